@@ -83,10 +83,12 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
 
 	"github.com/galaxynetwork/galaxy/x/mint"
+	mintclient "github.com/galaxynetwork/galaxy/x/mint/client"
 	mintkeeper "github.com/galaxynetwork/galaxy/x/mint/keeper"
 	minttypes "github.com/galaxynetwork/galaxy/x/mint/types"
 
 	"github.com/galaxynetwork/galaxy/x/clairdrop"
+	clairdropclient "github.com/galaxynetwork/galaxy/x/clairdrop/client"
 	clairdropkeeper "github.com/galaxynetwork/galaxy/x/clairdrop/keeper"
 	clairdroptypes "github.com/galaxynetwork/galaxy/x/clairdrop/types"
 
@@ -113,6 +115,15 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		upgradeclient.CancelProposalHandler,
 		ibcclientclient.UpdateClientProposalHandler,
 		ibcclientclient.UpgradeProposalHandler,
+		mintclient.ProposalHandler,
+		mintclient.UpdateParamsProposalHandler,
+		mintclient.ClawbackDeveloperRewardsProposalHandler,
+		mintclient.AddDeveloperReceiverProposalHandler,
+		mintclient.RemoveDeveloperReceiverProposalHandler,
+		mintclient.ReplaceDeveloperReceiverProposalHandler,
+		mintclient.ReweightDeveloperReceiverProposalHandler,
+		mintclient.SetPausedProposalHandler,
+		clairdropclient.SetPausedProposalHandler,
 	)
 
 	return govProposalHandlers
@@ -151,7 +162,8 @@ var (
 	maccPerms = map[string][]string{
 		authtypes.FeeCollectorName:     nil,
 		distrtypes.ModuleName:          nil,
-		minttypes.ModuleName:           {authtypes.Minter},
+		minttypes.ModuleName:           {authtypes.Minter, authtypes.Burner},
+		minttypes.EcosystemPoolName:    nil,
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
@@ -321,7 +333,9 @@ func New(
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.DistrKeeper,
+		&stakingKeeper,
 		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	app.ClairdropKeeper = clairdropkeeper.NewKeeper(
@@ -332,6 +346,7 @@ func New(
 		app.BankKeeper,
 		app.DistrKeeper,
 		app.MintKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	// register the staking hooks
@@ -355,7 +370,9 @@ func New(
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
+		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
+		AddRoute(minttypes.RouterKey, mint.NewProposalHandler(app.MintKeeper)).
+		AddRoute(clairdroptypes.RouterKey, clairdrop.NewProposalHandler(app.ClairdropKeeper))
 
 	// Create Transfer Keepers
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
@@ -420,7 +437,7 @@ func New(
 		params.NewAppModule(app.ParamsKeeper),
 		transferModule,
 		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper, app.BankKeeper),
-		clairdrop.NewAppModule(appCodec, app.ClairdropKeeper),
+		clairdrop.NewAppModule(appCodec, app.ClairdropKeeper, app.AccountKeeper, app.BankKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -507,6 +524,8 @@ func New(
 		evidence.NewAppModule(app.EvidenceKeeper),
 		ibc.NewAppModule(app.IBCKeeper),
 		transferModule,
+		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper, app.BankKeeper),
+		clairdrop.NewAppModule(appCodec, app.ClairdropKeeper, app.AccountKeeper, app.BankKeeper),
 	)
 	app.sm.RegisterStoreDecoders()
 	// initialize stores
@@ -17,6 +17,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 	"github.com/galaxynetwork/galaxy/app"
+	clairdropcli "github.com/galaxynetwork/galaxy/x/clairdrop/client/cli"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
@@ -165,6 +166,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig app.EncodingConfig) {
 		genutilcli.GenTxCmd(app.ModuleBasics, encodingConfig.TxConfig, banktypes.GenesisBalancesIterator{}, app.DefaultNodeHome),
 		genutilcli.ValidateGenesisCmd(app.ModuleBasics),
 		AddGenesisAccountCmd(app.DefaultNodeHome),
+		clairdropcli.GetCmdBuildMerkleTree(),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		debug.Cmd(),
 		config.Cmd(),
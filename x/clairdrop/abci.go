@@ -1,18 +1,28 @@
 package clairdrop
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/galaxynetwork/galaxy/x/clairdrop/keeper"
 )
 
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	// the clawback waits until claims are resumed
+	if k.IsPaused(ctx) {
+		return
+	}
 
 	params := k.GetParams(ctx)
 
-	if ctx.BlockTime().After(params.ClairdropEndTime) && k.GetModuleAccountBalance(ctx).IsPositive() {
-		err := k.EndAirdrop(ctx)
-		if err != nil {
-			panic(err)
+	if ctx.BlockTime().After(params.ClairdropEndTime) && !k.GetClawback(ctx).IsCompleted() {
+		// a failed step is discarded and retried in the following block
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.ProgressClawback(cacheCtx, params.ClawbackRecordsPerBlock); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to progress the clawback: %s", err))
+			return
 		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
 	}
 }
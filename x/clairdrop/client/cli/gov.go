@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/galaxynetwork/galaxy/x/clairdrop/types"
+	"github.com/spf13/cobra"
+)
+
+// CmdSubmitSetClairdropPausedProposal implements the command to submit a
+// proposal pausing or resuming claiming and clawback
+func CmdSubmitSetClairdropPausedProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-clairdrop-paused [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal pausing or resuming claiming and clawback",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal pausing or resuming claiming and clawback along with an
+initial deposit. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal set-clairdrop-paused <path/to/proposal.json> --deposit=1000uglx --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Resume Clairdrop",
+  "description": "The issue behind the guardian pause is fixed",
+  "paused": false
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var content types.SetClairdropPausedProposal
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &content); err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
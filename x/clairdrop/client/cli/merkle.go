@@ -0,0 +1,197 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/galaxynetwork/galaxy/x/clairdrop/types"
+	"github.com/spf13/cobra"
+)
+
+// Allocation is an allocation of the Merkle airdrop in a snapshot.
+type Allocation struct {
+	Address string `json:"address"`
+	Amount  string `json:"amount"`
+}
+
+// AllocationProof is an allocation of the Merkle airdrop along with its proof.
+type AllocationProof struct {
+	Address string   `json:"address"`
+	Amount  string   `json:"amount"`
+	Proof   []string `json:"proof"`
+}
+
+// MerkleTree is the output of the build-clairdrop-merkle-tree command.
+type MerkleTree struct {
+	Root            string            `json:"root"`
+	TotalAllocation sdk.Coin          `json:"total_allocation"`
+	Allocations     []AllocationProof `json:"allocations"`
+}
+
+// GetCmdBuildMerkleTree implements the command to build the Merkle tree of the
+// allocations of a snapshot offline.
+func GetCmdBuildMerkleTree() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "build-clairdrop-merkle-tree [snapshot-file] [output-file]",
+		Short: "Build the Merkle tree of the clairdrop allocations of a snapshot",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Build the Merkle tree of the clairdrop allocations of a JSON or CSV snapshot,
+and write its root, the total allocation and the proof of every allocation to the output file.
+The root and the total allocation go to the merkle_airdrop of the clairdrop genesis, and the
+total allocation is added to the module account balance.
+
+A JSON snapshot is an array of {"address": ..., "amount": ...} objects, a CSV snapshot has an
+address,amount line per allocation. Amounts without denom are in %[3]s.
+
+Example:
+$ %[1]s build-clairdrop-merkle-tree snapshot.csv merkle.json
+`,
+				version.AppName, types.ModuleName, types.DefaultClaimDenom,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			allocations, err := readSnapshot(args[0])
+			if err != nil {
+				return err
+			}
+
+			tree, err := BuildMerkleTree(allocations)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(tree, "", "  ")
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(args[1], bz, 0o600); err != nil {
+				return err
+			}
+
+			cmd.Printf("root: %s\ntotal allocation: %s\nallocations: %d\n", tree.Root, tree.TotalAllocation, len(tree.Allocations))
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// BuildMerkleTree returns the Merkle tree of the allocations, sorted by
+// address.
+func BuildMerkleTree(allocations []Allocation) (MerkleTree, error) {
+	if len(allocations) == 0 {
+		return MerkleTree{}, fmt.Errorf("no allocation")
+	}
+
+	proofs := make([]AllocationProof, len(allocations))
+	seen := make(map[string]bool, len(allocations))
+	total := sdk.NewCoin(types.DefaultClaimDenom, sdk.ZeroInt())
+	for i, allocation := range allocations {
+		addr, err := sdk.AccAddressFromBech32(strings.TrimSpace(allocation.Address))
+		if err != nil {
+			return MerkleTree{}, fmt.Errorf("invalid address %s: %w", allocation.Address, err)
+		}
+		if seen[addr.String()] {
+			return MerkleTree{}, fmt.Errorf("duplicate allocation for %s", addr)
+		}
+		seen[addr.String()] = true
+
+		amount, err := parseAllocationAmount(allocation.Amount)
+		if err != nil {
+			return MerkleTree{}, fmt.Errorf("invalid amount for %s: %w", addr, err)
+		}
+
+		proofs[i] = AllocationProof{Address: addr.String(), Amount: amount.String()}
+		total = total.Add(amount)
+	}
+	sort.Slice(proofs, func(i, j int) bool { return proofs[i].Address < proofs[j].Address })
+
+	leaves := make([][]byte, len(proofs))
+	for i, allocation := range proofs {
+		amount, err := sdk.ParseCoinsNormalized(allocation.Amount)
+		if err != nil {
+			return MerkleTree{}, err
+		}
+		leaves[i] = types.MerkleLeaf(allocation.Address, amount)
+	}
+	root, leafProofs := types.BuildMerkleTree(leaves)
+	for i, proof := range leafProofs {
+		proofs[i].Proof = make([]string, len(proof))
+		for j, hash := range proof {
+			proofs[i].Proof[j] = hex.EncodeToString(hash)
+		}
+	}
+
+	return MerkleTree{
+		Root:            hex.EncodeToString(root),
+		TotalAllocation: total,
+		Allocations:     proofs,
+	}, nil
+}
+
+// parseAllocationAmount parses a positive amount of the claim denom, the denom
+// may be left out.
+func parseAllocationAmount(amount string) (sdk.Coin, error) {
+	amount = strings.TrimSpace(amount)
+	if i, ok := sdk.NewIntFromString(amount); ok {
+		amount = i.String() + types.DefaultClaimDenom
+	}
+
+	coin, err := sdk.ParseCoinNormalized(amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if coin.Denom != types.DefaultClaimDenom {
+		return sdk.Coin{}, fmt.Errorf("denom must be %s: %s", types.DefaultClaimDenom, coin.Denom)
+	}
+	if !coin.IsPositive() {
+		return sdk.Coin{}, fmt.Errorf("amount must be positive: %s", coin)
+	}
+	return coin, nil
+}
+
+// readSnapshot reads the allocations of a JSON snapshot, or of a CSV snapshot
+// for files with the .csv extension. A CSV header line is skipped.
+func readSnapshot(path string) ([]Allocation, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var allocations []Allocation
+	if !strings.EqualFold(filepath.Ext(path), ".csv") {
+		if err := json.NewDecoder(f).Decode(&allocations); err != nil {
+			return nil, fmt.Errorf("failed to decode JSON snapshot: %w", err)
+		}
+		return allocations, nil
+	}
+
+	reader := csv.NewReader(f)
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if line == 1 && strings.EqualFold(record[0], "address") {
+			continue
+		}
+		allocations = append(allocations, Allocation{Address: record[0], Amount: record[1]})
+	}
+	return allocations, nil
+}
@@ -12,6 +12,11 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagStatus           = "status"
+	FlagCompletedActions = "completed-actions"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	claimQueryCmd := &cobra.Command{
@@ -28,6 +33,11 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryClaimRecord(),
 		GetCmdQueryClaimableForAction(),
 		GetCmdQueryTotalClaimable(),
+		GetCmdQueryPause(),
+		GetCmdQueryMerkleAirdrop(),
+		GetCmdQueryClaimRecords(),
+		GetCmdQueryStats(),
+		GetCmdQueryClawback(),
 	)
 
 	return claimQueryCmd
@@ -203,3 +213,186 @@ $ %s query claim total-claimable osmo1ey69r37gfxvxg62sh4r0ktpuc46pzjrm23kcrx
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryPause implements a command to return the pause state of claiming
+// and clawback.
+func GetCmdQueryPause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause",
+		Short: "Query the pause state of claiming and clawback",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Pause(context.Background(), &types.QueryPauseRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMerkleAirdrop implements a command to return the allocations
+// committed to by a Merkle root.
+func GetCmdQueryMerkleAirdrop() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merkle-airdrop",
+		Short: "Query the Merkle root and the total allocation of the Merkle airdrop",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MerkleAirdrop(context.Background(), &types.QueryMerkleAirdropRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryClaimRecords implements the query claim-records command.
+func GetCmdQueryClaimRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-records",
+		Args:  cobra.NoArgs,
+		Short: "Query the claim records filtered by their progress",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the claim records filtered by their progress.
+The status is one of %s, %s, %s and %s.
+
+Example:
+$ %s query clairdrop claim-records --status %s --completed-actions %s,%s
+`,
+				types.AnyStatus, types.FullyClaimed, types.PartiallyClaimed, types.Untouched,
+				version.AppName, types.PartiallyClaimed, types.Delegate, types.Vote,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			statusName, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+			status, err := types.ParseClaimRecordStatus(statusName)
+			if err != nil {
+				return err
+			}
+
+			actionNames, err := cmd.Flags().GetStringSlice(FlagCompletedActions)
+			if err != nil {
+				return err
+			}
+			completedActions := make([]types.ClaimAction, len(actionNames))
+			for i, actionName := range actionNames {
+				completedActions[i], err = types.ParseClaimAction(actionName)
+				if err != nil {
+					return err
+				}
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ClaimRecords(context.Background(), &types.QueryClaimRecordsRequest{
+				Status:           status,
+				CompletedActions: completedActions,
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagStatus, types.AnyStatus.String(), "progress of the claim records over all the actions")
+	cmd.Flags().StringSlice(FlagCompletedActions, nil, "actions the claim records completed")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "claim-records")
+
+	return cmd
+}
+
+// GetCmdQueryStats implements a command to return the aggregate progress of
+// the clairdrop.
+func GetCmdQueryStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Query the total allocated amount, the claims of each action and the module account balance",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Stats(context.Background(), &types.QueryStatsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryClawback implements a command to return the progress of the
+// clawback run after the clairdrop end.
+func GetCmdQueryClawback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback",
+		Short: "Query the progress of the clawback run after the clairdrop end",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Clawback(context.Background(), &types.QueryClawbackRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
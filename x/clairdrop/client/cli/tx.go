@@ -0,0 +1,199 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/galaxynetwork/galaxy/x/clairdrop/types"
+	"github.com/spf13/cobra"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdPause(),
+		GetCmdResume(),
+		GetCmdAttestAction(),
+		GetCmdSubmitClaimProof(),
+	)
+
+	return cmd
+}
+
+// GetCmdPause implements the command to pause claiming and clawback.
+func GetCmdPause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause",
+		Short: "pause claiming and the clawback at the end of the clairdrop",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Pause claiming and the clawback at the end of the clairdrop.
+The transaction must be signed by the module authority (the gov module account by default)
+or by the mint guardian.
+
+Example:
+$ %s tx %s pause --from <guardian>
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetPaused(clientCtx.GetFromAddress().String(), true)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdResume implements the command to resume claiming and clawback.
+func GetCmdResume() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume",
+		Short: "resume claiming and the clawback at the end of the clairdrop",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Resume claiming and the clawback at the end of the clairdrop.
+The transaction must be signed by the module authority (the gov module account by default).
+
+Example:
+$ %s tx %s resume --from <authority> --generate-only
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetPaused(clientCtx.GetFromAddress().String(), false)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAttestAction implements the command to confirm that an address
+// completed a claim action.
+func GetCmdAttestAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attest-action [address] [action]",
+		Short: "confirm that an address completed the story or nft action and claim it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Confirm that an address completed a claim action, and claim the amount
+of the action for it. The action is story or nft, and the transaction must be signed by an
+attester of the action in the params.
+
+Example:
+$ %s tx %s attest-action galaxy1... story --from <attester>
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			action, err := types.ParseClaimAction(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAttestAction(clientCtx.GetFromAddress().String(), args[0], action)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSubmitClaimProof implements the command to create the claim record of
+// an allocation of the Merkle airdrop and claim its initial action.
+func GetCmdSubmitClaimProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-claim-proof [amount] [proof]",
+		Short: "create the claim record of your allocation of the Merkle airdrop and make its first claim",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create the claim record of your allocation of the Merkle airdrop, from the
+comma separated hex encoded hashes of its proof. The allocation and its proof are listed in the
+output of the build-clairdrop-merkle-tree command. The proof is empty for a single allocation.
+The share of the delegate action is claimed along with the record.
+
+Example:
+$ %s tx %s submit-claim-proof 1000uglx 5b1f...,09ac... --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			proof := []string{}
+			if len(args) == 2 && args[1] != "" {
+				proof = strings.Split(args[1], ",")
+			}
+
+			msg := types.NewMsgSubmitClaimProof(clientCtx.GetFromAddress().String(), amount, proof)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,10 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/galaxynetwork/galaxy/x/clairdrop/client/cli"
+	"github.com/galaxynetwork/galaxy/x/clairdrop/client/rest"
+)
+
+// SetPausedProposalHandler is the clairdrop pause proposal handler.
+var SetPausedProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitSetClairdropPausedProposal, rest.SetClairdropPausedProposalRESTHandler)
@@ -15,12 +15,19 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	}
 	k.SetParams(ctx, genState.Params)
 	k.CreateModuleAccount(ctx, genState.ModuleAccountBalance)
+	k.SetPause(ctx, genState.Pause)
+	k.SetMerkleAirdrop(ctx, genState.MerkleAirdrop)
 	err := k.SetClaimRecords(ctx, genState.ClaimRecords)
 	if err != nil {
 		panic(
 			err,
 		)
 	}
+	if genState.Stats.IsEmpty() {
+		genState.Stats = types.NewClairdropStats(genState.ClaimRecords, genState.MerkleAirdrop)
+	}
+	k.SetStats(ctx, genState.Stats)
+	k.SetClawback(ctx, genState.Clawback)
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -29,5 +36,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 	genesis.ModuleAccountBalance = k.GetModuleAccountBalance(ctx)
 	genesis.ClaimRecords = k.GetClaimRecords(ctx)
+	genesis.Pause = k.GetPause(ctx)
+	genesis.MerkleAirdrop = k.GetMerkleAirdrop(ctx)
+	genesis.Stats = k.GetStats(ctx)
+	genesis.Clawback = k.GetClawback(ctx)
 	return genesis
 }
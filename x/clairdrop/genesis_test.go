@@ -35,23 +35,26 @@ func TestClaimInitGenesis(t *testing.T) {
 		{
 			ModuleAccountBalance: sdk.NewInt64Coin(types.DefaultClaimDenom, 1_000_000+4_000_000+1),
 			Params: types.Params{
-				ClairdropStartTime: now,
-				ClairdropEndTime:   now.Add(time.Hour * 3),
+				ClairdropStartTime:      now,
+				ClairdropEndTime:        now.Add(time.Hour * 3),
+				ClawbackRecordsPerBlock: types.DefaultClawbackRecordsPerBlock,
 			},
 			ClaimRecords: claimRecords,
 		},
 		{
 			ModuleAccountBalance: sdk.NewInt64Coin(types.DefaultClaimDenom, 1_000_000+4_000_000),
 			Params: types.Params{
-				ClairdropStartTime: now,
-				ClairdropEndTime:   now.Add(time.Hour * 3),
+				ClairdropStartTime:      now,
+				ClairdropEndTime:        now.Add(time.Hour * 3),
+				ClawbackRecordsPerBlock: types.DefaultClawbackRecordsPerBlock,
 			},
 			ClaimRecords: claimRecords,
 		}, {
 			ModuleAccountBalance: sdk.NewInt64Coin(types.DefaultClaimDenom, 1_000_000+4_000_000),
 			Params: types.Params{
-				ClairdropStartTime: time.Time{},
-				ClairdropEndTime:   time.Time{},
+				ClairdropStartTime:      time.Time{},
+				ClairdropEndTime:        time.Time{},
+				ClawbackRecordsPerBlock: types.DefaultClawbackRecordsPerBlock,
 			},
 			ClaimRecords: claimRecords,
 		},
@@ -84,3 +87,24 @@ func TestClaimInitGenesis(t *testing.T) {
 		require.Equal(t, claimRecords, genesis.ClaimRecords)
 	}
 }
+
+// An exported genesis carries the module account and its balance in the auth
+// and bank genesis, importing it must neither mint the balance again nor
+// replace the module account.
+func TestClaimInitGenesisExported(t *testing.T) {
+	app := app.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	balance := sdk.NewInt64Coin(types.DefaultClaimDenom, 5_000_000)
+	app.ClairdropKeeper.CreateModuleAccount(ctx, balance)
+	moduleAcc := app.AccountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	supply := app.BankKeeper.GetSupply(ctx, types.DefaultClaimDenom)
+
+	genesis := clairdrop.ExportGenesis(ctx, app.ClairdropKeeper)
+	require.Equal(t, balance.String(), genesis.ModuleAccountBalance.String())
+	clairdrop.InitGenesis(ctx, app.ClairdropKeeper, *genesis)
+
+	require.Equal(t, balance.String(), app.ClairdropKeeper.GetModuleAccountBalance(ctx).String())
+	require.Equal(t, supply.String(), app.BankKeeper.GetSupply(ctx, types.DefaultClaimDenom).String())
+	require.Equal(t, moduleAcc.GetAccountNumber(), app.AccountKeeper.GetModuleAccount(ctx, types.ModuleName).GetAccountNumber())
+}
@@ -1,72 +1,225 @@
 package keeper
 
 import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/galaxynetwork/galaxy/x/clairdrop/types"
 )
 
-// SetParams set the params
+// GetClawback returns the progress of the clawback.
+func (k Keeper) GetClawback(ctx sdk.Context) types.ClawbackProgress {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ClawbackKey)
+	if bz == nil {
+		return types.DefaultClawbackProgress()
+	}
+
+	var clawback types.ClawbackProgress
+	k.cdc.MustUnmarshal(bz, &clawback)
+	return clawback
+}
+
+func (k Keeper) SetClawback(ctx sdk.Context, clawback types.ClawbackProgress) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&clawback)
+	store.Set(types.ClawbackKey, bz)
+}
+
+// EndAirdrop runs the whole clawback at once.
 func (k Keeper) EndAirdrop(ctx sdk.Context) error {
+	for !k.GetClawback(ctx).IsCompleted() {
+		if err := k.progressClawback(ctx, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	err := k.ClawbackAirdrop(ctx)
-	if err != nil {
-		return err
+// ProgressClawback runs a step of the clawback over at most limit claim
+// records. The balances of the inactive claim record accounts are clawed back
+// first, then the balance of the module account is funded to the community
+// pool and the claim records are deleted. The clawback resumes from the saved
+// cursor in the following call.
+func (k Keeper) ProgressClawback(ctx sdk.Context, limit uint64) error {
+	if limit == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "clawback records limit must be positive")
 	}
+	return k.progressClawback(ctx, limit)
+}
 
-	err = k.FundRemainingsToCommunity(ctx)
-	if err != nil {
-		return err
+// progressClawback runs a step of the clawback over at most limit claim
+// records, all of them when limit is zero.
+func (k Keeper) progressClawback(ctx sdk.Context, limit uint64) error {
+	clawback := k.GetClawback(ctx)
+
+	switch clawback.State {
+	case types.ClawbackCompleted:
+		return nil
+
+	case types.ClawbackNotStarted:
+		clawback.State = types.ClawbackInProgress
+		clawback.StartedHeight = ctx.BlockHeight()
+		fallthrough
+
+	case types.ClawbackInProgress:
+		cursor := k.clawbackRecords(ctx, &clawback, limit)
+		if cursor != nil {
+			clawback.Cursor = cursor
+			break
+		}
+
+		if err := k.FundRemainingsToCommunity(ctx); err != nil {
+			return err
+		}
+		clawback.State = types.ClawbackClearing
+		clawback.Cursor = nil
+
+	case types.ClawbackClearing:
+		if k.clearClaimRecords(ctx, limit) {
+			clawback.State = types.ClawbackCompleted
+			clawback.CompletedHeight = ctx.BlockHeight()
+		}
 	}
 
-	k.ClearClaimables(ctx)
+	k.SetClawback(ctx, clawback)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClawbackProgress,
+			sdk.NewAttribute(types.AttributeKeyState, clawback.State.String()),
+			sdk.NewAttribute(types.AttributeKeyProcessedRecords, strconv.FormatUint(clawback.ProcessedRecords, 10)),
+			sdk.NewAttribute(types.AttributeKeyFailedRecords, strconv.FormatUint(clawback.FailedRecords, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, clawback.ClawedBack.String()),
+		),
+	)
 
 	return nil
 }
 
-func (k Keeper) ClawbackAirdrop(ctx sdk.Context) error {
-	claimRecords := k.GetClaimRecords(ctx)
+// clawbackRecords claws back the balances of the inactive accounts of at most
+// limit claim records from the cursor of the clawback, and returns the key of
+// the next claim record, nil once all the claim records are processed. A
+// failed clawback is skipped, so that one account cannot halt the clawback.
+func (k Keeper) clawbackRecords(ctx sdk.Context, clawback *types.ClawbackProgress, limit uint64) []byte {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ClaimRecordStorePrefix))
+	iterator := prefixStore.Iterator(clawback.Cursor, nil)
+	defer iterator.Close()
+
 	developerAddress := k.mk.GetDeveloperAddress(ctx)
-	for _, claimRecord := range claimRecords {
-		addr, err := sdk.AccAddressFromBech32(claimRecord.Address)
-		if err != nil {
-			return err
+
+	for processed := uint64(0); iterator.Valid(); iterator.Next() {
+		if limit > 0 && processed == limit {
+			return iterator.Key()
 		}
+		processed++
+		clawback.ProcessedRecords++
 
-		acc := k.ak.GetAccount(ctx, addr)
-		if acc == nil {
+		var claimRecord types.ClaimRecord
+		if err := k.cdc.Unmarshal(iterator.Value(), &claimRecord); err != nil {
+			k.recordClawbackFailure(ctx, clawback, sdk.AccAddress(iterator.Key()).String(), err)
 			continue
 		}
 
-		seq, err := k.ak.GetSequence(ctx, addr)
+		cacheCtx, write := ctx.CacheContext()
+		clawedBack, err := k.clawbackRecord(cacheCtx, claimRecord, developerAddress)
 		if err != nil {
-			return err
-		}
-		//if never make transaction
-		if seq == 0 {
-			//skip developer
-			var skip bool
-			for _, addr := range developerAddress {
-				if addr == claimRecord.Address {
-					skip = true
-					continue
-				}
-			}
-			if skip {
-				continue
-			}
-			balance := k.bk.GetBalance(ctx, addr, types.DefaultClaimDenom)
-			clawbackCoins := sdk.NewCoins(balance)
-			err = k.dk.FundCommunityPool(ctx, clawbackCoins, addr)
-			if err != nil {
-				return err
-			}
+			k.recordClawbackFailure(ctx, clawback, claimRecord.Address, err)
+			continue
 		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		clawback.ClawedBack = clawback.ClawedBack.Add(clawedBack...)
 	}
+
 	return nil
 }
 
+// clawbackRecord funds the balance of the account of the claim record to the
+// community pool if the account never made a transaction, and returns the
+// amount clawed back. Developer rewards receivers are skipped.
+func (k Keeper) clawbackRecord(ctx sdk.Context, claimRecord types.ClaimRecord, developerAddress []string) (sdk.Coins, error) {
+	addr, err := sdk.AccAddressFromBech32(claimRecord.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	acc := k.ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, nil
+	}
+
+	seq, err := k.ak.GetSequence(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	//if never make transaction
+	if seq != 0 {
+		return nil, nil
+	}
+
+	//skip developer
+	for _, developer := range developerAddress {
+		if developer == claimRecord.Address {
+			return nil, nil
+		}
+	}
+
+	balance := k.bk.GetBalance(ctx, addr, types.DefaultClaimDenom)
+	clawbackCoins := sdk.NewCoins(balance)
+	if clawbackCoins.Empty() {
+		return nil, nil
+	}
+	if err := k.dk.FundCommunityPool(ctx, clawbackCoins, addr); err != nil {
+		return nil, err
+	}
+	return clawbackCoins, nil
+}
+
+func (k Keeper) recordClawbackFailure(ctx sdk.Context, clawback *types.ClawbackProgress, address string, err error) {
+	clawback.FailedRecords++
+
+	k.Logger(ctx).Error(fmt.Sprintf("failed to claw back the claim record of %s: %s", address, err))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClawbackFailed,
+			sdk.NewAttribute(sdk.AttributeKeySender, address),
+			sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+		),
+	)
+}
+
+// clearClaimRecords deletes at most limit claim records, all of them when
+// limit is zero, and returns true once no claim record is left.
+func (k Keeper) clearClaimRecords(ctx sdk.Context, limit uint64) bool {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ClaimRecordStorePrefix))
+	iterator := prefixStore.Iterator(nil, nil)
+
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		if limit > 0 && uint64(len(keys)) == limit {
+			break
+		}
+		keys = append(keys, iterator.Key())
+	}
+	done := !iterator.Valid()
+	iterator.Close()
+
+	for _, key := range keys {
+		prefixStore.Delete(key)
+		deleteClaimRecordIndex(ctx.KVStore(k.storeKey), key)
+	}
+	return done
+}
+
 func (k Keeper) FundRemainingsToCommunity(ctx sdk.Context) error {
 	moduleAccAddr := k.ak.GetModuleAddress(types.ModuleName)
 	amt := k.GetModuleAccountBalance(ctx)
+	if !amt.IsPositive() {
+		return nil
+	}
 	return k.dk.FundCommunityPool(ctx, sdk.NewCoins(amt), moduleAccAddr)
 }
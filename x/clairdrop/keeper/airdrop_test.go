@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -209,3 +210,79 @@ func (suite *KeeperTestSuite) TestClawbackAirdrop() {
 		)
 	}
 }
+
+func (suite *KeeperTestSuite) TestProgressClawback() {
+	require := suite.Require()
+	clairdropKeeper := suite.app.ClairdropKeeper
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	claimRecords := []types.ClaimRecord{}
+	for i := 0; i < 4; i++ {
+		addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		claimRecords = append(claimRecords, types.ClaimRecord{
+			Address:               addr.String(),
+			InitalClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 100)),
+			ActionCompleted:       []bool{false, false, false, false},
+		})
+		require.NoError(simapp.FundAccount(suite.app.BankKeeper, suite.ctx, addr, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 10))))
+	}
+	require.NoError(clairdropKeeper.SetClaimRecords(suite.ctx, claimRecords))
+
+	// a malformed claim record fails without halting the clawback
+	prefixStore := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(types.StoreKey)), []byte(types.ClaimRecordStorePrefix))
+	prefixStore.Set([]byte("malformed"), suite.app.AppCodec().MustMarshal(&types.ClaimRecord{Address: "malformed"}))
+
+	queryClawback := func() types.ClawbackProgress {
+		res, err := clairdropKeeper.Clawback(ctx, &types.QueryClawbackRequest{})
+		require.NoError(err)
+		return res.Clawback
+	}
+	require.Equal(types.ClawbackNotStarted, queryClawback().State)
+
+	// only EndAirdrop runs the whole clawback at once
+	require.Error(clairdropKeeper.ProgressClawback(suite.ctx, 0))
+	require.Equal(types.ClawbackNotStarted, queryClawback().State)
+	params := clairdropKeeper.GetParams(suite.ctx)
+	params.ClawbackRecordsPerBlock = 0
+	require.Error(params.Validate())
+
+	for _, processed := range []uint64{2, 4} {
+		require.NoError(clairdropKeeper.ProgressClawback(suite.ctx, 2))
+		clawback := queryClawback()
+		require.Equal(types.ClawbackInProgress, clawback.State)
+		require.Equal(processed, clawback.ProcessedRecords)
+		require.NotEmpty(clawback.Cursor)
+		require.True(clairdropKeeper.GetModuleAccountBalance(suite.ctx).IsPositive())
+	}
+
+	require.NoError(clairdropKeeper.ProgressClawback(suite.ctx, 2))
+	clawback := queryClawback()
+	require.Equal(types.ClawbackClearing, clawback.State)
+	require.Equal(uint64(5), clawback.ProcessedRecords)
+	require.Equal(uint64(1), clawback.FailedRecords)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 40)), clawback.ClawedBack)
+	require.True(clairdropKeeper.GetModuleAccountBalance(suite.ctx).IsZero())
+	for _, claimRecord := range claimRecords {
+		addr, err := sdk.AccAddressFromBech32(claimRecord.Address)
+		require.NoError(err)
+		require.True(suite.app.BankKeeper.GetAllBalances(suite.ctx, addr).IsZero())
+	}
+
+	for _, left := range []int{3, 1, 0} {
+		require.Equal(types.ClawbackClearing, queryClawback().State)
+		require.NoError(clairdropKeeper.ProgressClawback(suite.ctx, 2))
+		require.Len(clairdropKeeper.GetClaimRecords(suite.ctx), left)
+	}
+	require.Equal(types.ClawbackCompleted, queryClawback().State)
+
+	// the index of the deleted claim records is deleted with them
+	for _, indexPrefix := range [][]byte{types.ClaimRecordStatusIndexPrefix, types.ClaimRecordActionIndexPrefix} {
+		iterator := sdk.KVStorePrefixIterator(suite.ctx.KVStore(suite.app.GetKey(types.StoreKey)), indexPrefix)
+		require.False(iterator.Valid())
+		iterator.Close()
+	}
+
+	// a completed clawback is not run again
+	require.NoError(clairdropKeeper.EndAirdrop(suite.ctx))
+	require.Equal(clawback.ProcessedRecords, queryClawback().ProcessedRecords)
+}
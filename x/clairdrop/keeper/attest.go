@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/galaxynetwork/galaxy/x/clairdrop/types"
+)
+
+// AttestAction claims the amount of an action confirmed by an attester for
+// the address. Modules attest with the address of their module account.
+// Unlike the hooks, attesting fails when nothing is claimed, so that the
+// attester can retry once claims resume.
+func (k Keeper) AttestAction(ctx sdk.Context, attester, addr sdk.AccAddress, action types.ClaimAction) (sdk.Coins, error) {
+	if !action.IsAttested() {
+		return nil, sdkerrors.Wrapf(types.ErrNotAttested, "%s", action)
+	}
+	if !k.GetParams(ctx).IsAttester(attester.String(), action) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAttester, "%s is not an attester of %s", attester, action)
+	}
+	if k.IsPaused(ctx) {
+		return nil, types.ErrClaimsPaused
+	}
+
+	claimed, err := k.ClaimForAction(ctx, addr, action)
+	if err != nil {
+		return nil, err
+	}
+	if claimed.IsZero() {
+		return nil, sdkerrors.Wrapf(types.ErrNothingToClaim, "%s for %s", addr, action)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAttestAction,
+			sdk.NewAttribute(types.AttributeKeyAttester, attester.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyAction, action.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, claimed.String()),
+		),
+	)
+
+	return claimed, nil
+}
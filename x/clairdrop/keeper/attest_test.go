@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/galaxynetwork/galaxy/x/clairdrop/keeper"
+	"github.com/galaxynetwork/galaxy/x/clairdrop/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgAttestAction() {
+	require := suite.Require()
+	clairdropKeeper := suite.app.ClairdropKeeper
+	msgServer := keeper.NewMsgServerImpl(clairdropKeeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	pubKey1 := secp256k1.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pubKey1.Address())
+	suite.app.AccountKeeper.SetAccount(suite.ctx, authtypes.NewBaseAccount(addr1, pubKey1, 0, 0))
+	attester := sdk.AccAddress([]byte("attester")).String()
+	// a module attests with its module account address
+	moduleAttester := authtypes.NewModuleAddress("story").String()
+
+	err := clairdropKeeper.SetClaimRecords(suite.ctx, []types.ClaimRecord{
+		{
+			Address:               addr1.String(),
+			InitalClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1_000)),
+			ActionCompleted:       []bool{false, false, false, false},
+		},
+	})
+	require.NoError(err)
+
+	params := clairdropKeeper.GetParams(suite.ctx)
+	params.Attesters = []types.Attester{
+		{Address: attester, Actions: []types.ClaimAction{types.Nft}},
+		{Address: moduleAttester, Actions: []types.ClaimAction{types.Story}},
+	}
+	clairdropKeeper.SetParams(suite.ctx, params)
+
+	// the attester of nft cannot attest story
+	_, err = msgServer.AttestAction(ctx, types.NewMsgAttestAction(attester, addr1.String(), types.Story))
+	require.ErrorIs(err, types.ErrInvalidAttester)
+	_, err = msgServer.AttestAction(ctx, types.NewMsgAttestAction(attester, addr1.String(), types.Vote))
+	require.ErrorIs(err, types.ErrNotAttested)
+
+	require.NoError(clairdropKeeper.SetPaused(suite.ctx, true))
+	_, err = msgServer.AttestAction(ctx, types.NewMsgAttestAction(attester, addr1.String(), types.Nft))
+	require.ErrorIs(err, types.ErrClaimsPaused)
+	require.NoError(clairdropKeeper.SetPaused(suite.ctx, false))
+
+	res, err := msgServer.AttestAction(ctx, types.NewMsgAttestAction(attester, addr1.String(), types.Nft))
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 250)), res.Claimed)
+	_, err = msgServer.AttestAction(ctx, types.NewMsgAttestAction(attester, addr1.String(), types.Nft))
+	require.ErrorIs(err, types.ErrNothingToClaim)
+
+	res, err = msgServer.AttestAction(ctx, types.NewMsgAttestAction(moduleAttester, addr1.String(), types.Story))
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 250)), res.Claimed)
+
+	record, err := clairdropKeeper.GetClaimRecord(suite.ctx, addr1)
+	require.NoError(err)
+	require.Equal([]bool{false, false, true, true}, record.ActionCompleted)
+	require.Equal(
+		sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 500)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1),
+	)
+}
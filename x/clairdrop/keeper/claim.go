@@ -44,9 +44,34 @@ func (k Keeper) SetClaimRecord(ctx sdk.Context, claimRecord types.ClaimRecord) e
 	}
 
 	prefixStore.Set(addr, bz)
+	setClaimRecordIndex(store, addr, claimRecord)
 	return nil
 }
 
+// setClaimRecordIndex replaces the index entries of a claim record with the
+// ones of its status and completed actions.
+func setClaimRecordIndex(store sdk.KVStore, addr sdk.AccAddress, claimRecord types.ClaimRecord) {
+	deleteClaimRecordIndex(store, addr)
+
+	prefix.NewStore(store, types.ClaimRecordStatusIndexKey(claimRecord.Status())).Set(addr, []byte{})
+	for action, completed := range claimRecord.ActionCompleted {
+		if completed {
+			prefix.NewStore(store, types.ClaimRecordActionIndexKey(types.ClaimAction(action))).Set(addr, []byte{})
+		}
+	}
+}
+
+// deleteClaimRecordIndex deletes the index entries of the claim record of the
+// address, whatever its status and completed actions.
+func deleteClaimRecordIndex(store sdk.KVStore, addr sdk.AccAddress) {
+	for status := range types.ClaimRecordStatus_name {
+		prefix.NewStore(store, types.ClaimRecordStatusIndexKey(types.ClaimRecordStatus(status))).Delete(addr)
+	}
+	for action := range types.ClaimAction_name {
+		prefix.NewStore(store, types.ClaimRecordActionIndexKey(types.ClaimAction(action))).Delete(addr)
+	}
+}
+
 func (k Keeper) GetClaimRecords(ctx sdk.Context) []types.ClaimRecord {
 	store := ctx.KVStore(k.storeKey)
 	prefixStore := prefix.NewStore(store, []byte(types.ClaimRecordStorePrefix))
@@ -82,17 +107,31 @@ func (k Keeper) SetClaimRecords(ctx sdk.Context, claimRecords []types.ClaimRecor
 	return nil
 }
 
-func (k Keeper) ClearClaimables(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := sdk.KVStorePrefixIterator(store, []byte(types.ClaimRecordStorePrefix))
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		key := iterator.Key()
-		store.Delete(key)
+// GetClaimableAmountForAction returns the amount claimable for the action after
+// the decay. The decayed part stays in the module account.
+func (k Keeper) GetClaimableAmountForAction(ctx sdk.Context, addr sdk.AccAddress, action types.ClaimAction) (sdk.Coins, error) {
+	claimableCoins, err := k.GetUndecayedClaimableAmountForAction(ctx, addr, action)
+	if err != nil || claimableCoins.Empty() {
+		return claimableCoins, err
 	}
+
+	decayFactor := k.GetParams(ctx).DecayFactor(ctx.BlockTime())
+	if decayFactor.Equal(sdk.OneDec()) {
+		return claimableCoins, nil
+	}
+
+	decayedCoins := sdk.Coins{}
+	for _, coin := range claimableCoins {
+		decayedCoins = decayedCoins.Add(
+			sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Mul(decayFactor).TruncateInt()),
+		)
+	}
+	return decayedCoins, nil
 }
 
-func (k Keeper) GetClaimableAmountForAction(ctx sdk.Context, addr sdk.AccAddress, action types.ClaimAction) (sdk.Coins, error) {
+// GetUndecayedClaimableAmountForAction returns the amount claimable for the
+// action without the decay.
+func (k Keeper) GetUndecayedClaimableAmountForAction(ctx sdk.Context, addr sdk.AccAddress, action types.ClaimAction) (sdk.Coins, error) {
 	claimRecord, err := k.GetClaimRecord(ctx, addr)
 	if err != nil {
 		return nil, err
@@ -124,6 +163,20 @@ func (k Keeper) GetClaimableAmountForAction(ctx sdk.Context, addr sdk.AccAddress
 }
 
 func (k Keeper) GetUserTotalClaimable(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coins, error) {
+	return k.getUserTotalClaimable(ctx, addr, k.GetClaimableAmountForAction)
+}
+
+// GetUserTotalUndecayedClaimable returns the amount claimable for all the
+// actions without the decay.
+func (k Keeper) GetUserTotalUndecayedClaimable(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coins, error) {
+	return k.getUserTotalClaimable(ctx, addr, k.GetUndecayedClaimableAmountForAction)
+}
+
+func (k Keeper) getUserTotalClaimable(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	claimableAmountForAction func(sdk.Context, sdk.AccAddress, types.ClaimAction) (sdk.Coins, error),
+) (sdk.Coins, error) {
 	claimRecord, err := k.GetClaimRecord(ctx, addr)
 	if err != nil {
 		return sdk.Coins{}, err
@@ -135,7 +188,7 @@ func (k Keeper) GetUserTotalClaimable(ctx sdk.Context, addr sdk.AccAddress) (sdk
 	totalClaimable := sdk.Coins{}
 
 	for action := range types.ClaimAction_name {
-		claimableForAction, err := k.GetClaimableAmountForAction(ctx, addr, types.ClaimAction(action))
+		claimableForAction, err := claimableAmountForAction(ctx, addr, types.ClaimAction(action))
 		if err != nil {
 			return sdk.Coins{}, err
 		}
@@ -144,7 +197,14 @@ func (k Keeper) GetUserTotalClaimable(ctx sdk.Context, addr sdk.AccAddress) (sdk
 	return totalClaimable, nil
 }
 
+// ClaimForAction sends the claimable amount of the action to the address and
+// marks the action completed. Nothing is claimed while claims are paused, the
+// action stays claimable once they resume.
 func (k Keeper) ClaimForAction(ctx sdk.Context, addr sdk.AccAddress, action types.ClaimAction) (sdk.Coins, error) {
+	if k.IsPaused(ctx) {
+		return sdk.Coins{}, nil
+	}
+
 	claimableAmount, err := k.GetClaimableAmountForAction(ctx, addr, action)
 	if err != nil {
 		return claimableAmount, err
@@ -170,11 +230,13 @@ func (k Keeper) ClaimForAction(ctx sdk.Context, addr sdk.AccAddress, action type
 	if err != nil {
 		return claimableAmount, err
 	}
+	k.SetStats(ctx, k.GetStats(ctx).AddClaim(action, claimableAmount))
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeClaim,
 			sdk.NewAttribute(sdk.AttributeKeySender, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyAction, action.String()),
 			sdk.NewAttribute(sdk.AttributeKeyAmount, claimableAmount.String()),
 		),
 	})
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -75,6 +77,41 @@ func (suite *KeeperTestSuite) TestHookOfClaimableAccount() {
 	)
 }
 
+func (suite *KeeperTestSuite) TestHookWhilePaused() {
+	require := suite.Require()
+
+	pubKey1 := secp256k1.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pubKey1.Address())
+
+	suite.app.AccountKeeper.SetAccount(suite.ctx, authtypes.NewBaseAccount(addr1, pubKey1, 0, 0))
+
+	initalCoins := sdk.NewCoins(sdk.NewCoin(types.DefaultClaimDenom, sdk.NewInt(1_000)))
+	err := suite.app.ClairdropKeeper.SetClaimRecords(suite.ctx, []types.ClaimRecord{
+		{
+			Address:               addr1.String(),
+			InitalClaimableAmount: initalCoins,
+			ActionCompleted:       []bool{false, false, false, false},
+		},
+	})
+	require.NoError(err)
+
+	require.NoError(suite.app.ClairdropKeeper.SetPaused(suite.ctx, true))
+	require.ErrorIs(suite.app.ClairdropKeeper.SetPaused(suite.ctx, true), types.ErrAlreadyPaused)
+
+	// the action is not recorded while paused, it can be claimed later
+	claimed, err := suite.app.ClairdropKeeper.ClaimForAction(suite.ctx, addr1, types.Vote)
+	require.NoError(err)
+	require.True(claimed.IsZero())
+	record, err := suite.app.ClairdropKeeper.GetClaimRecord(suite.ctx, addr1)
+	require.NoError(err)
+	require.Equal([]bool{false, false, false, false}, record.ActionCompleted)
+
+	require.NoError(suite.app.ClairdropKeeper.SetPaused(suite.ctx, false))
+	claimed, err = suite.app.ClairdropKeeper.ClaimForAction(suite.ctx, addr1, types.Vote)
+	require.NoError(err)
+	require.False(claimed.IsZero())
+}
+
 func (suite *KeeperTestSuite) TestDuplicatedHook() {
 
 	require := suite.Require()
@@ -162,4 +199,56 @@ func (suite *KeeperTestSuite) TestClaimAction() {
 
 }
 
+func (suite *KeeperTestSuite) TestClaimDecay() {
+	require := suite.Require()
+	clairdropKeeper := suite.app.ClairdropKeeper
+
+	p1 := secp256k1.GenPrivKey().PubKey()
+	acc1 := sdk.AccAddress(p1.Address())
+
+	err := clairdropKeeper.SetClaimRecords(suite.ctx, []types.ClaimRecord{
+		{
+			Address:               acc1.String(),
+			InitalClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1_000)),
+			ActionCompleted:       []bool{false, false, false, false},
+		},
+	})
+	require.NoError(err)
+
+	// the claimable amounts decay during the second hour of the clairdrop
+	params := clairdropKeeper.GetParams(suite.ctx)
+	params.ClairdropDecayStartTime = params.ClairdropStartTime.Add(time.Hour)
+	require.NoError(params.Validate())
+	clairdropKeeper.SetParams(suite.ctx, params)
+
+	queryClaimable := func(ctx sdk.Context) *types.QueryTotalClaimableResponse {
+		res, err := clairdropKeeper.TotalClaimable(sdk.WrapSDKContext(ctx), &types.QueryTotalClaimableRequest{Address: acc1.String()})
+		require.NoError(err)
+		return res
+	}
+
+	undecayed := sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1_000))
+	res := queryClaimable(suite.ctx.WithBlockTime(params.ClairdropDecayStartTime))
+	require.Equal(undecayed, res.Coins)
+	require.Equal(undecayed, res.UndecayedCoins)
+
+	res = queryClaimable(suite.ctx.WithBlockTime(params.ClairdropEndTime))
+	require.True(res.Coins.Empty())
+	require.Equal(undecayed, res.UndecayedCoins)
+
+	// a quarter of the decay leaves three quarters of the amounts claimable
+	suite.ctx = suite.ctx.WithBlockTime(params.ClairdropDecayStartTime.Add(15 * time.Minute))
+	res = queryClaimable(suite.ctx)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 748)), res.Coins)
+	require.Equal(undecayed, res.UndecayedCoins)
+
+	moduleBalance := clairdropKeeper.GetModuleAccountBalance(suite.ctx)
+	suite.app.ClairdropKeeper.AfterProposalVote(suite.ctx, acc1)
+	require.Equal(
+		sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 187)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, acc1),
+	)
+	require.Equal(moduleBalance.SubAmount(sdk.NewInt(187)), clairdropKeeper.GetModuleAccountBalance(suite.ctx))
+}
+
 //test action
@@ -6,7 +6,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/galaxynetwork/galaxy/x/clairdrop/types"
 )
 
@@ -34,9 +36,15 @@ func (k Keeper) TotalClaimable(c context.Context, req *types.QueryTotalClaimable
 	}
 
 	coins, err := k.GetUserTotalClaimable(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	undecayedCoins, err := k.GetUserTotalUndecayedClaimable(ctx, addr)
 
 	return &types.QueryTotalClaimableResponse{
-		Coins: coins,
+		Coins:          coins,
+		UndecayedCoins: undecayedCoins,
 	}, err
 }
 
@@ -70,8 +78,108 @@ func (k Keeper) ClaimableForAction(c context.Context, req *types.QueryClaimableF
 	}
 
 	coins, err := k.GetClaimableAmountForAction(ctx, addr, req.Action)
+	if err != nil {
+		return nil, err
+	}
+
+	undecayedCoins, err := k.GetUndecayedClaimableAmountForAction(ctx, addr, req.Action)
 
 	return &types.QueryClaimableForActionResponse{
-		Coins: coins,
+		Coins:          coins,
+		UndecayedCoins: undecayedCoins,
 	}, err
 }
+
+func (k Keeper) ClaimRecords(c context.Context, req *types.QueryClaimRecordsRequest) (*types.QueryClaimRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if _, ok := types.ClaimRecordStatus_name[int32(req.Status)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid claim record status %d", req.Status)
+	}
+	for _, action := range req.CompletedActions {
+		if _, ok := types.ClaimAction_name[int32(action)]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid claim action %d", action)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+
+	var claimRecords []types.ClaimRecord
+
+	// the filtered records are listed from the index of the status, or else of
+	// the first completed action, and the other actions are looked up in their
+	// index
+	var indexStore prefix.Store
+	actions := req.CompletedActions
+	switch {
+	case req.Status != types.AnyStatus:
+		indexStore = prefix.NewStore(store, types.ClaimRecordStatusIndexKey(req.Status))
+	case len(actions) > 0:
+		indexStore = prefix.NewStore(store, types.ClaimRecordActionIndexKey(actions[0]))
+		actions = actions[1:]
+	default:
+		prefixStore := prefix.NewStore(store, []byte(types.ClaimRecordStorePrefix))
+		pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_ []byte, value []byte) error {
+			var claimRecord types.ClaimRecord
+			if err := k.cdc.Unmarshal(value, &claimRecord); err != nil {
+				return err
+			}
+			claimRecords = append(claimRecords, claimRecord)
+			return nil
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &types.QueryClaimRecordsResponse{ClaimRecords: claimRecords, Pagination: pageRes}, nil
+	}
+
+	pageRes, err := query.FilteredPaginate(indexStore, req.Pagination, func(addr []byte, _ []byte, accumulate bool) (bool, error) {
+		for _, action := range actions {
+			if !prefix.NewStore(store, types.ClaimRecordActionIndexKey(action)).Has(addr) {
+				return false, nil
+			}
+		}
+
+		if accumulate {
+			claimRecord, err := k.GetClaimRecord(ctx, addr)
+			if err != nil {
+				return false, err
+			}
+			claimRecords = append(claimRecords, claimRecord)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClaimRecordsResponse{ClaimRecords: claimRecords, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Stats(c context.Context, _ *types.QueryStatsRequest) (*types.QueryStatsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryStatsResponse{
+		Stats:                k.GetStats(ctx),
+		ModuleAccountBalance: k.GetModuleAccountBalance(ctx),
+	}, nil
+}
+
+func (k Keeper) Clawback(c context.Context, _ *types.QueryClawbackRequest) (*types.QueryClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryClawbackResponse{
+		Clawback:        k.GetClawback(ctx),
+		RecordsPerBlock: k.GetParams(ctx).ClawbackRecordsPerBlock,
+	}, nil
+}
+
+func (k Keeper) Pause(c context.Context, _ *types.QueryPauseRequest) (*types.QueryPauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryPauseResponse{Pause: k.GetPause(ctx)}, nil
+}
+
+func (k Keeper) MerkleAirdrop(c context.Context, _ *types.QueryMerkleAirdropRequest) (*types.QueryMerkleAirdropResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryMerkleAirdropResponse{MerkleAirdrop: k.GetMerkleAirdrop(ctx)}, nil
+}
@@ -5,7 +5,6 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -22,6 +21,10 @@ type Keeper struct {
 	bk types.BankKeeper
 	dk types.DistributionKeeper
 	mk types.MintKeeper
+
+	// the address capable of pausing and resuming claims, typically the gov
+	// module account
+	authority string
 }
 
 func NewKeeper(
@@ -32,6 +35,7 @@ func NewKeeper(
 	bk types.BankKeeper,
 	dk types.DistributionKeeper,
 	mk types.MintKeeper,
+	authority string,
 ) Keeper {
 
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -50,6 +54,7 @@ func NewKeeper(
 		bk:         bk,
 		dk:         dk,
 		mk:         mk,
+		authority:  authority,
 	}
 }
 
@@ -57,17 +62,22 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// GetAuthority returns the address allowed to pause and resume claims.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k Keeper) GetModuleAccountBalance(ctx sdk.Context) sdk.Coin {
 	moduleAccAddr := k.ak.GetModuleAddress(types.ModuleName)
 	return k.bk.GetBalance(ctx, moduleAccAddr, types.DefaultClaimDenom)
 }
 
+// CreateModuleAccount creates the clairdrop module account and mints the
+// claimable amount into it. Coins the module account already holds, as in an
+// exported genesis, are not minted again.
 func (k Keeper) CreateModuleAccount(ctx sdk.Context, amount sdk.Coin) {
-	moduleAcc := authtypes.NewEmptyModuleAccount(types.ModuleName, authtypes.Minter)
-
-	k.ak.SetModuleAccount(ctx, moduleAcc)
-
-	mintCoins := sdk.NewCoins(amount)
+	// creates the module account with the permissions of the app if missing
+	k.ak.GetModuleAccount(ctx, types.ModuleName)
 
 	existingModuleAcctBalance := k.bk.GetBalance(
 		ctx,
@@ -75,18 +85,12 @@ func (k Keeper) CreateModuleAccount(ctx sdk.Context, amount sdk.Coin) {
 		amount.Denom,
 	)
 
-	if existingModuleAcctBalance.IsPositive() {
-
-		actual := existingModuleAcctBalance.Add(amount)
-
-		ctx.Logger().Info(fmt.Sprintf(
-			"WARNING! There is a bug in claims on InitGenesis, that you are subject to."+
-				" You likely expect the claims module account balance to be %d %s, but it will actually be %d %s due to this bug.",
-			amount.Amount.Int64(), amount.Denom, actual.Amount.Int64(), actual.Denom))
+	if existingModuleAcctBalance.IsGTE(amount) {
+		return
 	}
 
+	mintCoins := sdk.NewCoins(amount.Sub(existingModuleAcctBalance))
 	if err := k.bk.MintCoins(ctx, types.ModuleName, mintCoins); err != nil {
 		panic(err)
 	}
-
 }
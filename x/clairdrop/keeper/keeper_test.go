@@ -33,8 +33,9 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.app.ClairdropKeeper.SetParams(
 		suite.ctx,
 		types.Params{
-			ClairdropStartTime: airdropStartTime,
-			ClairdropEndTime:   airdropEndTime,
+			ClairdropStartTime:      airdropStartTime,
+			ClairdropEndTime:        airdropEndTime,
+			ClawbackRecordsPerBlock: types.DefaultClawbackRecordsPerBlock,
 		},
 	)
 
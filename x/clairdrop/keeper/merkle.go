@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/galaxynetwork/galaxy/x/clairdrop/types"
+)
+
+// GetMerkleAirdrop returns the allocations committed to by a Merkle root.
+func (k Keeper) GetMerkleAirdrop(ctx sdk.Context) types.MerkleAirdrop {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MerkleAirdropKey)
+	if bz == nil {
+		return types.DefaultMerkleAirdrop()
+	}
+
+	var airdrop types.MerkleAirdrop
+	k.cdc.MustUnmarshal(bz, &airdrop)
+	return airdrop
+}
+
+func (k Keeper) SetMerkleAirdrop(ctx sdk.Context, airdrop types.MerkleAirdrop) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&airdrop)
+	store.Set(types.MerkleAirdropKey, bz)
+}
+
+// SubmitClaimProof creates the claim record of an allocation of the Merkle
+// airdrop from a proof of the allocation and claims its initial action. Each
+// allocation is registered once, and the record is claimed like the genesis
+// claim records afterwards.
+func (k Keeper) SubmitClaimProof(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Coins, proof [][]byte) (sdk.Coins, error) {
+	airdrop := k.GetMerkleAirdrop(ctx)
+	if !airdrop.HasRoot() {
+		return nil, types.ErrNoMerkleAirdrop
+	}
+	if ctx.BlockTime().After(k.GetParams(ctx).ClairdropEndTime) {
+		return nil, types.ErrClairdropEnded
+	}
+
+	record, err := k.GetClaimRecord(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	if record.Address != "" {
+		return nil, sdkerrors.Wrapf(types.ErrClaimRecordExists, "%s", addr)
+	}
+
+	root, err := types.DecodeMerkleHashes([]string{airdrop.Root})
+	if err != nil {
+		return nil, err
+	}
+	if !types.VerifyMerkleProof(root[0], types.MerkleLeaf(addr.String(), amount), proof) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidProof, "allocation %s of %s", amount, addr)
+	}
+
+	registered := airdrop.Registered.AddAmount(amount.AmountOf(types.DefaultClaimDenom))
+	if registered.Amount.GT(airdrop.TotalAllocation.Amount) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidProof, "registered allocations %s exceed the total allocation %s", registered, airdrop.TotalAllocation)
+	}
+	airdrop.Registered = registered
+	k.SetMerkleAirdrop(ctx, airdrop)
+
+	err = k.SetClaimRecord(ctx, types.ClaimRecord{
+		Address:               addr.String(),
+		InitalClaimableAmount: amount,
+		ActionCompleted:       make([]bool, len(types.ClaimAction_name)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitProof,
+			sdk.NewAttribute(sdk.AttributeKeySender, addr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return k.ClaimForAction(ctx, addr, types.InitialClaimAction)
+}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/galaxynetwork/galaxy/x/clairdrop/client/cli"
+	"github.com/galaxynetwork/galaxy/x/clairdrop/keeper"
+	"github.com/galaxynetwork/galaxy/x/clairdrop/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgSubmitClaimProof() {
+	require := suite.Require()
+	clairdropKeeper := suite.app.ClairdropKeeper
+	msgServer := keeper.NewMsgServerImpl(clairdropKeeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1---")),
+		sdk.AccAddress([]byte("addr2---")),
+		sdk.AccAddress([]byte("addr3---")),
+	}
+	tree, err := cli.BuildMerkleTree([]cli.Allocation{
+		{Address: addrs[0].String(), Amount: "1000"},
+		{Address: addrs[1].String(), Amount: "2000uglx"},
+		{Address: addrs[2].String(), Amount: "3000"},
+	})
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(types.DefaultClaimDenom, 6_000), tree.TotalAllocation)
+
+	submit := func(allocation cli.AllocationProof) error {
+		amount, err := sdk.ParseCoinsNormalized(allocation.Amount)
+		require.NoError(err)
+		res, err := msgServer.SubmitClaimProof(ctx, types.NewMsgSubmitClaimProof(allocation.Address, amount, allocation.Proof))
+		if err != nil {
+			return err
+		}
+
+		// the initial action is claimed along with the registration
+		addr, err := sdk.AccAddressFromBech32(allocation.Address)
+		require.NoError(err)
+		initialClaim := sdk.NewCoins(sdk.NewCoin(types.DefaultClaimDenom, amount.AmountOf(types.DefaultClaimDenom).QuoRaw(4)))
+		require.Equal(initialClaim, res.Claimed)
+		require.Equal(initialClaim, suite.app.BankKeeper.GetAllBalances(suite.ctx, addr))
+		return nil
+	}
+
+	require.ErrorIs(submit(tree.Allocations[0]), types.ErrNoMerkleAirdrop)
+
+	airdrop := types.MerkleAirdrop{
+		Root:            tree.Root,
+		TotalAllocation: tree.TotalAllocation,
+		Registered:      sdk.NewInt64Coin(types.DefaultClaimDenom, 0),
+	}
+	clairdropKeeper.SetMerkleAirdrop(suite.ctx, airdrop)
+	clairdropKeeper.SetStats(suite.ctx, types.NewClairdropStats(nil, airdrop))
+
+	// the allocations count as allocated before their records are created
+	require.Equal(sdk.NewCoins(tree.TotalAllocation), clairdropKeeper.GetStats(suite.ctx).TotalAllocated)
+
+	// the proof of another allocation or another amount is rejected
+	forged := tree.Allocations[0]
+	forged.Amount = "5000uglx"
+	require.ErrorIs(submit(forged), types.ErrInvalidProof)
+	forged = tree.Allocations[0]
+	forged.Proof = tree.Allocations[1].Proof
+	require.ErrorIs(submit(forged), types.ErrInvalidProof)
+
+	for _, allocation := range tree.Allocations {
+		require.NoError(submit(allocation))
+
+		addr, err := sdk.AccAddressFromBech32(allocation.Address)
+		require.NoError(err)
+		record, err := clairdropKeeper.GetClaimRecord(suite.ctx, addr)
+		require.NoError(err)
+		require.Equal(allocation.Amount, record.InitalClaimableAmount.String())
+		require.Equal([]bool{true, false, false, false}, record.ActionCompleted)
+	}
+	require.ErrorIs(submit(tree.Allocations[0]), types.ErrClaimRecordExists)
+	require.Equal(tree.TotalAllocation, clairdropKeeper.GetMerkleAirdrop(suite.ctx).Registered)
+	require.Equal(sdk.NewCoins(tree.TotalAllocation), clairdropKeeper.GetStats(suite.ctx).TotalAllocated)
+
+	// the created records are claimed like the genesis claim records
+	clairdropKeeper.AfterProposalVote(suite.ctx, addrs[0])
+	require.Equal(
+		sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 500)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, addrs[0]),
+	)
+
+	// no record is created once the clairdrop ended
+	suite.ctx = suite.ctx.WithBlockTime(clairdropKeeper.GetParams(suite.ctx).ClairdropEndTime.Add(time.Second))
+	_, err = msgServer.SubmitClaimProof(sdk.WrapSDKContext(suite.ctx), types.NewMsgSubmitClaimProof(addrs[0].String(), sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1)), nil))
+	require.ErrorIs(err, types.ErrClairdropEnded)
+}
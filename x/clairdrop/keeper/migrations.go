@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/galaxynetwork/galaxy/x/clairdrop/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the clairdrop state from consensus version 1 to 2. The
+// params added since version 1 are set to their defaults and the stores added
+// since version 1 are seeded, the stats and the index of the claim records
+// from the existing claim records.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	k := m.keeper
+
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !k.paramStore.Has(ctx, pair.Key) {
+			k.paramStore.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+	if err := k.GetParams(ctx).Validate(); err != nil {
+		return err
+	}
+
+	k.SetPause(ctx, k.GetPause(ctx))
+	k.SetMerkleAirdrop(ctx, k.GetMerkleAirdrop(ctx))
+	// the claim records are set again to build their index
+	claimRecords := k.GetClaimRecords(ctx)
+	if err := k.SetClaimRecords(ctx, claimRecords); err != nil {
+		return err
+	}
+	k.SetStats(ctx, types.NewClairdropStats(claimRecords, k.GetMerkleAirdrop(ctx)))
+	k.SetClawback(ctx, k.GetClawback(ctx))
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/galaxynetwork/galaxy/x/clairdrop/keeper"
+	"github.com/galaxynetwork/galaxy/x/clairdrop/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	require := suite.Require()
+	clairdropKeeper := suite.app.ClairdropKeeper
+
+	claimRecords := []types.ClaimRecord{
+		{
+			Address:               sdk.AccAddress([]byte("addr1---")).String(),
+			InitalClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1_000)),
+			ActionCompleted:       []bool{true, false, false, false},
+		},
+		{
+			Address:               sdk.AccAddress([]byte("addr2---")).String(),
+			InitalClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 2_000)),
+			ActionCompleted:       []bool{false, false, false, false},
+		},
+	}
+	require.NoError(clairdropKeeper.SetClaimRecords(suite.ctx, claimRecords))
+
+	// rewind the state to consensus version 1
+	params := clairdropKeeper.GetParams(suite.ctx)
+	paramStore := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	for _, key := range [][]byte{types.KeyAttesters, types.KeyClairdropDecayStartTime, types.KeyClawbackRecordsPerBlock} {
+		paramStore.Delete(key)
+	}
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	for _, key := range [][]byte{types.PauseKey, types.MerkleAirdropKey, types.StatsKey, types.ClawbackKey} {
+		store.Delete(key)
+	}
+	for _, indexPrefix := range [][]byte{types.ClaimRecordStatusIndexPrefix, types.ClaimRecordActionIndexPrefix} {
+		indexStore := prefix.NewStore(store, indexPrefix)
+		iterator := indexStore.Iterator(nil, nil)
+		keys := [][]byte{}
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			indexStore.Delete(key)
+		}
+	}
+
+	require.NoError(keeper.NewMigrator(clairdropKeeper).Migrate1to2(suite.ctx))
+
+	// the new params are set to their defaults and the existing ones kept
+	migrated := clairdropKeeper.GetParams(suite.ctx)
+	require.Equal(params.ClairdropStartTime, migrated.ClairdropStartTime)
+	require.Equal(params.ClairdropEndTime, migrated.ClairdropEndTime)
+	require.Equal(types.DefaultClawbackRecordsPerBlock, migrated.ClawbackRecordsPerBlock)
+	require.NoError(migrated.Validate())
+
+	// the stats are derived from the existing claim records
+	stats := clairdropKeeper.GetStats(suite.ctx)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 3_000)), stats.TotalAllocated)
+	require.Equal(uint64(1), stats.Actions[types.Delegate].Claimants)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 250)), stats.Actions[types.Delegate].Claimed)
+	require.NoError(stats.Validate())
+
+	for _, key := range [][]byte{types.PauseKey, types.MerkleAirdropKey, types.ClawbackKey} {
+		require.True(store.Has(key))
+	}
+	require.Equal(types.ClawbackNotStarted, clairdropKeeper.GetClawback(suite.ctx).State)
+
+	// the claim records are indexed by status and completed action
+	for _, req := range []*types.QueryClaimRecordsRequest{
+		{Status: types.PartiallyClaimed},
+		{CompletedActions: []types.ClaimAction{types.Delegate}},
+	} {
+		res, err := clairdropKeeper.ClaimRecords(sdk.WrapSDKContext(suite.ctx), req)
+		require.NoError(err)
+		require.Len(res.ClaimRecords, 1)
+		require.Equal(claimRecords[0].Address, res.ClaimRecords[0].Address)
+	}
+}
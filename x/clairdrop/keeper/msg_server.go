@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/galaxynetwork/galaxy/x/clairdrop/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the clairdrop MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) SetPaused(goCtx context.Context, msg *types.MsgSetPaused) (*types.MsgSetPausedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the mint guardian can only pause, resuming goes through the authority or a
+	// SetClairdropPausedProposal
+	guardian := k.mk.GetGuardian(ctx)
+	if k.authority != msg.Authority && !(msg.Paused && guardian != "" && guardian == msg.Authority) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := k.Keeper.SetPaused(ctx, msg.Paused); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetPausedResponse{}, nil
+}
+
+func (k msgServer) AttestAction(goCtx context.Context, msg *types.MsgAttestAction) (*types.MsgAttestActionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	attester, err := sdk.AccAddressFromBech32(msg.Attester)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed, err := k.Keeper.AttestAction(ctx, attester, addr, msg.Action)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAttestActionResponse{Claimed: claimed}, nil
+}
+
+func (k msgServer) SubmitClaimProof(goCtx context.Context, msg *types.MsgSubmitClaimProof) (*types.MsgSubmitClaimProofResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := types.DecodeMerkleHashes(msg.Proof)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidProof, "%s", err)
+	}
+
+	claimed, err := k.Keeper.SubmitClaimProof(ctx, sender, msg.Amount, proof)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitClaimProofResponse{Claimed: claimed}, nil
+}
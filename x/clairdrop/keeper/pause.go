@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/galaxynetwork/galaxy/x/clairdrop/types"
+)
+
+// GetPause returns the pause state of claiming and clawback.
+func (k Keeper) GetPause(ctx sdk.Context) types.ClairdropPause {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PauseKey)
+	if bz == nil {
+		return types.ClairdropPause{}
+	}
+
+	var pause types.ClairdropPause
+	k.cdc.MustUnmarshal(bz, &pause)
+	return pause
+}
+
+func (k Keeper) SetPause(ctx sdk.Context, pause types.ClairdropPause) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&pause)
+	store.Set(types.PauseKey, bz)
+}
+
+// IsPaused returns true if claiming and clawback are paused.
+func (k Keeper) IsPaused(ctx sdk.Context) bool {
+	return k.GetPause(ctx).Paused
+}
+
+// SetPaused pauses or resumes claiming and clawback.
+func (k Keeper) SetPaused(ctx sdk.Context, paused bool) error {
+	pause := k.GetPause(ctx)
+	if paused && pause.Paused {
+		return types.ErrAlreadyPaused
+	}
+	if !paused && !pause.Paused {
+		return types.ErrNotPaused
+	}
+
+	pause.Paused = paused
+	if paused {
+		pause.PausedHeight = ctx.BlockHeight()
+		pause.PausedTime = ctx.BlockTime()
+	}
+	k.SetPause(ctx, pause)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetPaused,
+			sdk.NewAttribute(types.AttributeKeyPaused, strconv.FormatBool(paused)),
+		),
+	)
+
+	return nil
+}
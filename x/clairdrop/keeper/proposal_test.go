@@ -0,0 +1,35 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/galaxynetwork/galaxy/x/clairdrop/keeper"
+	"github.com/galaxynetwork/galaxy/x/clairdrop/types"
+)
+
+func (suite *KeeperTestSuite) TestSetClairdropPausedProposal() {
+	require := suite.Require()
+	clairdropKeeper := suite.app.ClairdropKeeper
+	msgServer := keeper.NewMsgServerImpl(clairdropKeeper)
+	guardian := sdk.AccAddress([]byte("guardian")).String()
+
+	mintParams := suite.app.MintKeeper.GetParams(suite.ctx)
+	mintParams.Guardian = guardian
+	suite.app.MintKeeper.SetParams(suite.ctx, mintParams)
+
+	// the guardian pauses but cannot resume
+	_, err := msgServer.SetPaused(sdk.WrapSDKContext(suite.ctx), types.NewMsgSetPaused(guardian, true))
+	require.NoError(err)
+	_, err = msgServer.SetPaused(sdk.WrapSDKContext(suite.ctx), types.NewMsgSetPaused(guardian, false))
+	require.ErrorIs(err, types.ErrInvalidAuthority)
+	require.True(clairdropKeeper.IsPaused(suite.ctx))
+
+	// governance resumes through the clairdrop route of the gov router
+	content := types.NewSetClairdropPausedProposal("title", "description", false)
+	require.NoError(content.ValidateBasic())
+	handler := suite.app.GovKeeper.Router().GetRoute(content.ProposalRoute())
+	require.NoError(handler(suite.ctx, content))
+	require.False(clairdropKeeper.IsPaused(suite.ctx))
+
+	require.ErrorIs(handler(suite.ctx, content), types.ErrNotPaused)
+}
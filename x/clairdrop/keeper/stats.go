@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/galaxynetwork/galaxy/x/clairdrop/types"
+)
+
+// GetStats returns the aggregate progress of the clairdrop.
+func (k Keeper) GetStats(ctx sdk.Context) types.ClairdropStats {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.StatsKey)
+	if bz == nil {
+		return types.DefaultClairdropStats()
+	}
+
+	var stats types.ClairdropStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats
+}
+
+func (k Keeper) SetStats(ctx sdk.Context, stats types.ClairdropStats) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&stats)
+	store.Set(types.StatsKey, bz)
+}
@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/galaxynetwork/galaxy/x/clairdrop/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryClaimRecordsAndStats() {
+	require := suite.Require()
+	clairdropKeeper := suite.app.ClairdropKeeper
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1---")),
+		sdk.AccAddress([]byte("addr2---")),
+		sdk.AccAddress([]byte("addr3---")),
+	}
+	claimRecords := []types.ClaimRecord{
+		{
+			Address:               addrs[0].String(),
+			InitalClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1_000)),
+			ActionCompleted:       []bool{true, true, true, true},
+		},
+		{
+			Address:               addrs[1].String(),
+			InitalClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 2_000)),
+			ActionCompleted:       []bool{true, false, false, false},
+		},
+		{
+			Address:               addrs[2].String(),
+			InitalClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 4_000)),
+			ActionCompleted:       []bool{false, false, false, false},
+		},
+	}
+	require.NoError(clairdropKeeper.SetClaimRecords(suite.ctx, claimRecords))
+	clairdropKeeper.SetStats(suite.ctx, types.NewClairdropStats(claimRecords, types.DefaultMerkleAirdrop()))
+
+	// claiming the vote of the untouched record updates the stats
+	clairdropKeeper.AfterProposalVote(suite.ctx, addrs[2])
+
+	stats, err := clairdropKeeper.Stats(ctx, &types.QueryStatsRequest{})
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 7_000)), stats.Stats.TotalAllocated)
+	require.Equal(types.ClaimActionStats{
+		Action:    types.Delegate,
+		Claimed:   sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 250+500)),
+		Claimants: 2,
+	}, stats.Stats.Actions[types.Delegate])
+	require.Equal(types.ClaimActionStats{
+		Action:    types.Vote,
+		Claimed:   sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 250+1_000)),
+		Claimants: 2,
+	}, stats.Stats.Actions[types.Vote])
+	require.Equal(uint64(1), stats.Stats.Actions[types.Nft].Claimants)
+	require.Equal(sdk.NewInt64Coin(types.DefaultClaimDenom, 1_000_000-1_000), stats.ModuleAccountBalance)
+	require.NoError(stats.Stats.Validate())
+
+	queryAddresses := func(req *types.QueryClaimRecordsRequest) []string {
+		res, err := clairdropKeeper.ClaimRecords(ctx, req)
+		require.NoError(err)
+		addresses := []string{}
+		for _, claimRecord := range res.ClaimRecords {
+			addresses = append(addresses, claimRecord.Address)
+		}
+		return addresses
+	}
+
+	require.Len(queryAddresses(&types.QueryClaimRecordsRequest{}), 3)
+	require.Equal([]string{addrs[0].String()}, queryAddresses(&types.QueryClaimRecordsRequest{Status: types.FullyClaimed}))
+	require.ElementsMatch(
+		[]string{addrs[1].String(), addrs[2].String()},
+		queryAddresses(&types.QueryClaimRecordsRequest{Status: types.PartiallyClaimed}),
+	)
+	require.Empty(queryAddresses(&types.QueryClaimRecordsRequest{Status: types.Untouched}))
+	require.ElementsMatch(
+		[]string{addrs[0].String(), addrs[2].String()},
+		queryAddresses(&types.QueryClaimRecordsRequest{CompletedActions: []types.ClaimAction{types.Vote}}),
+	)
+	require.Equal(
+		[]string{addrs[0].String()},
+		queryAddresses(&types.QueryClaimRecordsRequest{CompletedActions: []types.ClaimAction{types.Vote, types.Nft}}),
+	)
+	require.Equal(
+		[]string{addrs[1].String()},
+		queryAddresses(&types.QueryClaimRecordsRequest{Status: types.PartiallyClaimed, CompletedActions: []types.ClaimAction{types.Delegate}}),
+	)
+
+	// pages hold the given number of matching records
+	res, err := clairdropKeeper.ClaimRecords(ctx, &types.QueryClaimRecordsRequest{
+		Status:     types.PartiallyClaimed,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Len(res.ClaimRecords, 1)
+	require.Equal(uint64(2), res.Pagination.Total)
+
+	_, err = clairdropKeeper.ClaimRecords(ctx, &types.QueryClaimRecordsRequest{Status: 9})
+	require.Error(err)
+}
@@ -0,0 +1,58 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/galaxynetwork/galaxy/x/clairdrop/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding clairdrop type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, []byte(types.ClaimRecordStorePrefix)):
+			var claimRecordA, claimRecordB types.ClaimRecord
+			cdc.MustUnmarshal(kvA.Value, &claimRecordA)
+			cdc.MustUnmarshal(kvB.Value, &claimRecordB)
+			return fmt.Sprintf("%v\n%v", claimRecordA, claimRecordB)
+
+		case bytes.HasPrefix(kvA.Key, types.ClaimRecordStatusIndexPrefix),
+			bytes.HasPrefix(kvA.Key, types.ClaimRecordActionIndexPrefix):
+			// the index entries are keyed by the address after the status or action
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Key[2:]), sdk.AccAddress(kvB.Key[2:]))
+
+		case bytes.Equal(kvA.Key, types.PauseKey):
+			var pauseA, pauseB types.ClairdropPause
+			cdc.MustUnmarshal(kvA.Value, &pauseA)
+			cdc.MustUnmarshal(kvB.Value, &pauseB)
+			return fmt.Sprintf("%v\n%v", pauseA, pauseB)
+
+		case bytes.Equal(kvA.Key, types.MerkleAirdropKey):
+			var airdropA, airdropB types.MerkleAirdrop
+			cdc.MustUnmarshal(kvA.Value, &airdropA)
+			cdc.MustUnmarshal(kvB.Value, &airdropB)
+			return fmt.Sprintf("%v\n%v", airdropA, airdropB)
+
+		case bytes.Equal(kvA.Key, types.StatsKey):
+			var statsA, statsB types.ClairdropStats
+			cdc.MustUnmarshal(kvA.Value, &statsA)
+			cdc.MustUnmarshal(kvB.Value, &statsB)
+			return fmt.Sprintf("%v\n%v", statsA, statsB)
+
+		case bytes.Equal(kvA.Key, types.ClawbackKey):
+			var clawbackA, clawbackB types.ClawbackProgress
+			cdc.MustUnmarshal(kvA.Value, &clawbackA)
+			cdc.MustUnmarshal(kvB.Value, &clawbackB)
+			return fmt.Sprintf("%v\n%v", clawbackA, clawbackB)
+
+		default:
+			panic(fmt.Sprintf("invalid clairdrop key %X", kvA.Key))
+		}
+	}
+}
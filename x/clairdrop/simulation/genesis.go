@@ -0,0 +1,201 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/galaxynetwork/galaxy/x/clairdrop/types"
+)
+
+// Simulation parameter constants
+const (
+	ClairdropDuration = "clairdrop_duration"
+	ClaimRecords      = "claim_records"
+	DecayDuration     = "decay_duration"
+	ClawbackRecords   = "clawback_records_per_block"
+	Attesters         = "attesters"
+)
+
+// GenClairdropDuration randomized length of the clairdrop, short enough for
+// the clairdrop to end during a simulation
+func GenClairdropDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simtypes.RandIntBetween(r, 1, 30*24)) * time.Hour
+}
+
+// GenDecayDuration randomized length of the decay ending with the clairdrop,
+// zero for no decay
+func GenDecayDuration(r *rand.Rand, clairdropDuration time.Duration) time.Duration {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+	return clairdropDuration / time.Duration(simtypes.RandIntBetween(r, 1, 5))
+}
+
+// GenClawbackRecordsPerBlock randomized number of claim records the clawback
+// processes per block, low enough for the clawback to span several blocks
+func GenClawbackRecordsPerBlock(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 10))
+}
+
+// GenAttesters randomized attesters of the attested actions among the
+// simulation accounts
+func GenAttesters(r *rand.Rand, accs []simtypes.Account) []types.Attester {
+	attesters := []types.Attester{}
+	for _, acc := range accs {
+		if r.Intn(10) != 0 {
+			continue
+		}
+
+		actions := []types.ClaimAction{}
+		for _, action := range []types.ClaimAction{types.Story, types.Nft} {
+			if r.Intn(2) == 0 {
+				actions = append(actions, action)
+			}
+		}
+		if len(actions) == 0 {
+			actions = append(actions, types.Story)
+		}
+
+		attesters = append(attesters, types.Attester{
+			Address: acc.Address.String(),
+			Actions: actions,
+		})
+	}
+	return attesters
+}
+
+// MerkleAllocations returns the allocations of the Merkle airdrop of the
+// simulation. They are derived from the addresses of the simulation accounts,
+// so that the operations rebuild the same tree as the genesis.
+func MerkleAllocations(accs []simtypes.Account) ([]simtypes.Account, []sdk.Coins) {
+	var allocated []simtypes.Account
+	var amounts []sdk.Coins
+	for _, acc := range accs {
+		if !isMerkleAccount(acc) {
+			continue
+		}
+		amount := int64(binary.BigEndian.Uint32(acc.Address[len(acc.Address)-4:]))%1_000_000_000 + 1
+		allocated = append(allocated, acc)
+		amounts = append(amounts, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, amount)))
+	}
+	return allocated, amounts
+}
+
+func isMerkleAccount(acc simtypes.Account) bool {
+	return acc.Address[0]%4 == 0
+}
+
+// BuildMerkleAirdrop returns the Merkle airdrop of the allocations and the
+// proof of every allocation.
+func BuildMerkleAirdrop(accs []simtypes.Account, amounts []sdk.Coins) (types.MerkleAirdrop, [][][]byte) {
+	if len(accs) == 0 {
+		return types.DefaultMerkleAirdrop(), nil
+	}
+
+	leaves := make([][]byte, len(accs))
+	total := sdk.NewCoin(types.DefaultClaimDenom, sdk.ZeroInt())
+	for i, acc := range accs {
+		leaves[i] = types.MerkleLeaf(acc.Address.String(), amounts[i])
+		total = total.AddAmount(amounts[i].AmountOf(types.DefaultClaimDenom))
+	}
+	root, proofs := types.BuildMerkleTree(leaves)
+
+	airdrop := types.DefaultMerkleAirdrop()
+	airdrop.Root = hex.EncodeToString(root)
+	airdrop.TotalAllocation = total
+	return airdrop, proofs
+}
+
+// GenClaimRecords randomized claim records of a random subset of the
+// simulation accounts outside of the Merkle airdrop
+func GenClaimRecords(r *rand.Rand, accs []simtypes.Account) []types.ClaimRecord {
+	claimRecords := []types.ClaimRecord{}
+	for _, acc := range accs {
+		if r.Intn(2) == 0 || isMerkleAccount(acc) {
+			continue
+		}
+
+		actionCompleted := make([]bool, len(types.ClaimAction_name))
+		for i := range actionCompleted {
+			actionCompleted[i] = r.Intn(4) == 0
+		}
+
+		claimRecords = append(claimRecords, types.ClaimRecord{
+			Address:               acc.Address.String(),
+			InitalClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, int64(simtypes.RandIntBetween(r, 1, 1_000_000_000)))),
+			ActionCompleted:       actionCompleted,
+		})
+	}
+	return claimRecords
+}
+
+// RandomizedGenState generates a random GenesisState for clairdrop
+func RandomizedGenState(simState *module.SimulationState) {
+	var clairdropDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ClairdropDuration, &clairdropDuration, simState.Rand,
+		func(r *rand.Rand) { clairdropDuration = GenClairdropDuration(r) },
+	)
+
+	var decayDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DecayDuration, &decayDuration, simState.Rand,
+		func(r *rand.Rand) { decayDuration = GenDecayDuration(r, clairdropDuration) },
+	)
+
+	clairdropEndTime := simState.GenTimestamp.Add(clairdropDuration)
+	decayStartTime := time.Time{}
+	if decayDuration > 0 {
+		decayStartTime = clairdropEndTime.Add(-decayDuration)
+	}
+
+	var clawbackRecordsPerBlock uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ClawbackRecords, &clawbackRecordsPerBlock, simState.Rand,
+		func(r *rand.Rand) { clawbackRecordsPerBlock = GenClawbackRecordsPerBlock(r) },
+	)
+
+	var attesters []types.Attester
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, Attesters, &attesters, simState.Rand,
+		func(r *rand.Rand) { attesters = GenAttesters(r, simState.Accounts) },
+	)
+
+	var claimRecords []types.ClaimRecord
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ClaimRecords, &claimRecords, simState.Rand,
+		func(r *rand.Rand) { claimRecords = GenClaimRecords(r, simState.Accounts) },
+	)
+
+	merkleAirdrop, _ := BuildMerkleAirdrop(MerkleAllocations(simState.Accounts))
+
+	totalClaimable := merkleAirdrop.TotalAllocation
+	for _, claimRecord := range claimRecords {
+		totalClaimable = totalClaimable.AddAmount(claimRecord.InitalClaimableAmount.AmountOf(types.DefaultClaimDenom))
+	}
+
+	clairdropGenesis := types.GenesisState{
+		ModuleAccountBalance: totalClaimable,
+		Params:               types.NewParams(simState.GenTimestamp, clairdropEndTime, attesters, decayStartTime, clawbackRecordsPerBlock),
+		ClaimRecords:         claimRecords,
+		MerkleAirdrop:        merkleAirdrop,
+		Stats:                types.NewClairdropStats(claimRecords, merkleAirdrop),
+	}
+
+	bz, err := json.MarshalIndent(&clairdropGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated clairdrop parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&clairdropGenesis)
+}
@@ -0,0 +1,59 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/galaxynetwork/galaxy/x/clairdrop/simulation"
+	"github.com/galaxynetwork/galaxy/x/clairdrop/types"
+)
+
+// TestRandomizedGenState tests that the randomized genesis state of the
+// clairdrop module is always valid.
+func TestRandomizedGenState(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	genTime := time.Unix(1_000_000, 0).UTC()
+
+	r := rand.New(rand.NewSource(1))
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          cdc,
+		Rand:         r,
+		NumBonded:    3,
+		Accounts:     simtypes.RandomAccounts(r, 10),
+		InitialStake: 1000,
+		GenState:     make(map[string]json.RawMessage),
+		GenTimestamp: genTime,
+	}
+
+	simulation.RandomizedGenState(&simState)
+
+	var clairdropGenesis types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &clairdropGenesis)
+
+	require.NoError(t, types.ValidateGenesis(clairdropGenesis))
+	require.NotEmpty(t, clairdropGenesis.ClaimRecords)
+	require.Equal(t, genTime, clairdropGenesis.Params.ClairdropStartTime)
+	require.True(t, clairdropGenesis.Params.ClairdropEndTime.After(genTime))
+
+	// the operations rebuild the proofs of the Merkle airdrop of the genesis
+	allocated, amounts := simulation.MerkleAllocations(simState.Accounts)
+	require.NotEmpty(t, allocated)
+	airdrop, proofs := simulation.BuildMerkleAirdrop(allocated, amounts)
+	require.Equal(t, clairdropGenesis.MerkleAirdrop, airdrop)
+	root, err := types.DecodeMerkleHashes([]string{airdrop.Root})
+	require.NoError(t, err)
+	for i, acc := range allocated {
+		require.True(t, types.VerifyMerkleProof(root[0], types.MerkleLeaf(acc.Address.String(), amounts[i]), proofs[i]))
+	}
+}
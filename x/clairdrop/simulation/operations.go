@@ -0,0 +1,183 @@
+package simulation
+
+import (
+	"encoding/hex"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/galaxynetwork/galaxy/x/clairdrop/keeper"
+	"github.com/galaxynetwork/galaxy/x/clairdrop/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgAttestAction     = "op_weight_msg_attest_action"      //nolint:gosec
+	OpWeightMsgSubmitClaimProof = "op_weight_msg_submit_claim_proof" //nolint:gosec
+
+	DefaultWeightMsgAttestAction     = 50
+	DefaultWeightMsgSubmitClaimProof = 50
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simulation.WeightedOperations {
+	var weightMsgAttestAction int
+	appParams.GetOrGenerate(cdc, OpWeightMsgAttestAction, &weightMsgAttestAction, nil,
+		func(_ *rand.Rand) {
+			weightMsgAttestAction = DefaultWeightMsgAttestAction
+		},
+	)
+
+	var weightMsgSubmitClaimProof int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSubmitClaimProof, &weightMsgSubmitClaimProof, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitClaimProof = DefaultWeightMsgSubmitClaimProof
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgAttestAction,
+			SimulateMsgAttestAction(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSubmitClaimProof,
+			SimulateMsgSubmitClaimProof(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgAttestAction generates a MsgAttestAction of a random attester
+// claiming an action of a random claim record.
+func SimulateMsgAttestAction(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if k.IsPaused(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAttestAction, "claims paused"), nil, nil
+		}
+
+		attesters := k.GetParams(ctx).Attesters
+		if len(attesters) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAttestAction, "no attesters"), nil, nil
+		}
+		attester := attesters[r.Intn(len(attesters))]
+		action := attester.Actions[r.Intn(len(attester.Actions))]
+
+		attesterAddr, err := sdk.AccAddressFromBech32(attester.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAttestAction, "invalid attester address"), nil, err
+		}
+		simAccount, found := simtypes.FindAccount(accs, attesterAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAttestAction, "attester is not a simulation account"), nil, nil
+		}
+
+		claimRecords := k.GetClaimRecords(ctx)
+		if len(claimRecords) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAttestAction, "no claim records"), nil, nil
+		}
+		addr, err := sdk.AccAddressFromBech32(claimRecords[r.Intn(len(claimRecords))].Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAttestAction, "invalid claim record address"), nil, err
+		}
+
+		claimable, err := k.GetClaimableAmountForAction(ctx, addr, action)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAttestAction, "unable to get claimable amount"), nil, err
+		}
+		if claimable.IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAttestAction, "nothing to claim"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		if account == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAttestAction, "attester account does not exist"), nil, nil
+		}
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		msg := types.NewMsgAttestAction(simAccount.Address.String(), addr.String(), action)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgSubmitClaimProof generates a MsgSubmitClaimProof of a random
+// allocation of the Merkle airdrop that is not registered yet.
+func SimulateMsgSubmitClaimProof(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if !k.GetMerkleAirdrop(ctx).HasRoot() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitProof, "no merkle airdrop"), nil, nil
+		}
+		if ctx.BlockTime().After(k.GetParams(ctx).ClairdropEndTime) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitProof, "clairdrop ended"), nil, nil
+		}
+
+		allocated, amounts := MerkleAllocations(accs)
+		_, proofs := BuildMerkleAirdrop(allocated, amounts)
+		if len(allocated) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitProof, "no merkle allocations"), nil, nil
+		}
+		i := r.Intn(len(allocated))
+		simAccount := allocated[i]
+
+		claimRecord, err := k.GetClaimRecord(ctx, simAccount.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitProof, "unable to get claim record"), nil, err
+		}
+		if claimRecord.Address != "" {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitProof, "allocation already registered"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		if account == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitProof, "sender account does not exist"), nil, nil
+		}
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		proof := make([]string, len(proofs[i]))
+		for j, hash := range proofs[i] {
+			proof[j] = hex.EncodeToString(hash)
+		}
+		msg := types.NewMsgSubmitClaimProof(simAccount.Address.String(), amounts[i], proof)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
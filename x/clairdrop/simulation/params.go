@@ -0,0 +1,27 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/galaxynetwork/galaxy/x/clairdrop/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation. The clairdrop times are left out as they are only
+// meaningful relative to the genesis time, and the attesters as they are
+// simulation accounts.
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyClawbackRecordsPerBlock),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenClawbackRecordsPerBlock(r))
+			},
+		),
+	}
+}
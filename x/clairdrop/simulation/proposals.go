@@ -0,0 +1,42 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/galaxynetwork/galaxy/x/clairdrop/keeper"
+	"github.com/galaxynetwork/galaxy/x/clairdrop/types"
+)
+
+// Simulation proposal weights constants
+const (
+	OpWeightSubmitSetClairdropPausedProposal = "op_weight_submit_set_clairdrop_paused_proposal" //nolint:gosec
+
+	DefaultWeightSetClairdropPausedProposal = 5
+)
+
+// ProposalContents defines the module weighted proposals' contents
+func ProposalContents(k keeper.Keeper) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitSetClairdropPausedProposal,
+			DefaultWeightSetClairdropPausedProposal,
+			SimulateSetClairdropPausedProposalContent(k),
+		),
+	}
+}
+
+// SimulateSetClairdropPausedProposalContent generates proposal content pausing
+// the claims, or resuming them while they are paused
+func SimulateSetClairdropPausedProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		return types.NewSetClairdropPausedProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			!k.IsPaused(ctx),
+		)
+	}
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// InitialClaimAction is the action claimed along with the registration of an
+// allocation of the Merkle airdrop, the proof being submitted with the first
+// claim of the allocation.
+const InitialClaimAction = Delegate
+
+// IsAttested returns true if the completion of the action is confirmed by an
+// attester. Delegate and Vote are claimed through the staking and gov hooks.
+func (a ClaimAction) IsAttested() bool {
+	return a == Story || a == Nft
+}
+
+// ParseClaimAction returns the claim action of the given name, case
+// insensitive.
+func ParseClaimAction(name string) (ClaimAction, error) {
+	for value, actionName := range ClaimAction_name {
+		if strings.EqualFold(name, actionName) {
+			return ClaimAction(value), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown claim action: %s", name)
+}
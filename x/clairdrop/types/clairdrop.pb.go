@@ -9,15 +9,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -56,6 +60,79 @@ func (ClaimAction) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_533fbb123bd0afd3, []int{0}
 }
 
+// ClaimRecordStatus defines the progress of a claim record over its actions.
+type ClaimRecordStatus int32
+
+const (
+	// any progress
+	AnyStatus ClaimRecordStatus = 0
+	// all the actions are completed
+	FullyClaimed ClaimRecordStatus = 1
+	// some but not all of the actions are completed
+	PartiallyClaimed ClaimRecordStatus = 2
+	// none of the actions are completed
+	Untouched ClaimRecordStatus = 3
+)
+
+var ClaimRecordStatus_name = map[int32]string{
+	0: "AnyStatus",
+	1: "FullyClaimed",
+	2: "PartiallyClaimed",
+	3: "Untouched",
+}
+
+var ClaimRecordStatus_value = map[string]int32{
+	"AnyStatus":        0,
+	"FullyClaimed":     1,
+	"PartiallyClaimed": 2,
+	"Untouched":        3,
+}
+
+func (x ClaimRecordStatus) String() string {
+	return proto.EnumName(ClaimRecordStatus_name, int32(x))
+}
+
+func (ClaimRecordStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_533fbb123bd0afd3, []int{1}
+}
+
+// ClawbackState defines the stage of the clawback run after the clairdrop end.
+type ClawbackState int32
+
+const (
+	// the clairdrop has not ended
+	ClawbackNotStarted ClawbackState = 0
+	// the balances of the inactive claim record accounts are clawed back
+	ClawbackInProgress ClawbackState = 1
+	// the remainings were funded to the community pool, the claim records are
+	// deleted
+	ClawbackClearing ClawbackState = 2
+	// all the claim records are deleted
+	ClawbackCompleted ClawbackState = 3
+)
+
+var ClawbackState_name = map[int32]string{
+	0: "ClawbackNotStarted",
+	1: "ClawbackInProgress",
+	2: "ClawbackClearing",
+	3: "ClawbackCompleted",
+}
+
+var ClawbackState_value = map[string]int32{
+	"ClawbackNotStarted": 0,
+	"ClawbackInProgress": 1,
+	"ClawbackClearing":   2,
+	"ClawbackCompleted":  3,
+}
+
+func (x ClawbackState) String() string {
+	return proto.EnumName(ClawbackState_name, int32(x))
+}
+
+func (ClawbackState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_533fbb123bd0afd3, []int{2}
+}
+
 type ClaimRecord struct {
 	// address of claim user
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -120,38 +197,427 @@ func (m *ClaimRecord) GetActionCompleted() []bool {
 	return nil
 }
 
+// ClairdropPause defines the pause state of claiming and clawback.
+type ClairdropPause struct {
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	// height of the block claiming was last paused at
+	PausedHeight int64 `protobuf:"varint,2,opt,name=paused_height,json=pausedHeight,proto3" json:"paused_height,omitempty"`
+	// time of the block claiming was last paused at
+	PausedTime time.Time `protobuf:"bytes,3,opt,name=paused_time,json=pausedTime,proto3,stdtime" json:"paused_time"`
+}
+
+func (m *ClairdropPause) Reset()         { *m = ClairdropPause{} }
+func (m *ClairdropPause) String() string { return proto.CompactTextString(m) }
+func (*ClairdropPause) ProtoMessage()    {}
+func (*ClairdropPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_533fbb123bd0afd3, []int{1}
+}
+func (m *ClairdropPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClairdropPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClairdropPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClairdropPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClairdropPause.Merge(m, src)
+}
+func (m *ClairdropPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClairdropPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClairdropPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClairdropPause proto.InternalMessageInfo
+
+func (m *ClairdropPause) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *ClairdropPause) GetPausedHeight() int64 {
+	if m != nil {
+		return m.PausedHeight
+	}
+	return 0
+}
+
+func (m *ClairdropPause) GetPausedTime() time.Time {
+	if m != nil {
+		return m.PausedTime
+	}
+	return time.Time{}
+}
+
+// MerkleAirdrop defines the allocations committed to by a Merkle root. The
+// claim record of an allocation is created on its first claim, with a proof
+// of the allocation.
+type MerkleAirdrop struct {
+	// hex encoded root of the tree of the allocations, no Merkle airdrop when
+	// empty
+	Root string `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// sum of all allocations of the tree
+	TotalAllocation types.Coin `protobuf:"bytes,2,opt,name=total_allocation,json=totalAllocation,proto3" json:"total_allocation"`
+	// sum of the allocations whose claim record was created
+	Registered types.Coin `protobuf:"bytes,3,opt,name=registered,proto3" json:"registered"`
+}
+
+func (m *MerkleAirdrop) Reset()         { *m = MerkleAirdrop{} }
+func (m *MerkleAirdrop) String() string { return proto.CompactTextString(m) }
+func (*MerkleAirdrop) ProtoMessage()    {}
+func (*MerkleAirdrop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_533fbb123bd0afd3, []int{2}
+}
+func (m *MerkleAirdrop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MerkleAirdrop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MerkleAirdrop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MerkleAirdrop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerkleAirdrop.Merge(m, src)
+}
+func (m *MerkleAirdrop) XXX_Size() int {
+	return m.Size()
+}
+func (m *MerkleAirdrop) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerkleAirdrop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerkleAirdrop proto.InternalMessageInfo
+
+func (m *MerkleAirdrop) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
+}
+
+func (m *MerkleAirdrop) GetTotalAllocation() types.Coin {
+	if m != nil {
+		return m.TotalAllocation
+	}
+	return types.Coin{}
+}
+
+func (m *MerkleAirdrop) GetRegistered() types.Coin {
+	if m != nil {
+		return m.Registered
+	}
+	return types.Coin{}
+}
+
+// ClairdropStats defines the aggregate progress of the clairdrop, kept as
+// the claim records are created and claimed.
+type ClairdropStats struct {
+	// sum of the initial claimable amounts of all claim records, including
+	// the allocations of the merkle airdrop whose claim record is not created
+	// yet
+	TotalAllocated github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total_allocated,json=totalAllocated,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_allocated"`
+	// index of the stats in array refers to claim action enum
+	Actions []ClaimActionStats `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions"`
+}
+
+func (m *ClairdropStats) Reset()         { *m = ClairdropStats{} }
+func (m *ClairdropStats) String() string { return proto.CompactTextString(m) }
+func (*ClairdropStats) ProtoMessage()    {}
+func (*ClairdropStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_533fbb123bd0afd3, []int{3}
+}
+func (m *ClairdropStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClairdropStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClairdropStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClairdropStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClairdropStats.Merge(m, src)
+}
+func (m *ClairdropStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClairdropStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClairdropStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClairdropStats proto.InternalMessageInfo
+
+func (m *ClairdropStats) GetTotalAllocated() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalAllocated
+	}
+	return nil
+}
+
+func (m *ClairdropStats) GetActions() []ClaimActionStats {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+// ClaimActionStats defines the claims of an action.
+type ClaimActionStats struct {
+	Action ClaimAction `protobuf:"varint,1,opt,name=action,proto3,enum=galaxy.clairdrop.ClaimAction" json:"action,omitempty"`
+	// sum of the claimed amounts
+	Claimed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=claimed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed"`
+	// number of the claim records with the action completed
+	Claimants uint64 `protobuf:"varint,3,opt,name=claimants,proto3" json:"claimants,omitempty"`
+}
+
+func (m *ClaimActionStats) Reset()         { *m = ClaimActionStats{} }
+func (m *ClaimActionStats) String() string { return proto.CompactTextString(m) }
+func (*ClaimActionStats) ProtoMessage()    {}
+func (*ClaimActionStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_533fbb123bd0afd3, []int{4}
+}
+func (m *ClaimActionStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimActionStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimActionStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimActionStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimActionStats.Merge(m, src)
+}
+func (m *ClaimActionStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimActionStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimActionStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimActionStats proto.InternalMessageInfo
+
+func (m *ClaimActionStats) GetAction() ClaimAction {
+	if m != nil {
+		return m.Action
+	}
+	return Delegate
+}
+
+func (m *ClaimActionStats) GetClaimed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Claimed
+	}
+	return nil
+}
+
+func (m *ClaimActionStats) GetClaimants() uint64 {
+	if m != nil {
+		return m.Claimants
+	}
+	return 0
+}
+
+// ClawbackProgress defines the progress of the clawback, resumed from the
+// cursor in the following block.
+type ClawbackProgress struct {
+	State ClawbackState `protobuf:"varint,1,opt,name=state,proto3,enum=galaxy.clairdrop.ClawbackState" json:"state,omitempty"`
+	// key of the next claim record to process
+	Cursor []byte `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// number of the claim records processed
+	ProcessedRecords uint64 `protobuf:"varint,3,opt,name=processed_records,json=processedRecords,proto3" json:"processed_records,omitempty"`
+	// number of the claim records whose clawback failed
+	FailedRecords uint64 `protobuf:"varint,4,opt,name=failed_records,json=failedRecords,proto3" json:"failed_records,omitempty"`
+	// sum of the balances clawed back from the inactive accounts
+	ClawedBack github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=clawed_back,json=clawedBack,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"clawed_back"`
+	// height of the block the clawback started at
+	StartedHeight int64 `protobuf:"varint,6,opt,name=started_height,json=startedHeight,proto3" json:"started_height,omitempty"`
+	// height of the block the clawback completed at
+	CompletedHeight int64 `protobuf:"varint,7,opt,name=completed_height,json=completedHeight,proto3" json:"completed_height,omitempty"`
+}
+
+func (m *ClawbackProgress) Reset()         { *m = ClawbackProgress{} }
+func (m *ClawbackProgress) String() string { return proto.CompactTextString(m) }
+func (*ClawbackProgress) ProtoMessage()    {}
+func (*ClawbackProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_533fbb123bd0afd3, []int{5}
+}
+func (m *ClawbackProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClawbackProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClawbackProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClawbackProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClawbackProgress.Merge(m, src)
+}
+func (m *ClawbackProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClawbackProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClawbackProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClawbackProgress proto.InternalMessageInfo
+
+func (m *ClawbackProgress) GetState() ClawbackState {
+	if m != nil {
+		return m.State
+	}
+	return ClawbackNotStarted
+}
+
+func (m *ClawbackProgress) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+func (m *ClawbackProgress) GetProcessedRecords() uint64 {
+	if m != nil {
+		return m.ProcessedRecords
+	}
+	return 0
+}
+
+func (m *ClawbackProgress) GetFailedRecords() uint64 {
+	if m != nil {
+		return m.FailedRecords
+	}
+	return 0
+}
+
+func (m *ClawbackProgress) GetClawedBack() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClawedBack
+	}
+	return nil
+}
+
+func (m *ClawbackProgress) GetStartedHeight() int64 {
+	if m != nil {
+		return m.StartedHeight
+	}
+	return 0
+}
+
+func (m *ClawbackProgress) GetCompletedHeight() int64 {
+	if m != nil {
+		return m.CompletedHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("galaxy.clairdrop.ClaimAction", ClaimAction_name, ClaimAction_value)
+	proto.RegisterEnum("galaxy.clairdrop.ClaimRecordStatus", ClaimRecordStatus_name, ClaimRecordStatus_value)
+	proto.RegisterEnum("galaxy.clairdrop.ClawbackState", ClawbackState_name, ClawbackState_value)
 	proto.RegisterType((*ClaimRecord)(nil), "galaxy.clairdrop.ClaimRecord")
+	proto.RegisterType((*ClairdropPause)(nil), "galaxy.clairdrop.ClairdropPause")
+	proto.RegisterType((*MerkleAirdrop)(nil), "galaxy.clairdrop.MerkleAirdrop")
+	proto.RegisterType((*ClairdropStats)(nil), "galaxy.clairdrop.ClairdropStats")
+	proto.RegisterType((*ClaimActionStats)(nil), "galaxy.clairdrop.ClaimActionStats")
+	proto.RegisterType((*ClawbackProgress)(nil), "galaxy.clairdrop.ClawbackProgress")
 }
 
 func init() { proto.RegisterFile("galaxy/clairdrop/clairdrop.proto", fileDescriptor_533fbb123bd0afd3) }
 
 var fileDescriptor_533fbb123bd0afd3 = []byte{
-	// 366 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0x31, 0x8f, 0xd3, 0x30,
-	0x14, 0xc7, 0xe3, 0xa6, 0xd0, 0xd6, 0x45, 0x22, 0xb2, 0x40, 0x84, 0x0e, 0x6e, 0xc4, 0x14, 0x90,
-	0x88, 0x29, 0x7c, 0x00, 0xd4, 0x86, 0x0d, 0x89, 0x21, 0x48, 0x0c, 0x2c, 0x95, 0x93, 0x98, 0x60,
-	0x35, 0xc9, 0x8b, 0x62, 0x17, 0xda, 0xf9, 0x96, 0x1b, 0xef, 0x3b, 0xdc, 0x76, 0x9f, 0xa4, 0x63,
-	0xc7, 0x4e, 0x77, 0xa7, 0xf6, 0x8b, 0x9c, 0x1a, 0xa7, 0x77, 0x9d, 0xfc, 0xde, 0xff, 0x3d, 0xeb,
-	0xe7, 0xbf, 0xff, 0xd8, 0xcb, 0x78, 0xce, 0x57, 0x6b, 0x96, 0xe4, 0x5c, 0xd6, 0x69, 0x0d, 0xd5,
-	0x53, 0x15, 0x54, 0x35, 0x68, 0x20, 0x8e, 0xd9, 0x08, 0x1e, 0xf5, 0xd1, 0xab, 0x0c, 0x32, 0x68,
-	0x86, 0xec, 0x58, 0x99, 0xbd, 0x11, 0x4d, 0x40, 0x15, 0xa0, 0x58, 0xcc, 0x95, 0x60, 0xff, 0x26,
-	0xb1, 0xd0, 0x7c, 0xc2, 0x12, 0x90, 0xa5, 0x99, 0xbf, 0xdb, 0x21, 0x3c, 0x0c, 0x73, 0x2e, 0x8b,
-	0x48, 0x24, 0x50, 0xa7, 0xc4, 0xc5, 0x3d, 0x9e, 0xa6, 0xb5, 0x50, 0xca, 0x45, 0x1e, 0xf2, 0x07,
-	0xd1, 0xa9, 0x25, 0x17, 0x08, 0xbf, 0x91, 0xa5, 0xd4, 0x3c, 0x9f, 0x1f, 0xa1, 0x05, 0x8f, 0x73,
-	0x31, 0xe7, 0x05, 0x2c, 0x4b, 0xed, 0x76, 0x3c, 0xdb, 0x1f, 0x7e, 0x7e, 0x1b, 0x18, 0x58, 0x70,
-	0x84, 0x05, 0x2d, 0x2c, 0x08, 0x41, 0x96, 0xb3, 0x4f, 0x9b, 0xdb, 0xb1, 0x75, 0x73, 0x37, 0xf6,
-	0x33, 0xa9, 0xff, 0x2e, 0xe3, 0x20, 0x81, 0x82, 0xb5, 0x2f, 0x33, 0xc7, 0x47, 0x95, 0x2e, 0x98,
-	0x5e, 0x57, 0x42, 0x35, 0x17, 0x54, 0xf4, 0xda, 0xb0, 0xc2, 0x13, 0x6a, 0xda, 0x90, 0xc8, 0x7b,
-	0xec, 0xf0, 0x44, 0x4b, 0x28, 0xe7, 0x09, 0x14, 0x55, 0x2e, 0xb4, 0x48, 0x5d, 0xdb, 0xb3, 0xfd,
-	0x7e, 0xf4, 0xd2, 0xe8, 0xe1, 0x49, 0xfe, 0xf0, 0xb5, 0x75, 0x36, 0x6d, 0x74, 0xf2, 0x02, 0xf7,
-	0xbf, 0x89, 0x5c, 0x64, 0x5c, 0x0b, 0xc7, 0x22, 0x7d, 0xdc, 0xfd, 0x05, 0x5a, 0x38, 0x88, 0x0c,
-	0xf0, 0xb3, 0x9f, 0x1a, 0xea, 0xb5, 0xd3, 0x21, 0x3d, 0x6c, 0xff, 0xf8, 0xa3, 0x1d, 0x7b, 0xd4,
-	0xbd, 0xbc, 0xa6, 0xd6, 0xec, 0xfb, 0x66, 0x4f, 0xd1, 0x76, 0x4f, 0xd1, 0xfd, 0x9e, 0xa2, 0xab,
-	0x03, 0xb5, 0xb6, 0x07, 0x6a, 0xed, 0x0e, 0xd4, 0xfa, 0x3d, 0x39, 0xb3, 0x61, 0x82, 0x28, 0x85,
-	0xfe, 0x0f, 0xf5, 0xa2, 0xed, 0xd8, 0xea, 0x2c, 0xba, 0xc6, 0x55, 0xfc, 0xbc, 0xf9, 0xef, 0x2f,
-	0x0f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3b, 0xf9, 0x09, 0xaa, 0xdb, 0x01, 0x00, 0x00,
+	// 854 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xda, 0x4e, 0xec, 0x3c, 0xc7, 0xc9, 0x64, 0xd4, 0x16, 0x13, 0x81, 0x6d, 0x19, 0x21,
+	0xb9, 0x45, 0xec, 0x92, 0xa0, 0x9e, 0x2b, 0xdb, 0x80, 0xf8, 0x23, 0xaa, 0x68, 0x03, 0x1c, 0xb8,
+	0x58, 0xe3, 0xdd, 0xc9, 0x7a, 0xe5, 0xd9, 0x1d, 0x6b, 0x66, 0x96, 0xd4, 0x67, 0x2e, 0x1c, 0x7b,
+	0xe0, 0x1b, 0x70, 0xe3, 0xc4, 0xc7, 0xa8, 0x38, 0xa0, 0x1e, 0x7b, 0xa2, 0x28, 0xf9, 0x0c, 0xdc,
+	0xd1, 0xcc, 0xec, 0x38, 0xdb, 0x0a, 0x55, 0x1c, 0xc2, 0xc9, 0xf3, 0x7e, 0xf3, 0x7b, 0xfb, 0xde,
+	0xfb, 0xbd, 0xf7, 0xc6, 0x30, 0x4c, 0x08, 0x23, 0x4f, 0x36, 0x41, 0xc4, 0x48, 0x2a, 0x62, 0xc1,
+	0xd7, 0x37, 0x27, 0x7f, 0x2d, 0xb8, 0xe2, 0x18, 0x59, 0x86, 0xbf, 0xc5, 0x8f, 0xef, 0x24, 0x3c,
+	0xe1, 0xe6, 0x32, 0xd0, 0x27, 0xcb, 0x3b, 0xee, 0x47, 0x5c, 0x66, 0x5c, 0x06, 0x0b, 0x22, 0x69,
+	0xf0, 0xc3, 0xc9, 0x82, 0x2a, 0x72, 0x12, 0x44, 0x3c, 0xcd, 0xcb, 0xfb, 0x41, 0xc2, 0x79, 0xc2,
+	0x68, 0x60, 0xac, 0x45, 0x71, 0x11, 0xa8, 0x34, 0xa3, 0x52, 0x91, 0xac, 0x0c, 0x34, 0x7a, 0xe1,
+	0x41, 0x67, 0xc6, 0x48, 0x9a, 0x85, 0x34, 0xe2, 0x22, 0xc6, 0x3d, 0x68, 0x91, 0x38, 0x16, 0x54,
+	0xca, 0x9e, 0x37, 0xf4, 0xc6, 0x7b, 0xa1, 0x33, 0xf1, 0x8f, 0x1e, 0xbc, 0x95, 0xe6, 0xa9, 0x22,
+	0x6c, 0xae, 0xb3, 0xca, 0xc8, 0x82, 0xd1, 0x39, 0xc9, 0x78, 0x91, 0xab, 0x5e, 0x7d, 0xd8, 0x18,
+	0x77, 0x4e, 0xdf, 0xf6, 0x6d, 0x36, 0xbe, 0xce, 0xc6, 0x2f, 0xb3, 0xf1, 0x67, 0x3c, 0xcd, 0xa7,
+	0x1f, 0x3d, 0xfb, 0x73, 0x50, 0xfb, 0xf5, 0xe5, 0x60, 0x9c, 0xa4, 0x6a, 0x59, 0x2c, 0xfc, 0x88,
+	0x67, 0x41, 0x99, 0xba, 0xfd, 0xf9, 0x50, 0xc6, 0xab, 0x40, 0x6d, 0xd6, 0x54, 0x1a, 0x07, 0x19,
+	0xde, 0xb5, 0xb1, 0x66, 0x2e, 0xd4, 0xc4, 0x44, 0xc2, 0xf7, 0x01, 0x91, 0x48, 0xa5, 0x3c, 0x9f,
+	0x47, 0x3c, 0x5b, 0x33, 0xaa, 0x68, 0xdc, 0x6b, 0x0c, 0x1b, 0xe3, 0x76, 0x78, 0x68, 0xf1, 0x99,
+	0x83, 0x47, 0x3f, 0x7b, 0x70, 0x30, 0x73, 0xfa, 0x9d, 0x91, 0x42, 0x52, 0x7c, 0x0f, 0x76, 0xd7,
+	0xfa, 0x10, 0x9b, 0xe2, 0xda, 0x61, 0x69, 0xe1, 0xf7, 0xa0, 0x6b, 0x4f, 0xf3, 0x25, 0x4d, 0x93,
+	0xa5, 0x2e, 0xc8, 0x1b, 0x37, 0xc2, 0x7d, 0x0b, 0x7e, 0x6e, 0x30, 0xfc, 0x29, 0x74, 0x4a, 0x92,
+	0x16, 0xb1, 0xd7, 0x18, 0x7a, 0xe3, 0xce, 0xe9, 0xb1, 0x6f, 0x15, 0xf6, 0x9d, 0xc2, 0xfe, 0x37,
+	0x4e, 0xe1, 0x69, 0x5b, 0x17, 0xfd, 0xf4, 0xe5, 0xc0, 0x0b, 0xc1, 0x3a, 0xea, 0xab, 0xd1, 0x6f,
+	0x1e, 0x74, 0xbf, 0xa6, 0x62, 0xc5, 0xe8, 0xc4, 0xa6, 0x86, 0x31, 0x34, 0x05, 0xe7, 0xaa, 0x14,
+	0xdc, 0x9c, 0xf1, 0x97, 0x80, 0x14, 0xd7, 0x5a, 0x13, 0xc6, 0x78, 0x44, 0x74, 0x65, 0x26, 0xa9,
+	0x37, 0xaa, 0xdc, 0xd4, 0x01, 0xc3, 0x43, 0xe3, 0x38, 0xd9, 0xfa, 0xe1, 0x47, 0x00, 0x82, 0x26,
+	0xa9, 0x54, 0x54, 0x18, 0xb5, 0xfe, 0xd3, 0x57, 0x2a, 0x2e, 0xa3, 0xdf, 0xab, 0x4a, 0x9e, 0x2b,
+	0xa2, 0x24, 0x56, 0x70, 0xf8, 0x4a, 0x7e, 0x46, 0xd2, 0x5b, 0x1f, 0x82, 0x83, 0x6a, 0x29, 0x34,
+	0xc6, 0x53, 0x68, 0xd9, 0x2e, 0xcb, 0x72, 0xe4, 0x46, 0xfe, 0xeb, 0x8b, 0xe2, 0x9b, 0x89, 0x99,
+	0x18, 0x96, 0x49, 0xb5, 0xac, 0xc7, 0x39, 0x8e, 0xfe, 0xf0, 0x00, 0xbd, 0xce, 0xc1, 0x0f, 0x61,
+	0xd7, 0xde, 0x9b, 0x26, 0x1c, 0x9c, 0xbe, 0xfb, 0xc6, 0xef, 0x86, 0x25, 0x19, 0x53, 0x68, 0x99,
+	0x5d, 0xa0, 0xf1, 0xff, 0xb1, 0x02, 0xee, 0xdb, 0xf8, 0x1d, 0xd8, 0x33, 0x47, 0x92, 0x2b, 0x69,
+	0xfa, 0xd7, 0x0c, 0x6f, 0x80, 0xd1, 0xdf, 0x75, 0x53, 0xd0, 0xe5, 0x82, 0x44, 0xab, 0x33, 0xc1,
+	0x13, 0xb3, 0xad, 0x0f, 0x61, 0x47, 0x2a, 0xa2, 0x68, 0x59, 0xcf, 0xe0, 0x5f, 0xeb, 0x31, 0x2e,
+	0x5a, 0x00, 0x1a, 0x5a, 0xb6, 0x5e, 0x90, 0xa8, 0x10, 0x92, 0x0b, 0x33, 0x6c, 0xfb, 0x61, 0x69,
+	0xe1, 0x0f, 0xe0, 0x68, 0x2d, 0x78, 0x44, 0xa5, 0x1e, 0x7f, 0x61, 0x9e, 0x0a, 0x97, 0x09, 0xda,
+	0x5e, 0xd8, 0x27, 0x44, 0xe2, 0xf7, 0xe1, 0xe0, 0x82, 0xa4, 0xac, 0xc2, 0x6c, 0x1a, 0x66, 0xd7,
+	0xa2, 0x8e, 0xc6, 0xa0, 0x13, 0x31, 0x72, 0x49, 0xe3, 0xb9, 0x4e, 0xa3, 0xb7, 0x73, 0xfb, 0x02,
+	0x82, 0xfd, 0xfe, 0x94, 0x44, 0x2b, 0x9d, 0x94, 0x54, 0x44, 0xa8, 0x9b, 0x1d, 0xdf, 0x35, 0x3b,
+	0xde, 0x2d, 0xd1, 0x72, 0xc9, 0xef, 0x03, 0xda, 0x3e, 0x2c, 0x8e, 0xd8, 0x32, 0xc4, 0xc3, 0x2d,
+	0x6e, 0xa9, 0x0f, 0x1e, 0x41, 0xa7, 0x32, 0x13, 0x78, 0x1f, 0xda, 0x9f, 0x50, 0x46, 0x13, 0xa2,
+	0x28, 0xaa, 0xe1, 0x36, 0x34, 0xbf, 0xe3, 0x8a, 0x22, 0x0f, 0xef, 0xc1, 0xce, 0xb9, 0xe2, 0x62,
+	0x83, 0xea, 0xb8, 0x05, 0x8d, 0xc7, 0x17, 0x0a, 0x35, 0x8e, 0x9b, 0x3f, 0xfd, 0xd2, 0xaf, 0x3d,
+	0x98, 0xc3, 0x51, 0xe5, 0xe9, 0xd5, 0x7d, 0x28, 0x24, 0xee, 0xc2, 0xde, 0x24, 0xdf, 0x58, 0x03,
+	0xd5, 0x30, 0x82, 0xfd, 0xcf, 0x0a, 0xc6, 0x36, 0x33, 0x3b, 0x0a, 0xc8, 0xc3, 0x77, 0x00, 0x9d,
+	0x11, 0xa1, 0x52, 0x52, 0x41, 0xeb, 0xda, 0xed, 0xdb, 0x5c, 0xf1, 0x22, 0x5a, 0xd2, 0x78, 0x1b,
+	0x40, 0x40, 0xf7, 0x95, 0x2e, 0xe3, 0x7b, 0x80, 0x1d, 0xf0, 0x98, 0xab, 0x73, 0x5b, 0x39, 0xaa,
+	0x55, 0xf1, 0x2f, 0x72, 0x37, 0x43, 0x36, 0x96, 0xc3, 0x67, 0x8c, 0x12, 0x91, 0xe6, 0x09, 0xaa,
+	0xe3, 0xbb, 0x70, 0xb4, 0x45, 0x9d, 0x26, 0x2e, 0xe6, 0xf4, 0xab, 0x67, 0x57, 0x7d, 0xef, 0xf9,
+	0x55, 0xdf, 0xfb, 0xeb, 0xaa, 0xef, 0x3d, 0xbd, 0xee, 0xd7, 0x9e, 0x5f, 0xf7, 0x6b, 0x2f, 0xae,
+	0xfb, 0xb5, 0xef, 0x4f, 0x2a, 0x7d, 0xb3, 0xd3, 0x98, 0x53, 0x75, 0xc9, 0xc5, 0xaa, 0xb4, 0x82,
+	0x27, 0x95, 0x3f, 0x44, 0xd3, 0xc6, 0xc5, 0xae, 0x79, 0x55, 0x3f, 0xfe, 0x67, 0x00, 0xa8, 0x45,
+	0x3c, 0xc1, 0x31, 0x07, 0x00, 0x00,
 }
 
 func (m *ClaimRecord) Marshal() (dAtA []byte, err error) {
@@ -211,37 +677,410 @@ func (m *ClaimRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintClairdrop(dAtA []byte, offset int, v uint64) int {
-	offset -= sovClairdrop(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ClairdropPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ClaimRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ClairdropPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClairdropPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovClairdrop(uint64(l))
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PausedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PausedTime):])
+	if err1 != nil {
+		return 0, err1
 	}
-	if len(m.InitalClaimableAmount) > 0 {
-		for _, e := range m.InitalClaimableAmount {
-			l = e.Size()
-			n += 1 + l + sovClairdrop(uint64(l))
+	i -= n1
+	i = encodeVarintClairdrop(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.PausedHeight != 0 {
+		i = encodeVarintClairdrop(dAtA, i, uint64(m.PausedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.ActionCompleted) > 0 {
-		n += 1 + sovClairdrop(uint64(len(m.ActionCompleted))) + len(m.ActionCompleted)*1
+	return len(dAtA) - i, nil
+}
+
+func (m *MerkleAirdrop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *MerkleAirdrop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MerkleAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Registered.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClairdrop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.TotalAllocation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClairdrop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintClairdrop(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClairdropStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClairdropStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClairdropStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClairdrop(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TotalAllocated) > 0 {
+		for iNdEx := len(m.TotalAllocated) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalAllocated[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClairdrop(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimActionStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimActionStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimActionStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Claimants != 0 {
+		i = encodeVarintClairdrop(dAtA, i, uint64(m.Claimants))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Claimed) > 0 {
+		for iNdEx := len(m.Claimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClairdrop(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Action != 0 {
+		i = encodeVarintClairdrop(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClawbackProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClawbackProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClawbackProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletedHeight != 0 {
+		i = encodeVarintClairdrop(dAtA, i, uint64(m.CompletedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartedHeight != 0 {
+		i = encodeVarintClairdrop(dAtA, i, uint64(m.StartedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ClawedBack) > 0 {
+		for iNdEx := len(m.ClawedBack) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClawedBack[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClairdrop(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.FailedRecords != 0 {
+		i = encodeVarintClairdrop(dAtA, i, uint64(m.FailedRecords))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ProcessedRecords != 0 {
+		i = encodeVarintClairdrop(dAtA, i, uint64(m.ProcessedRecords))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintClairdrop(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.State != 0 {
+		i = encodeVarintClairdrop(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintClairdrop(dAtA []byte, offset int, v uint64) int {
+	offset -= sovClairdrop(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClaimRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovClairdrop(uint64(l))
+	}
+	if len(m.InitalClaimableAmount) > 0 {
+		for _, e := range m.InitalClaimableAmount {
+			l = e.Size()
+			n += 1 + l + sovClairdrop(uint64(l))
+		}
+	}
+	if len(m.ActionCompleted) > 0 {
+		n += 1 + sovClairdrop(uint64(len(m.ActionCompleted))) + len(m.ActionCompleted)*1
+	}
+	return n
+}
+
+func (m *ClairdropPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	if m.PausedHeight != 0 {
+		n += 1 + sovClairdrop(uint64(m.PausedHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PausedTime)
+	n += 1 + l + sovClairdrop(uint64(l))
+	return n
+}
+
+func (m *MerkleAirdrop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovClairdrop(uint64(l))
+	}
+	l = m.TotalAllocation.Size()
+	n += 1 + l + sovClairdrop(uint64(l))
+	l = m.Registered.Size()
+	n += 1 + l + sovClairdrop(uint64(l))
+	return n
+}
+
+func (m *ClairdropStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalAllocated) > 0 {
+		for _, e := range m.TotalAllocated {
+			l = e.Size()
+			n += 1 + l + sovClairdrop(uint64(l))
+		}
+	}
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovClairdrop(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClaimActionStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != 0 {
+		n += 1 + sovClairdrop(uint64(m.Action))
+	}
+	if len(m.Claimed) > 0 {
+		for _, e := range m.Claimed {
+			l = e.Size()
+			n += 1 + l + sovClairdrop(uint64(l))
+		}
+	}
+	if m.Claimants != 0 {
+		n += 1 + sovClairdrop(uint64(m.Claimants))
+	}
+	return n
+}
+
+func (m *ClawbackProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovClairdrop(uint64(m.State))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovClairdrop(uint64(l))
+	}
+	if m.ProcessedRecords != 0 {
+		n += 1 + sovClairdrop(uint64(m.ProcessedRecords))
+	}
+	if m.FailedRecords != 0 {
+		n += 1 + sovClairdrop(uint64(m.FailedRecords))
+	}
+	if len(m.ClawedBack) > 0 {
+		for _, e := range m.ClawedBack {
+			l = e.Size()
+			n += 1 + l + sovClairdrop(uint64(l))
+		}
+	}
+	if m.StartedHeight != 0 {
+		n += 1 + sovClairdrop(uint64(m.StartedHeight))
+	}
+	if m.CompletedHeight != 0 {
+		n += 1 + sovClairdrop(uint64(m.CompletedHeight))
+	}
+	return n
 }
 
 func sovClairdrop(x uint64) (n int) {
@@ -436,6 +1275,729 @@ func (m *ClaimRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClairdropPause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClairdrop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClairdropPause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClairdropPause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClairdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedHeight", wireType)
+			}
+			m.PausedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClairdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PausedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClairdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClairdrop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClairdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PausedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClairdrop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClairdrop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MerkleAirdrop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClairdrop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MerkleAirdrop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MerkleAirdrop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClairdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClairdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClairdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAllocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClairdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClairdrop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClairdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registered", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClairdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClairdrop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClairdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Registered.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClairdrop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClairdrop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClairdropStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClairdrop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClairdropStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClairdropStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAllocated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClairdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClairdrop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClairdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalAllocated = append(m.TotalAllocated, types.Coin{})
+			if err := m.TotalAllocated[len(m.TotalAllocated)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClairdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClairdrop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClairdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, ClaimActionStats{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClairdrop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClairdrop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimActionStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClairdrop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimActionStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimActionStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClairdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= ClaimAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClairdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClairdrop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClairdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimed = append(m.Claimed, types.Coin{})
+			if err := m.Claimed[len(m.Claimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimants", wireType)
+			}
+			m.Claimants = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClairdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Claimants |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClairdrop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClairdrop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClawbackProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClairdrop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClawbackProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClawbackProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClairdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= ClawbackState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClairdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClairdrop
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClairdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = append(m.Cursor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Cursor == nil {
+				m.Cursor = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedRecords", wireType)
+			}
+			m.ProcessedRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClairdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProcessedRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedRecords", wireType)
+			}
+			m.FailedRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClairdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawedBack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClairdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClairdrop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClairdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawedBack = append(m.ClawedBack, types.Coin{})
+			if err := m.ClawedBack[len(m.ClawedBack)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedHeight", wireType)
+			}
+			m.StartedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClairdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedHeight", wireType)
+			}
+			m.CompletedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClairdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClairdrop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClairdrop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipClairdrop(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultClawbackProgress returns the progress of a clawback not started.
+func DefaultClawbackProgress() ClawbackProgress {
+	return ClawbackProgress{
+		State:      ClawbackNotStarted,
+		ClawedBack: nil,
+	}
+}
+
+// IsCompleted returns true if all the claim records were processed and
+// deleted.
+func (c ClawbackProgress) IsCompleted() bool {
+	return c.State == ClawbackCompleted
+}
+
+// HasFundedRemainings returns true if the balance of the module account was
+// funded to the community pool, after which the claim records are deleted.
+func (c ClawbackProgress) HasFundedRemainings() bool {
+	return c.State == ClawbackClearing || c.State == ClawbackCompleted
+}
+
+func (c ClawbackProgress) Validate() error {
+	if _, ok := ClawbackState_name[int32(c.State)]; !ok {
+		return fmt.Errorf("invalid clawback state %d", c.State)
+	}
+	if err := c.ClawedBack.Validate(); err != nil {
+		return fmt.Errorf("invalid clawed back amount: %w", err)
+	}
+	if c.FailedRecords > c.ProcessedRecords {
+		return fmt.Errorf("failed records %d exceed processed records %d", c.FailedRecords, c.ProcessedRecords)
+	}
+	if c.State == ClawbackNotStarted && (c.ProcessedRecords > 0 || len(c.Cursor) > 0) {
+		return fmt.Errorf("clawback not started with processed records")
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgSetPaused{}, "galaxy/clairdrop/MsgSetPaused", nil)
+	cdc.RegisterConcrete(&MsgAttestAction{}, "galaxy/clairdrop/MsgAttestAction", nil)
+	cdc.RegisterConcrete(&MsgSubmitClaimProof{}, "galaxy/clairdrop/MsgSubmitClaimProof", nil)
+	cdc.RegisterConcrete(&SetClairdropPausedProposal{}, "galaxy/clairdrop/SetClairdropPausedProposal", nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgSetPaused{},
+		&MsgAttestAction{},
+		&MsgSubmitClaimProof{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&SetClairdropPausedProposal{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	Amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+)
+
+func init() {
+	RegisterLegacyAminoCodec(Amino)
+	cryptocodec.RegisterCrypto(Amino)
+	Amino.Seal()
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/clairdrop module sentinel errors
+var (
+	ErrInvalidAuthority  = sdkerrors.Register(ModuleName, 2, "invalid authority")
+	ErrAlreadyPaused     = sdkerrors.Register(ModuleName, 3, "already paused")
+	ErrNotPaused         = sdkerrors.Register(ModuleName, 4, "not paused")
+	ErrInvalidAttester   = sdkerrors.Register(ModuleName, 5, "invalid attester")
+	ErrNotAttested       = sdkerrors.Register(ModuleName, 6, "action cannot be attested")
+	ErrClaimsPaused      = sdkerrors.Register(ModuleName, 7, "claims are paused")
+	ErrNothingToClaim    = sdkerrors.Register(ModuleName, 8, "nothing to claim")
+	ErrNoMerkleAirdrop   = sdkerrors.Register(ModuleName, 9, "no merkle airdrop")
+	ErrInvalidProof      = sdkerrors.Register(ModuleName, 10, "invalid merkle proof")
+	ErrClaimRecordExists = sdkerrors.Register(ModuleName, 11, "claim record already exists")
+	ErrClairdropEnded    = sdkerrors.Register(ModuleName, 12, "clairdrop ended")
+)
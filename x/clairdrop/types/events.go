@@ -1,5 +1,20 @@
 package types
 
 const (
-	EventTypeClaim = "claim"
+	EventTypeClaim        = "claim"
+	EventTypeSetPaused    = "set_paused"
+	EventTypeAttestAction = "attest_action"
+	EventTypeSubmitProof  = "submit_claim_proof"
+
+	EventTypeClawbackProgress = "clawback_progress"
+	EventTypeClawbackFailed   = "clawback_failed"
+
+	AttributeKeyPaused   = "paused"
+	AttributeKeyAction   = "action"
+	AttributeKeyAttester = "attester"
+
+	AttributeKeyState            = "state"
+	AttributeKeyProcessedRecords = "processed_records"
+	AttributeKeyFailedRecords    = "failed_records"
+	AttributeKeyReason           = "reason"
 )
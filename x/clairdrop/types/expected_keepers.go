@@ -16,6 +16,7 @@ type AccountKeeper interface {
 
 type BankKeeper interface {
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 }
@@ -26,4 +27,5 @@ type DistributionKeeper interface {
 
 type MintKeeper interface {
 	GetDeveloperAddress(ctx sdk.Context) []string
+	GetGuardian(ctx sdk.Context) string
 }
@@ -11,6 +11,10 @@ func DefaultGenesisState() *GenesisState {
 		ModuleAccountBalance: sdk.NewCoin(DefaultClaimDenom, sdk.ZeroInt()),
 		Params:               DefaultParams(),
 		ClaimRecords:         []ClaimRecord{},
+		Pause:                ClairdropPause{},
+		MerkleAirdrop:        DefaultMerkleAirdrop(),
+		Stats:                DefaultClairdropStats(),
+		Clawback:             DefaultClawbackProgress(),
 	}
 }
 
@@ -23,6 +27,14 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("denom for module and claim does not match")
 	}
 
+	if err := data.MerkleAirdrop.Validate(); err != nil {
+		return err
+	}
+
+	if err := data.Clawback.Validate(); err != nil {
+		return err
+	}
+
 	totalClaimable := sdk.Coins{}
 
 	for index, claimRecord := range data.ClaimRecords {
@@ -32,8 +44,29 @@ func ValidateGenesis(data GenesisState) error {
 		totalClaimable = totalClaimable.Add(claimRecord.InitalClaimableAmount...)
 	}
 
+	// only the sum of the allocations of the merkle airdrop is known before
+	// their claim records are created
+	totalClaimable = totalClaimable.Add(data.MerkleAirdrop.Unregistered()...)
+
+	// the stats are derived from the claim records when missing, and outlive
+	// the claim records deleted by the clawback
+	if !data.Stats.IsEmpty() {
+		if err := data.Stats.Validate(); err != nil {
+			return err
+		}
+		if !data.Clawback.HasFundedRemainings() && !data.Stats.TotalAllocated.IsEqual(totalClaimable) {
+			return fmt.Errorf("total allocated of stats %s != sum of all claim record InitialClaimableAmounts and unregistered merkle allocations %s", data.Stats.TotalAllocated, totalClaimable)
+		}
+	}
+
+	// the module account balance was funded to the community pool, and the
+	// claim records left are being deleted
+	if data.Clawback.HasFundedRemainings() {
+		return nil
+	}
+
 	if !totalClaimable.IsEqual(sdk.NewCoins(data.ModuleAccountBalance)) {
-		return fmt.Errorf("claim module account balance != sum of all claim record InitialClaimableAmounts")
+		return fmt.Errorf("claim module account balance != sum of all claim record InitialClaimableAmounts and unregistered merkle allocations")
 	}
 
 	return nil
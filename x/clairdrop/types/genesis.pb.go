@@ -25,9 +25,13 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	ModuleAccountBalance types.Coin    `protobuf:"bytes,1,opt,name=module_account_balance,json=moduleAccountBalance,proto3" json:"module_account_balance"`
-	Params               Params        `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
-	ClaimRecords         []ClaimRecord `protobuf:"bytes,3,rep,name=claim_records,json=claimRecords,proto3" json:"claim_records"`
+	ModuleAccountBalance types.Coin       `protobuf:"bytes,1,opt,name=module_account_balance,json=moduleAccountBalance,proto3" json:"module_account_balance"`
+	Params               Params           `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	ClaimRecords         []ClaimRecord    `protobuf:"bytes,3,rep,name=claim_records,json=claimRecords,proto3" json:"claim_records"`
+	Pause                ClairdropPause   `protobuf:"bytes,4,opt,name=pause,proto3" json:"pause"`
+	MerkleAirdrop        MerkleAirdrop    `protobuf:"bytes,5,opt,name=merkle_airdrop,json=merkleAirdrop,proto3" json:"merkle_airdrop"`
+	Stats                ClairdropStats   `protobuf:"bytes,6,opt,name=stats,proto3" json:"stats"`
+	Clawback             ClawbackProgress `protobuf:"bytes,7,opt,name=clawback,proto3" json:"clawback"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +88,34 @@ func (m *GenesisState) GetClaimRecords() []ClaimRecord {
 	return nil
 }
 
+func (m *GenesisState) GetPause() ClairdropPause {
+	if m != nil {
+		return m.Pause
+	}
+	return ClairdropPause{}
+}
+
+func (m *GenesisState) GetMerkleAirdrop() MerkleAirdrop {
+	if m != nil {
+		return m.MerkleAirdrop
+	}
+	return MerkleAirdrop{}
+}
+
+func (m *GenesisState) GetStats() ClairdropStats {
+	if m != nil {
+		return m.Stats
+	}
+	return ClairdropStats{}
+}
+
+func (m *GenesisState) GetClawback() ClawbackProgress {
+	if m != nil {
+		return m.Clawback
+	}
+	return ClawbackProgress{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "galaxy.clairdrop.GenesisState")
 }
@@ -91,27 +123,33 @@ func init() {
 func init() { proto.RegisterFile("galaxy/clairdrop/genesis.proto", fileDescriptor_991fd59c5efdf6c6) }
 
 var fileDescriptor_991fd59c5efdf6c6 = []byte{
-	// 318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0x41, 0x4b, 0xfb, 0x30,
-	0x18, 0xc6, 0x9b, 0xff, 0xfe, 0xec, 0xd0, 0x4d, 0x90, 0x32, 0xa4, 0x0e, 0x16, 0x87, 0xa7, 0x9d,
-	0x12, 0x36, 0xc1, 0xbb, 0xdb, 0x41, 0xc1, 0x8b, 0x4c, 0xbc, 0x78, 0x19, 0x69, 0x16, 0x6a, 0xb1,
-	0xcd, 0x5b, 0x92, 0x54, 0xb7, 0x6f, 0xe1, 0xc7, 0xda, 0x71, 0x47, 0x4f, 0x22, 0xed, 0xc1, 0xaf,
-	0x21, 0x6d, 0xc2, 0x14, 0x7b, 0x7b, 0x93, 0xe7, 0xf9, 0x3d, 0xef, 0xcb, 0xe3, 0xe3, 0x98, 0xa5,
-	0x6c, 0xb3, 0xa5, 0x3c, 0x65, 0x89, 0x5a, 0x2b, 0xc8, 0x69, 0x2c, 0xa4, 0xd0, 0x89, 0x26, 0xb9,
-	0x02, 0x03, 0xc1, 0xb1, 0xd5, 0xc9, 0x41, 0x1f, 0x8e, 0x5b, 0xc4, 0x61, 0xb2, 0xcc, 0x70, 0xd4,
-	0x72, 0xe4, 0x4c, 0xb1, 0xcc, 0x45, 0x0e, 0x31, 0x07, 0x9d, 0x81, 0xa6, 0x11, 0xd3, 0x82, 0xbe,
-	0x4c, 0x23, 0x61, 0xd8, 0x94, 0x72, 0x48, 0xa4, 0xd3, 0x07, 0x31, 0xc4, 0xd0, 0x8c, 0xb4, 0x9e,
-	0xec, 0xef, 0xf9, 0x17, 0xf2, 0xfb, 0xd7, 0xf6, 0xb4, 0x7b, 0xc3, 0x8c, 0x08, 0x1e, 0xfc, 0x93,
-	0x0c, 0xd6, 0x45, 0x2a, 0x56, 0x8c, 0x73, 0x28, 0xa4, 0x59, 0x45, 0x2c, 0x65, 0x92, 0x8b, 0x10,
-	0x8d, 0xd1, 0xa4, 0x37, 0x3b, 0x25, 0x76, 0x0f, 0xa9, 0xf7, 0x10, 0xb7, 0x87, 0x2c, 0x20, 0x91,
-	0xf3, 0xff, 0xbb, 0x8f, 0x33, 0x6f, 0x39, 0xb0, 0xf8, 0x95, 0xa5, 0xe7, 0x16, 0x0e, 0x2e, 0xfd,
-	0xae, 0xbd, 0x36, 0xfc, 0xd7, 0xc4, 0x84, 0xe4, 0x6f, 0x03, 0xe4, 0xae, 0xd1, 0x5d, 0x8a, 0x73,
-	0x07, 0x37, 0xfe, 0x51, 0xed, 0xc8, 0x56, 0x4a, 0x70, 0x50, 0x6b, 0x1d, 0x76, 0xc6, 0x9d, 0x49,
-	0x6f, 0x36, 0x6a, 0xe3, 0x8b, 0xda, 0xb6, 0x6c, 0x5c, 0x2e, 0xa3, 0xcf, 0x7f, 0xbe, 0xf4, 0xfc,
-	0x76, 0x57, 0x62, 0xb4, 0x2f, 0x31, 0xfa, 0x2c, 0x31, 0x7a, 0xab, 0xb0, 0xb7, 0xaf, 0xb0, 0xf7,
-	0x5e, 0x61, 0xef, 0x71, 0x1a, 0x27, 0xe6, 0xa9, 0x88, 0x08, 0x87, 0x8c, 0xda, 0x58, 0x29, 0xcc,
-	0x2b, 0xa8, 0x67, 0xf7, 0xa2, 0x9b, 0x5f, 0x9d, 0x9b, 0x6d, 0x2e, 0x74, 0xd4, 0x6d, 0xda, 0xbb,
-	0xf8, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xb5, 0x3e, 0xf3, 0xdd, 0xe8, 0x01, 0x00, 0x00,
+	// 412 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
+	0x14, 0xc6, 0x13, 0x7b, 0x6f, 0x95, 0xb9, 0xf7, 0x8a, 0x84, 0x8b, 0x8c, 0x85, 0x3b, 0x0d, 0x5d,
+	0x75, 0x35, 0x43, 0x2b, 0xb8, 0x72, 0xd3, 0x56, 0x50, 0x50, 0xa1, 0x54, 0xdc, 0xb8, 0x29, 0x93,
+	0xe9, 0x10, 0x43, 0x93, 0x4c, 0x98, 0x99, 0xd8, 0xf6, 0x2d, 0x7c, 0xac, 0x2e, 0xbb, 0x74, 0x25,
+	0xd2, 0x3e, 0x84, 0x5b, 0x99, 0x3f, 0xad, 0xc5, 0x14, 0xdc, 0x9d, 0x9c, 0xf3, 0x7d, 0xbf, 0xf3,
+	0x71, 0x32, 0x00, 0xa5, 0x34, 0xa7, 0xeb, 0x0d, 0x61, 0x39, 0xcd, 0xe4, 0x42, 0x8a, 0x8a, 0xa4,
+	0xbc, 0xe4, 0x2a, 0x53, 0xb8, 0x92, 0x42, 0x8b, 0xe8, 0x99, 0x9b, 0xe3, 0xd3, 0xbc, 0x13, 0x37,
+	0x1c, 0xa7, 0xca, 0x79, 0x3a, 0x0f, 0x0d, 0x45, 0x45, 0x25, 0x2d, 0x3c, 0xb2, 0x83, 0x98, 0x50,
+	0x85, 0x50, 0x24, 0xa1, 0x8a, 0x93, 0x6f, 0x83, 0x84, 0x6b, 0x3a, 0x20, 0x4c, 0x64, 0xa5, 0x9f,
+	0xdf, 0xa7, 0x22, 0x15, 0xb6, 0x24, 0xa6, 0x72, 0xdd, 0xde, 0xef, 0x16, 0xb8, 0x7d, 0xeb, 0xa2,
+	0x7d, 0xd2, 0x54, 0xf3, 0xe8, 0x33, 0x78, 0x5e, 0x88, 0x45, 0x9d, 0xf3, 0x39, 0x65, 0x4c, 0xd4,
+	0xa5, 0x9e, 0x27, 0x34, 0xa7, 0x25, 0xe3, 0x30, 0x8c, 0xc3, 0xfe, 0xcd, 0xf0, 0x05, 0x76, 0x7b,
+	0xb0, 0xd9, 0x83, 0xfd, 0x1e, 0x3c, 0x11, 0x59, 0x39, 0xbe, 0xda, 0xfe, 0xec, 0x06, 0xb3, 0x7b,
+	0x67, 0x1f, 0x39, 0xf7, 0xd8, 0x99, 0xa3, 0x57, 0xa0, 0xed, 0xd2, 0xc2, 0x47, 0x16, 0x03, 0xf1,
+	0xbf, 0x17, 0xc0, 0x53, 0x3b, 0xf7, 0x14, 0xaf, 0x8e, 0xde, 0x81, 0x3b, 0xa3, 0x28, 0xe6, 0x92,
+	0x33, 0x21, 0x17, 0x0a, 0xb6, 0xe2, 0x56, 0xff, 0x66, 0xf8, 0xd0, 0xb4, 0x4f, 0x8c, 0x6c, 0x66,
+	0x55, 0x9e, 0x71, 0xcb, 0xfe, 0xb6, 0x54, 0xf4, 0x1a, 0x5c, 0x57, 0xb4, 0x56, 0x1c, 0x5e, 0xd9,
+	0x00, 0xf1, 0x65, 0x82, 0xad, 0xa6, 0x46, 0xe7, 0x21, 0xce, 0x14, 0x7d, 0x00, 0x4f, 0x0b, 0x2e,
+	0x97, 0xe6, 0x2c, 0x4e, 0x03, 0xaf, 0x2d, 0xa6, 0xdb, 0xc4, 0x7c, 0xb4, 0xba, 0x91, 0xfb, 0xf2,
+	0x94, 0xbb, 0xe2, 0xbc, 0x69, 0xb2, 0x28, 0x4d, 0xb5, 0x82, 0xed, 0xff, 0x66, 0x31, 0x7f, 0xe5,
+	0x78, 0x14, 0x67, 0x8a, 0xde, 0x80, 0x27, 0x2c, 0xa7, 0xab, 0x84, 0xb2, 0x25, 0x7c, 0x6c, 0x01,
+	0xbd, 0x8b, 0x00, 0xab, 0x98, 0x4a, 0x91, 0x4a, 0xae, 0x8e, 0x88, 0x93, 0x73, 0xfc, 0x7e, 0xbb,
+	0x47, 0xe1, 0x6e, 0x8f, 0xc2, 0x5f, 0x7b, 0x14, 0x7e, 0x3f, 0xa0, 0x60, 0x77, 0x40, 0xc1, 0x8f,
+	0x03, 0x0a, 0xbe, 0x0c, 0xd2, 0x4c, 0x7f, 0xad, 0x13, 0xcc, 0x44, 0x41, 0x1c, 0xb7, 0xe4, 0x7a,
+	0x25, 0xe4, 0xd2, 0x7f, 0x91, 0xf5, 0xd9, 0x1b, 0xd4, 0x9b, 0x8a, 0xab, 0xa4, 0x6d, 0x5f, 0xd3,
+	0xcb, 0x3f, 0x03, 0x00, 0x61, 0x79, 0x70, 0xe3, 0xf8, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -134,6 +172,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Clawback.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.MerkleAirdrop.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Pause.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.ClaimRecords) > 0 {
 		for iNdEx := len(m.ClaimRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -198,6 +276,14 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Pause.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MerkleAirdrop.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Stats.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Clawback.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -336,6 +422,138 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleAirdrop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MerkleAirdrop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clawback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Clawback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,29 @@
 package types
 
-var MinterKey = []byte{0x00}
+var (
+	MinterKey = []byte{0x00}
+
+	// PauseKey is the key of the pause state of claiming and clawback
+	PauseKey = []byte{0x01}
+
+	// MerkleAirdropKey is the key of the allocations committed to by a Merkle
+	// root
+	MerkleAirdropKey = []byte{0x02}
+
+	// StatsKey is the key of the aggregate progress of the clairdrop
+	StatsKey = []byte{0x03}
+
+	// ClawbackKey is the key of the progress of the clawback
+	ClawbackKey = []byte{0x04}
+
+	// ClaimRecordStatusIndexPrefix is the prefix of the index of the claim
+	// records by status
+	ClaimRecordStatusIndexPrefix = []byte{0x05}
+
+	// ClaimRecordActionIndexPrefix is the prefix of the index of the claim
+	// records by completed action
+	ClaimRecordActionIndexPrefix = []byte{0x06}
+)
 
 const (
 	// ModuleName defines the module name
@@ -23,3 +46,15 @@ const (
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+// ClaimRecordStatusIndexKey returns the prefix of the index entries of the
+// claim records of the given status, followed by their address.
+func ClaimRecordStatusIndexKey(status ClaimRecordStatus) []byte {
+	return append(append([]byte{}, ClaimRecordStatusIndexPrefix...), byte(status))
+}
+
+// ClaimRecordActionIndexKey returns the prefix of the index entries of the
+// claim records that completed the given action, followed by their address.
+func ClaimRecordActionIndexKey(action ClaimAction) []byte {
+	return append(append([]byte{}, ClaimRecordActionIndexPrefix...), byte(action))
+}
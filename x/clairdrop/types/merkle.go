@@ -0,0 +1,162 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Prefixes of the hashes of the Merkle tree, so that a leaf can never be
+// passed off as an inner node.
+var (
+	merkleLeafPrefix = []byte{0x00}
+	merkleNodePrefix = []byte{0x01}
+)
+
+// MerkleLeaf returns the leaf hash of the allocation of an address.
+func MerkleLeaf(address string, amount sdk.Coins) []byte {
+	h := sha256.New()
+	h.Write(merkleLeafPrefix)
+	h.Write([]byte(address + "," + amount.String()))
+	return h.Sum(nil)
+}
+
+// merkleNode returns the hash of two sibling nodes. Siblings are hashed in
+// sorted order, so that a proof does not need the position of the leaf.
+func merkleNode(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	h := sha256.New()
+	h.Write(merkleNodePrefix)
+	h.Write(a)
+	h.Write(b)
+	return h.Sum(nil)
+}
+
+// BuildMerkleTree returns the root of the tree of the given leaves and the
+// proof of every leaf, in the order of the leaves. A node without sibling is
+// moved up a level as is.
+func BuildMerkleTree(leaves [][]byte) ([]byte, [][][]byte) {
+	if len(leaves) == 0 {
+		return nil, nil
+	}
+
+	proofs := make([][][]byte, len(leaves))
+	// indexes of the leaves under each node of the level
+	members := make([][]int, len(leaves))
+	for i := range leaves {
+		members[i] = []int{i}
+	}
+
+	level := leaves
+	for len(level) > 1 {
+		var next [][]byte
+		var nextMembers [][]int
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				nextMembers = append(nextMembers, members[i])
+				continue
+			}
+			for _, leaf := range members[i] {
+				proofs[leaf] = append(proofs[leaf], level[i+1])
+			}
+			for _, leaf := range members[i+1] {
+				proofs[leaf] = append(proofs[leaf], level[i])
+			}
+			next = append(next, merkleNode(level[i], level[i+1]))
+			nextMembers = append(nextMembers, append(members[i], members[i+1]...))
+		}
+		level = next
+		members = nextMembers
+	}
+
+	return level[0], proofs
+}
+
+// VerifyMerkleProof returns true if the proof leads from the leaf to the root.
+func VerifyMerkleProof(root, leaf []byte, proof [][]byte) bool {
+	hash := leaf
+	for _, sibling := range proof {
+		hash = merkleNode(hash, sibling)
+	}
+	return bytes.Equal(hash, root)
+}
+
+// DecodeMerkleHashes decodes hex encoded hashes.
+func DecodeMerkleHashes(hashes []string) ([][]byte, error) {
+	decoded := make([][]byte, len(hashes))
+	for i, hash := range hashes {
+		bz, err := decodeMerkleHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		decoded[i] = bz
+	}
+	return decoded, nil
+}
+
+func decodeMerkleHash(hash string) ([]byte, error) {
+	bz, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) != sha256.Size {
+		return nil, fmt.Errorf("invalid hash length %d: %s", len(bz), hash)
+	}
+	return bz, nil
+}
+
+// HasRoot returns true if allocations are committed to by a Merkle root.
+func (m MerkleAirdrop) HasRoot() bool {
+	return m.Root != ""
+}
+
+// Unregistered returns the allocations whose claim record was not created yet.
+func (m MerkleAirdrop) Unregistered() sdk.Coins {
+	if !m.HasRoot() {
+		return sdk.NewCoins()
+	}
+	unregistered, _ := sdk.NewCoins(m.TotalAllocation).SafeSub(sdk.NewCoins(m.Registered))
+	return unregistered
+}
+
+func (m MerkleAirdrop) Validate() error {
+	// the allocations may be left out without merkle root
+	if !m.HasRoot() {
+		for _, coin := range []sdk.Coin{m.TotalAllocation, m.Registered} {
+			if !coin.Amount.IsNil() && !coin.Amount.IsZero() {
+				return fmt.Errorf("merkle allocations %s without merkle root", coin)
+			}
+		}
+		return nil
+	}
+
+	if _, err := decodeMerkleHash(m.Root); err != nil {
+		return fmt.Errorf("invalid merkle root: %w", err)
+	}
+	for _, coin := range []sdk.Coin{m.TotalAllocation, m.Registered} {
+		if coin.Denom != DefaultClaimDenom {
+			return fmt.Errorf("denom for merkle airdrop and claim does not match: %s", coin.Denom)
+		}
+		if err := coin.Validate(); err != nil {
+			return err
+		}
+	}
+	if m.Registered.Amount.GT(m.TotalAllocation.Amount) {
+		return fmt.Errorf("registered allocations %s exceed the total allocation %s", m.Registered, m.TotalAllocation)
+	}
+	return nil
+}
+
+// DefaultMerkleAirdrop returns a Merkle airdrop without allocations.
+func DefaultMerkleAirdrop() MerkleAirdrop {
+	return MerkleAirdrop{
+		TotalAllocation: sdk.NewCoin(DefaultClaimDenom, sdk.ZeroInt()),
+		Registered:      sdk.NewCoin(DefaultClaimDenom, sdk.ZeroInt()),
+	}
+}
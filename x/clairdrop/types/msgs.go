@@ -0,0 +1,113 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgSetPaused    = "set_paused"
+	TypeMsgAttestAction = "attest_action"
+	TypeMsgSubmitProof  = "submit_claim_proof"
+)
+
+var (
+	_ sdk.Msg = &MsgSetPaused{}
+	_ sdk.Msg = &MsgAttestAction{}
+	_ sdk.Msg = &MsgSubmitClaimProof{}
+)
+
+func NewMsgSetPaused(authority string, paused bool) *MsgSetPaused {
+	return &MsgSetPaused{
+		Authority: authority,
+		Paused:    paused,
+	}
+}
+
+func (msg MsgSetPaused) Route() string { return RouterKey }
+
+func (msg MsgSetPaused) Type() string { return TypeMsgSetPaused }
+
+func (msg MsgSetPaused) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	return nil
+}
+
+func (msg MsgSetPaused) GetSignBytes() []byte {
+	return sdk.MustSortJSON(Amino.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetPaused) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func NewMsgAttestAction(attester string, address string, action ClaimAction) *MsgAttestAction {
+	return &MsgAttestAction{
+		Attester: attester,
+		Address:  address,
+		Action:   action,
+	}
+}
+
+func (msg MsgAttestAction) Route() string { return RouterKey }
+
+func (msg MsgAttestAction) Type() string { return TypeMsgAttestAction }
+
+func (msg MsgAttestAction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Attester); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid attester address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address: %s", err)
+	}
+	if !msg.Action.IsAttested() {
+		return sdkerrors.Wrapf(ErrNotAttested, "%s", msg.Action)
+	}
+	return nil
+}
+
+func (msg MsgAttestAction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(Amino.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAttestAction) GetSigners() []sdk.AccAddress {
+	attester, _ := sdk.AccAddressFromBech32(msg.Attester)
+	return []sdk.AccAddress{attester}
+}
+
+func NewMsgSubmitClaimProof(sender string, amount sdk.Coins, proof []string) *MsgSubmitClaimProof {
+	return &MsgSubmitClaimProof{
+		Sender: sender,
+		Amount: amount,
+		Proof:  proof,
+	}
+}
+
+func (msg MsgSubmitClaimProof) Route() string { return RouterKey }
+
+func (msg MsgSubmitClaimProof) Type() string { return TypeMsgSubmitProof }
+
+func (msg MsgSubmitClaimProof) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	if !msg.Amount.IsValid() || msg.Amount.Len() != 1 || msg.Amount[0].Denom != DefaultClaimDenom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "allocation must be a positive amount of %s: %s", DefaultClaimDenom, msg.Amount)
+	}
+	if _, err := DecodeMerkleHashes(msg.Proof); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidProof, "%s", err)
+	}
+	return nil
+}
+
+func (msg MsgSubmitClaimProof) GetSignBytes() []byte {
+	return sdk.MustSortJSON(Amino.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSubmitClaimProof) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
@@ -4,16 +4,24 @@ import (
 	"fmt"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 var (
-	KeyClairdropStartTime = []byte("ClairdropStartTime")
-	KeyClairdropEndTime   = []byte("ClairdropEndTime")
+	KeyClairdropStartTime      = []byte("ClairdropStartTime")
+	KeyClairdropEndTime        = []byte("ClairdropEndTime")
+	KeyAttesters               = []byte("Attesters")
+	KeyClairdropDecayStartTime = []byte("ClairdropDecayStartTime")
+	KeyClawbackRecordsPerBlock = []byte("ClawbackRecordsPerBlock")
 )
 
+// DefaultClawbackRecordsPerBlock is the default number of claim records the
+// clawback processes per block.
+const DefaultClawbackRecordsPerBlock uint64 = 1_000
+
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
@@ -21,10 +29,16 @@ func ParamKeyTable() paramtypes.KeyTable {
 func NewParams(
 	clairdropStartTime time.Time,
 	clairdropEndTime time.Time,
+	attesters []Attester,
+	clairdropDecayStartTime time.Time,
+	clawbackRecordsPerBlock uint64,
 ) Params {
 	return Params{
-		ClairdropStartTime: clairdropStartTime,
-		ClairdropEndTime:   clairdropEndTime,
+		ClairdropStartTime:      clairdropStartTime,
+		ClairdropEndTime:        clairdropEndTime,
+		Attesters:               attesters,
+		ClairdropDecayStartTime: clairdropDecayStartTime,
+		ClawbackRecordsPerBlock: clawbackRecordsPerBlock,
 	}
 }
 
@@ -32,6 +46,9 @@ func DefaultParams() Params {
 	return NewParams(
 		time.Time{},
 		time.Time{}.Add(time.Hour*24*150),
+		[]Attester{},
+		time.Time{},
+		DefaultClawbackRecordsPerBlock,
 	)
 }
 
@@ -40,6 +57,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyClairdropStartTime, &p.ClairdropStartTime, validateClairdropTime),
 		paramtypes.NewParamSetPair(KeyClairdropEndTime, &p.ClairdropEndTime, validateClairdropTime),
+		paramtypes.NewParamSetPair(KeyAttesters, &p.Attesters, validateAttesters),
+		paramtypes.NewParamSetPair(KeyClairdropDecayStartTime, &p.ClairdropDecayStartTime, validateClairdropTime),
+		paramtypes.NewParamSetPair(KeyClawbackRecordsPerBlock, &p.ClawbackRecordsPerBlock, validateClawbackRecordsPerBlock),
 	}
 }
 
@@ -51,12 +71,46 @@ func (p Params) Validate() error {
 	if err := validateClairdropTime(p.ClairdropEndTime); err != nil {
 		return err
 	}
+	if err := validateAttesters(p.Attesters); err != nil {
+		return err
+	}
+	if err := validateClairdropTime(p.ClairdropDecayStartTime); err != nil {
+		return err
+	}
+	if err := validateClawbackRecordsPerBlock(p.ClawbackRecordsPerBlock); err != nil {
+		return err
+	}
 	if p.ClairdropEndTime.Before(p.ClairdropStartTime) {
 		return fmt.Errorf("clairdrop end time must be late than clairdrop start time")
 	}
+	if p.IsDecayEnabled() &&
+		(p.ClairdropDecayStartTime.Before(p.ClairdropStartTime) || !p.ClairdropDecayStartTime.Before(p.ClairdropEndTime)) {
+		return fmt.Errorf("clairdrop decay start time must be between clairdrop start time and clairdrop end time")
+	}
 	return nil
 }
 
+// IsDecayEnabled returns true if the claimable amounts decay before the end
+// of the clairdrop.
+func (p Params) IsDecayEnabled() bool {
+	return !p.ClairdropDecayStartTime.IsZero()
+}
+
+// DecayFactor returns the ratio of the claimable amounts left at the time,
+// one until the decay start and zero at the clairdrop end.
+func (p Params) DecayFactor(t time.Time) sdk.Dec {
+	if !p.IsDecayEnabled() || !t.After(p.ClairdropDecayStartTime) {
+		return sdk.OneDec()
+	}
+	if !t.Before(p.ClairdropEndTime) {
+		return sdk.ZeroDec()
+	}
+
+	elapsed := sdk.NewInt(int64(t.Sub(p.ClairdropDecayStartTime)))
+	decay := sdk.NewInt(int64(p.ClairdropEndTime.Sub(p.ClairdropDecayStartTime)))
+	return sdk.OneDec().Sub(sdk.NewDecFromInt(elapsed).QuoInt(decay))
+}
+
 func validateClairdropTime(i interface{}) error {
 	_, ok := i.(time.Time)
 	if !ok {
@@ -65,3 +119,60 @@ func validateClairdropTime(i interface{}) error {
 
 	return nil
 }
+
+func validateClawbackRecordsPerBlock(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("clawback records per block must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateAttesters(i interface{}) error {
+	v, ok := i.([]Attester)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for i, attester := range v {
+		if _, err := sdk.AccAddressFromBech32(attester.Address); err != nil {
+			return fmt.Errorf("invalid attester address at %dth: %w", i, err)
+		}
+		if seen[attester.Address] {
+			return fmt.Errorf("duplicate attester %s", attester.Address)
+		}
+		seen[attester.Address] = true
+
+		if len(attester.Actions) == 0 {
+			return fmt.Errorf("no action for attester %s", attester.Address)
+		}
+		for _, action := range attester.Actions {
+			if !action.IsAttested() {
+				return fmt.Errorf("action %s of attester %s cannot be attested", action, attester.Address)
+			}
+		}
+	}
+
+	return nil
+}
+
+// IsAttester returns true if the address is an attester of the action.
+func (p Params) IsAttester(address string, action ClaimAction) bool {
+	for _, attester := range p.Attesters {
+		if attester.Address != address {
+			continue
+		}
+		for _, a := range attester.Actions {
+			if a == action {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -30,6 +30,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	ClairdropStartTime time.Time `protobuf:"bytes,1,opt,name=clairdrop_start_time,json=clairdropStartTime,proto3,stdtime" json:"clairdrop_start_time" yaml:"clairdrop_start_time"`
 	ClairdropEndTime   time.Time `protobuf:"bytes,2,opt,name=clairdrop_end_time,json=clairdropEndTime,proto3,stdtime" json:"clairdrop_end_time" yaml:"clairdrop_end_time"`
+	// addresses allowed to confirm the completion of the Story and Nft actions
+	Attesters []Attester `protobuf:"bytes,3,rep,name=attesters,proto3" json:"attesters" yaml:"attesters"`
+	// time from which the claimable amounts decay linearly to zero at the
+	// clairdrop end time, the zero time disables the decay
+	ClairdropDecayStartTime time.Time `protobuf:"bytes,4,opt,name=clairdrop_decay_start_time,json=clairdropDecayStartTime,proto3,stdtime" json:"clairdrop_decay_start_time" yaml:"clairdrop_decay_start_time"`
+	// number of claim records the clawback processes per block, must be
+	// positive
+	ClawbackRecordsPerBlock uint64 `protobuf:"varint,5,opt,name=clawback_records_per_block,json=clawbackRecordsPerBlock,proto3" json:"clawback_records_per_block,omitempty" yaml:"clawback_records_per_block"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,32 +87,119 @@ func (m *Params) GetClairdropEndTime() time.Time {
 	return time.Time{}
 }
 
+func (m *Params) GetAttesters() []Attester {
+	if m != nil {
+		return m.Attesters
+	}
+	return nil
+}
+
+func (m *Params) GetClairdropDecayStartTime() time.Time {
+	if m != nil {
+		return m.ClairdropDecayStartTime
+	}
+	return time.Time{}
+}
+
+func (m *Params) GetClawbackRecordsPerBlock() uint64 {
+	if m != nil {
+		return m.ClawbackRecordsPerBlock
+	}
+	return 0
+}
+
+// Attester defines an address, or the address of a module account, allowed to
+// confirm the completion of claim actions.
+type Attester struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// actions the attester confirms
+	Actions []ClaimAction `protobuf:"varint,2,rep,packed,name=actions,proto3,enum=galaxy.clairdrop.ClaimAction" json:"actions,omitempty"`
+}
+
+func (m *Attester) Reset()         { *m = Attester{} }
+func (m *Attester) String() string { return proto.CompactTextString(m) }
+func (*Attester) ProtoMessage()    {}
+func (*Attester) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2faf4d5aa0b2e41d, []int{1}
+}
+func (m *Attester) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Attester) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Attester.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Attester) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Attester.Merge(m, src)
+}
+func (m *Attester) XXX_Size() int {
+	return m.Size()
+}
+func (m *Attester) XXX_DiscardUnknown() {
+	xxx_messageInfo_Attester.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Attester proto.InternalMessageInfo
+
+func (m *Attester) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Attester) GetActions() []ClaimAction {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "galaxy.clairdrop.Params")
+	proto.RegisterType((*Attester)(nil), "galaxy.clairdrop.Attester")
 }
 
 func init() { proto.RegisterFile("galaxy/clairdrop/params.proto", fileDescriptor_2faf4d5aa0b2e41d) }
 
 var fileDescriptor_2faf4d5aa0b2e41d = []byte{
-	// 274 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0x4f, 0xcc, 0x49,
-	0xac, 0xa8, 0xd4, 0x4f, 0xce, 0x49, 0xcc, 0x2c, 0x4a, 0x29, 0xca, 0x2f, 0xd0, 0x2f, 0x48, 0x2c,
-	0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x48, 0xeb, 0xc1, 0xa5,
-	0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x94, 0x7c, 0x7a,
-	0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x3e, 0x98, 0x97, 0x54, 0x9a, 0xa6, 0x5f, 0x92, 0x99, 0x9b, 0x5a,
-	0x5c, 0x92, 0x98, 0x5b, 0x00, 0x51, 0xa0, 0xf4, 0x81, 0x91, 0x8b, 0x2d, 0x00, 0x6c, 0xb2, 0x50,
-	0x29, 0x97, 0x08, 0xdc, 0xb8, 0xf8, 0xe2, 0x92, 0xc4, 0xa2, 0x92, 0x78, 0x90, 0x6a, 0x09, 0x46,
-	0x05, 0x46, 0x0d, 0x6e, 0x23, 0x29, 0x3d, 0x88, 0x51, 0x7a, 0x30, 0xa3, 0xf4, 0x42, 0x60, 0x46,
-	0x39, 0xa9, 0x9f, 0xb8, 0x27, 0xcf, 0xf0, 0xe9, 0x9e, 0xbc, 0x74, 0x65, 0x62, 0x6e, 0x8e, 0x95,
-	0x12, 0x36, 0x53, 0x94, 0x26, 0xdc, 0x97, 0x67, 0x0c, 0x12, 0x82, 0x4b, 0x05, 0x83, 0x64, 0x40,
-	0x26, 0x08, 0xe5, 0x73, 0x21, 0x44, 0xe3, 0x53, 0xf3, 0x52, 0x20, 0x96, 0x32, 0x11, 0xb4, 0x54,
-	0x15, 0x6a, 0xa9, 0x24, 0xba, 0xa5, 0x30, 0x33, 0x20, 0x56, 0x0a, 0xc0, 0x25, 0x5c, 0xf3, 0x52,
-	0x40, 0xba, 0x9d, 0xbc, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
-	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x30,
-	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x12, 0xc0, 0x79, 0xa9, 0x25,
-	0xe5, 0xf9, 0x45, 0xd9, 0x50, 0x9e, 0x7e, 0x05, 0x52, 0x7c, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27,
-	0xb1, 0x81, 0x5d, 0x66, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xb9, 0xc5, 0x46, 0x4c, 0xb0, 0x01,
-	0x00, 0x00,
+	// 445 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4f, 0x8f, 0x93, 0x40,
+	0x18, 0xc6, 0x99, 0x6d, 0xdd, 0x75, 0xc7, 0xc4, 0x34, 0x64, 0x93, 0x45, 0xcc, 0x02, 0x92, 0x6c,
+	0xec, 0x45, 0x88, 0xeb, 0xc1, 0xc4, 0xdb, 0xa2, 0x9e, 0xbc, 0x6c, 0xd0, 0x93, 0x89, 0x21, 0x03,
+	0x8c, 0x48, 0x0a, 0x0c, 0x99, 0x99, 0x66, 0x97, 0x2f, 0xe0, 0xb9, 0x5f, 0xc5, 0x6f, 0xd1, 0x63,
+	0x8f, 0x9e, 0xaa, 0x69, 0xbf, 0x81, 0x9f, 0xc0, 0x30, 0xc3, 0x9f, 0x4a, 0xdd, 0xf4, 0x36, 0x2f,
+	0xcf, 0xc3, 0xf3, 0x9b, 0xf7, 0xc9, 0xc0, 0x8b, 0x04, 0x65, 0xe8, 0xae, 0x72, 0xa3, 0x0c, 0xa5,
+	0x34, 0xa6, 0xa4, 0x74, 0x4b, 0x44, 0x51, 0xce, 0x9c, 0x92, 0x12, 0x4e, 0xd4, 0x89, 0x94, 0x9d,
+	0x4e, 0xd6, 0xcf, 0x12, 0x92, 0x10, 0x21, 0xba, 0xf5, 0x49, 0xfa, 0x74, 0x33, 0x21, 0x24, 0xc9,
+	0xb0, 0x2b, 0xa6, 0x70, 0xfe, 0xd5, 0xe5, 0x69, 0x8e, 0x19, 0x47, 0x79, 0xd9, 0x18, 0xac, 0x3d,
+	0x4e, 0x77, 0x92, 0x0e, 0xfb, 0xc7, 0x18, 0x1e, 0xdf, 0x08, 0xb6, 0x3a, 0x87, 0x67, 0x9d, 0x1a,
+	0x30, 0x8e, 0x28, 0x0f, 0xea, 0x3c, 0x0d, 0x58, 0x60, 0xfa, 0xe8, 0x4a, 0x77, 0x24, 0xcc, 0x69,
+	0x61, 0xce, 0xa7, 0x16, 0xe6, 0x3d, 0x5f, 0xae, 0x4d, 0xe5, 0xcf, 0xda, 0x7c, 0x5a, 0xa1, 0x3c,
+	0x7b, 0x63, 0xff, 0x2f, 0xc5, 0x5e, 0xfc, 0x32, 0x81, 0xaf, 0x76, 0xd2, 0xc7, 0x5a, 0xa9, 0x13,
+	0x54, 0x02, 0xfb, 0xaf, 0x01, 0x2e, 0x62, 0x09, 0x3d, 0x3a, 0x08, 0xbd, 0x6c, 0xa0, 0x4f, 0x86,
+	0xd0, 0x36, 0x43, 0x22, 0x27, 0x9d, 0xf0, 0xbe, 0x88, 0x05, 0xd0, 0x87, 0xa7, 0x88, 0x73, 0xcc,
+	0x38, 0xa6, 0x4c, 0x1b, 0x59, 0x23, 0xc9, 0x19, 0x34, 0xee, 0x5c, 0x37, 0x16, 0x4f, 0x6b, 0x38,
+	0x13, 0xc9, 0xe9, 0x7e, 0xb5, 0xfd, 0x3e, 0x46, 0xfd, 0x0e, 0xa0, 0xde, 0xdf, 0x20, 0xc6, 0x11,
+	0xaa, 0x76, 0x2b, 0x1c, 0x1f, 0xdc, 0xe6, 0x45, 0x43, 0x79, 0x36, 0xdc, 0x66, 0x98, 0x25, 0xb7,
+	0x3a, 0xef, 0x0c, 0xef, 0x6a, 0xbd, 0x6f, 0x33, 0x14, 0xf7, 0xb8, 0x0d, 0x51, 0x34, 0x0b, 0x28,
+	0x8e, 0x08, 0x8d, 0x59, 0x50, 0x62, 0x1a, 0x84, 0x19, 0x89, 0x66, 0xda, 0x03, 0x0b, 0x4c, 0xc7,
+	0xde, 0xe5, 0x3f, 0x9c, 0x7b, 0xbc, 0xb6, 0x7f, 0xde, 0x8a, 0xbe, 0xd4, 0x6e, 0x30, 0xf5, 0x84,
+	0xf2, 0x05, 0x3e, 0x6c, 0xdb, 0x51, 0x35, 0x78, 0x82, 0xe2, 0x98, 0x62, 0xc6, 0xc4, 0x3b, 0x39,
+	0xf5, 0xdb, 0x51, 0x7d, 0x0d, 0x4f, 0x50, 0xc4, 0x53, 0x52, 0x30, 0xed, 0xc8, 0x1a, 0x4d, 0x1f,
+	0x5f, 0x5d, 0xec, 0x97, 0xfc, 0x36, 0x43, 0x69, 0x7e, 0x2d, 0x5c, 0x7e, 0xeb, 0xf6, 0x3e, 0x2c,
+	0x37, 0x06, 0x58, 0x6d, 0x0c, 0xf0, 0x7b, 0x63, 0x80, 0xc5, 0xd6, 0x50, 0x56, 0x5b, 0x43, 0xf9,
+	0xb9, 0x35, 0x94, 0xcf, 0x2f, 0x93, 0x94, 0x7f, 0x9b, 0x87, 0x4e, 0x44, 0x72, 0x57, 0x66, 0x15,
+	0x98, 0xdf, 0x12, 0x3a, 0x6b, 0x26, 0xf7, 0x6e, 0xe7, 0xa5, 0xf3, 0xaa, 0xc4, 0x2c, 0x3c, 0x16,
+	0x5d, 0xbf, 0xfa, 0x3b, 0x00, 0x23, 0x05, 0x93, 0x4e, 0x72, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -127,25 +222,100 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ClairdropEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ClairdropEndTime):])
+	if m.ClawbackRecordsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ClawbackRecordsPerBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ClairdropDecayStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ClairdropDecayStartTime):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintParams(dAtA, i, uint64(n1))
 	i--
-	dAtA[i] = 0x12
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ClairdropStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ClairdropStartTime):])
+	dAtA[i] = 0x22
+	if len(m.Attesters) > 0 {
+		for iNdEx := len(m.Attesters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attesters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ClairdropEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ClairdropEndTime):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintParams(dAtA, i, uint64(n2))
 	i--
+	dAtA[i] = 0x12
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ClairdropStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ClairdropStartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
+func (m *Attester) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Attester) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Attester) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		dAtA5 := make([]byte, len(m.Actions)*10)
+		var j4 int
+		for _, num := range m.Actions {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintParams(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -167,6 +337,37 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ClairdropEndTime)
 	n += 1 + l + sovParams(uint64(l))
+	if len(m.Attesters) > 0 {
+		for _, e := range m.Attesters {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ClairdropDecayStartTime)
+	n += 1 + l + sovParams(uint64(l))
+	if m.ClawbackRecordsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.ClawbackRecordsPerBlock))
+	}
+	return n
+}
+
+func (m *Attester) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.Actions) > 0 {
+		l = 0
+		for _, e := range m.Actions {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
 	return n
 }
 
@@ -271,6 +472,243 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attesters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attesters = append(m.Attesters, Attester{})
+			if err := m.Attesters[len(m.Attesters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClairdropDecayStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ClairdropDecayStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackRecordsPerBlock", wireType)
+			}
+			m.ClawbackRecordsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClawbackRecordsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Attester) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attester: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attester: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v ClaimAction
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ClaimAction(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Actions = append(m.Actions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Actions) == 0 {
+					m.Actions = make([]ClaimAction, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ClaimAction
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ClaimAction(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Actions = append(m.Actions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,37 @@
+package types
+
+import (
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeSetClairdropPaused defines the type for a SetClairdropPausedProposal
+	ProposalTypeSetClairdropPaused = "SetClairdropPaused"
+)
+
+// Assert SetClairdropPausedProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &SetClairdropPausedProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetClairdropPaused)
+	govtypes.RegisterProposalTypeCodec(&SetClairdropPausedProposal{}, "galaxy/clairdrop/SetClairdropPausedProposal")
+}
+
+// NewSetClairdropPausedProposal creates a new proposal pausing or resuming
+// claiming and clawback.
+func NewSetClairdropPausedProposal(title, description string, paused bool) *SetClairdropPausedProposal {
+	return &SetClairdropPausedProposal{title, description, paused}
+}
+
+func (p *SetClairdropPausedProposal) GetTitle() string { return p.Title }
+
+func (p *SetClairdropPausedProposal) GetDescription() string { return p.Description }
+
+func (p *SetClairdropPausedProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetClairdropPausedProposal) ProposalType() string { return ProposalTypeSetClairdropPaused }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *SetClairdropPausedProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(p)
+}
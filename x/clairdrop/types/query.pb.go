@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -335,6 +336,8 @@ func (m *QueryClaimableForActionRequest) GetAction() ClaimAction {
 
 type QueryClaimableForActionResponse struct {
 	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// amount claimable without the decay
+	UndecayedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=undecayed_coins,json=undecayedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"undecayed_coins"`
 }
 
 func (m *QueryClaimableForActionResponse) Reset()         { *m = QueryClaimableForActionResponse{} }
@@ -377,6 +380,13 @@ func (m *QueryClaimableForActionResponse) GetCoins() github_com_cosmos_cosmos_sd
 	return nil
 }
 
+func (m *QueryClaimableForActionResponse) GetUndecayedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UndecayedCoins
+	}
+	return nil
+}
+
 type QueryTotalClaimableRequest struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
@@ -423,6 +433,8 @@ func (m *QueryTotalClaimableRequest) GetAddress() string {
 
 type QueryTotalClaimableResponse struct {
 	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// amount claimable without the decay
+	UndecayedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=undecayed_coins,json=undecayedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"undecayed_coins"`
 }
 
 func (m *QueryTotalClaimableResponse) Reset()         { *m = QueryTotalClaimableResponse{} }
@@ -465,755 +477,2808 @@ func (m *QueryTotalClaimableResponse) GetCoins() github_com_cosmos_cosmos_sdk_ty
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "galaxy.clairdrop.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "galaxy.clairdrop.QueryParamsResponse")
-	proto.RegisterType((*QueryModuleAccountBalanceRequest)(nil), "galaxy.clairdrop.QueryModuleAccountBalanceRequest")
-	proto.RegisterType((*QueryModuleAccountBalanceResponse)(nil), "galaxy.clairdrop.QueryModuleAccountBalanceResponse")
-	proto.RegisterType((*QueryClaimRecordRequest)(nil), "galaxy.clairdrop.QueryClaimRecordRequest")
-	proto.RegisterType((*QueryClaimRecordResponse)(nil), "galaxy.clairdrop.QueryClaimRecordResponse")
-	proto.RegisterType((*QueryClaimableForActionRequest)(nil), "galaxy.clairdrop.QueryClaimableForActionRequest")
-	proto.RegisterType((*QueryClaimableForActionResponse)(nil), "galaxy.clairdrop.QueryClaimableForActionResponse")
-	proto.RegisterType((*QueryTotalClaimableRequest)(nil), "galaxy.clairdrop.QueryTotalClaimableRequest")
-	proto.RegisterType((*QueryTotalClaimableResponse)(nil), "galaxy.clairdrop.QueryTotalClaimableResponse")
+func (m *QueryTotalClaimableResponse) GetUndecayedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UndecayedCoins
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("galaxy/clairdrop/query.proto", fileDescriptor_490dbb3da7356033) }
-
-var fileDescriptor_490dbb3da7356033 = []byte{
-	// 682 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0x4f, 0x6b, 0xd4, 0x40,
-	0x18, 0xc6, 0x77, 0xaa, 0x5d, 0x71, 0x56, 0x8a, 0x8c, 0x0b, 0xc6, 0xd8, 0xa6, 0x6b, 0x50, 0x58,
-	0xab, 0xcd, 0x74, 0x77, 0xb1, 0x20, 0x9e, 0xba, 0x85, 0x5e, 0xa4, 0xa0, 0x8b, 0x27, 0x2f, 0xcb,
-	0x24, 0x3b, 0xc6, 0xd0, 0x24, 0x93, 0x66, 0xb2, 0xb6, 0xa5, 0x14, 0x45, 0xfc, 0x00, 0x82, 0xe0,
-	0x07, 0x10, 0x4f, 0xde, 0x7a, 0xf7, 0x03, 0xf4, 0x58, 0xf0, 0xe2, 0x49, 0xa5, 0xf5, 0x83, 0x48,
-	0x66, 0x66, 0xff, 0xd4, 0x24, 0xdd, 0xf6, 0xa0, 0xa7, 0xcd, 0xce, 0xbc, 0xcf, 0xfb, 0xfc, 0xe6,
-	0xcd, 0x3c, 0xbb, 0x70, 0xd6, 0x25, 0x3e, 0xd9, 0xde, 0xc1, 0x8e, 0x4f, 0xbc, 0xb8, 0x17, 0xb3,
-	0x08, 0x6f, 0xf6, 0x69, 0xbc, 0x63, 0x45, 0x31, 0x4b, 0x18, 0xba, 0x2a, 0x77, 0xad, 0xe1, 0xae,
-	0x3e, 0xeb, 0x32, 0xe6, 0xfa, 0x14, 0x93, 0xc8, 0xc3, 0x24, 0x0c, 0x59, 0x42, 0x12, 0x8f, 0x85,
-	0x5c, 0xd6, 0xeb, 0x86, 0xc3, 0x78, 0xc0, 0x38, 0xb6, 0x09, 0xa7, 0xf8, 0x55, 0xc3, 0xa6, 0x09,
-	0x69, 0x60, 0x87, 0x79, 0xa1, 0xda, 0x9f, 0xcb, 0xb8, 0x45, 0x24, 0x26, 0xc1, 0x40, 0x5e, 0x75,
-	0x99, 0xcb, 0xc4, 0x23, 0x4e, 0x9f, 0xd4, 0x6a, 0x2d, 0x23, 0x1a, 0x3e, 0xc9, 0x0a, 0xb3, 0x0a,
-	0xd1, 0xd3, 0x94, 0xfa, 0x89, 0x68, 0xd6, 0xa1, 0x9b, 0x7d, 0xca, 0x13, 0x73, 0x1d, 0x5e, 0x3b,
-	0xb1, 0xca, 0x23, 0x16, 0x72, 0x8a, 0x96, 0x61, 0x59, 0x9a, 0x6a, 0xa0, 0x06, 0xea, 0x95, 0xa6,
-	0x66, 0xfd, 0x7d, 0x48, 0x4b, 0x2a, 0xda, 0x17, 0x0f, 0x7e, 0xcc, 0x97, 0x3a, 0xaa, 0xda, 0x34,
-	0x61, 0x4d, 0xb4, 0x5b, 0x67, 0xbd, 0xbe, 0x4f, 0x57, 0x1c, 0x87, 0xf5, 0xc3, 0xa4, 0x4d, 0x7c,
-	0x12, 0x3a, 0x74, 0x60, 0xf9, 0x19, 0xc0, 0x5b, 0xa7, 0x14, 0x29, 0x82, 0xd7, 0xb0, 0x1a, 0xe4,
-	0xec, 0x6b, 0xa0, 0x76, 0xa1, 0x5e, 0x69, 0xde, 0xb0, 0xe4, 0x10, 0xad, 0x74, 0x88, 0x96, 0x1a,
-	0xa2, 0xb5, 0xca, 0xbc, 0xb0, 0xbd, 0x94, 0x02, 0x7d, 0xf9, 0x39, 0x5f, 0x77, 0xbd, 0xe4, 0x65,
-	0xdf, 0xb6, 0x1c, 0x16, 0x60, 0x35, 0x71, 0xf9, 0xb1, 0xc8, 0x7b, 0x1b, 0x38, 0xd9, 0x89, 0x28,
-	0x17, 0x02, 0xde, 0xc9, 0x35, 0x32, 0x5b, 0xf0, 0xba, 0xa0, 0x5c, 0xf5, 0x89, 0x17, 0x74, 0xa8,
-	0xc3, 0xe2, 0x9e, 0x3a, 0x01, 0xd2, 0xe0, 0x25, 0xd2, 0xeb, 0xc5, 0x94, 0xcb, 0xf1, 0x5c, 0xee,
-	0x0c, 0xbe, 0x9a, 0x36, 0xd4, 0xb2, 0x22, 0x75, 0xa2, 0x35, 0x78, 0x25, 0x9d, 0x5e, 0xd0, 0x8d,
-	0xc5, 0xba, 0x9a, 0xec, 0x5c, 0x76, 0xb2, 0x63, 0x62, 0x35, 0xde, 0x8a, 0x33, 0x5a, 0x32, 0x37,
-	0xa1, 0x31, 0xf2, 0x20, 0xb6, 0x4f, 0xd7, 0x58, 0xbc, 0xe2, 0xa4, 0x37, 0x6c, 0x22, 0x1f, 0x7a,
-	0x00, 0xcb, 0x44, 0x94, 0x6a, 0x53, 0x35, 0x50, 0x9f, 0x29, 0x74, 0x57, 0xfd, 0x54, 0xb1, 0xf9,
-	0x0e, 0xc0, 0xf9, 0x42, 0x4f, 0x75, 0x3c, 0x02, 0xa7, 0xd3, 0x4b, 0xcc, 0xff, 0xc5, 0x1b, 0x92,
-	0x9d, 0xcd, 0x65, 0xa8, 0x0b, 0x8a, 0x67, 0x2c, 0x21, 0xfe, 0x10, 0x65, 0xf2, 0x5b, 0x79, 0x03,
-	0xe0, 0xcd, 0x5c, 0xe1, 0x7f, 0x43, 0x6f, 0xee, 0x97, 0xe1, 0xb4, 0x40, 0x40, 0x5b, 0xb0, 0x2c,
-	0xa3, 0x83, 0x6e, 0x67, 0x87, 0x9f, 0x4d, 0xa8, 0x7e, 0x67, 0x42, 0x95, 0x3c, 0x83, 0x59, 0x7b,
-	0xfb, 0xed, 0xf7, 0x87, 0x29, 0x1d, 0x69, 0xb8, 0xe0, 0xe7, 0x03, 0xed, 0x03, 0x58, 0xcd, 0x8b,
-	0x1c, 0x6a, 0x16, 0x38, 0x9c, 0x12, 0x62, 0xbd, 0x75, 0x2e, 0x8d, 0x62, 0x5c, 0x12, 0x8c, 0x0b,
-	0xa8, 0x9e, 0x65, 0x94, 0x11, 0xec, 0x12, 0x29, 0xec, 0xda, 0x0a, 0xed, 0x23, 0x80, 0x95, 0xb1,
-	0x38, 0xa0, 0xbb, 0x05, 0xb6, 0xd9, 0x90, 0xea, 0x0b, 0x67, 0x29, 0x9d, 0x0c, 0x36, 0x1e, 0x59,
-	0xbc, 0xab, 0x6e, 0xd4, 0x1e, 0xfa, 0x0a, 0x20, 0xca, 0x86, 0x01, 0x2d, 0x9d, 0x66, 0x9a, 0x97,
-	0x55, 0xbd, 0x71, 0x0e, 0x85, 0xa2, 0x5d, 0x11, 0xb4, 0x8f, 0xd0, 0xc3, 0x02, 0xda, 0x54, 0xd5,
-	0x7d, 0xc1, 0xe2, 0xae, 0x4c, 0xef, 0x88, 0x1a, 0xef, 0xca, 0x95, 0x3d, 0xf4, 0x09, 0xc0, 0x99,
-	0x93, 0x61, 0x40, 0xf7, 0x0b, 0x40, 0x72, 0xc3, 0xa6, 0x2f, 0x9e, 0xb1, 0x5a, 0x21, 0xb7, 0x04,
-	0xf2, 0x22, 0xba, 0x97, 0x45, 0x4e, 0x52, 0x45, 0x77, 0x08, 0x3e, 0xa2, 0x6d, 0x3f, 0x3e, 0x38,
-	0x32, 0xc0, 0xe1, 0x91, 0x01, 0x7e, 0x1d, 0x19, 0xe0, 0xfd, 0xb1, 0x51, 0x3a, 0x3c, 0x36, 0x4a,
-	0xdf, 0x8f, 0x8d, 0xd2, 0xf3, 0xc6, 0x58, 0xfc, 0x64, 0xc3, 0x90, 0x26, 0x5b, 0x2c, 0xde, 0x18,
-	0xb4, 0xdf, 0x1e, 0x37, 0x48, 0xd3, 0x68, 0x97, 0xc5, 0xbf, 0x60, 0xeb, 0x4f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xcb, 0x64, 0x7a, 0x5e, 0xcc, 0x07, 0x00, 0x00,
+type QueryPauseRequest struct {
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryPauseRequest) Reset()         { *m = QueryPauseRequest{} }
+func (m *QueryPauseRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseRequest) ProtoMessage()    {}
+func (*QueryPauseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_490dbb3da7356033, []int{10}
+}
+func (m *QueryPauseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPauseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseRequest.Merge(m, src)
+}
+func (m *QueryPauseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryPauseRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	ModuleAccountBalance(ctx context.Context, in *QueryModuleAccountBalanceRequest, opts ...grpc.CallOption) (*QueryModuleAccountBalanceResponse, error)
-	ClaimRecord(ctx context.Context, in *QueryClaimRecordRequest, opts ...grpc.CallOption) (*QueryClaimRecordResponse, error)
-	ClaimableForAction(ctx context.Context, in *QueryClaimableForActionRequest, opts ...grpc.CallOption) (*QueryClaimableForActionResponse, error)
-	TotalClaimable(ctx context.Context, in *QueryTotalClaimableRequest, opts ...grpc.CallOption) (*QueryTotalClaimableResponse, error)
+type QueryPauseResponse struct {
+	Pause ClairdropPause `protobuf:"bytes,1,opt,name=pause,proto3" json:"pause"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryPauseResponse) Reset()         { *m = QueryPauseResponse{} }
+func (m *QueryPauseResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseResponse) ProtoMessage()    {}
+func (*QueryPauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_490dbb3da7356033, []int{11}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryPauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/galaxy.clairdrop.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) ModuleAccountBalance(ctx context.Context, in *QueryModuleAccountBalanceRequest, opts ...grpc.CallOption) (*QueryModuleAccountBalanceResponse, error) {
-	out := new(QueryModuleAccountBalanceResponse)
-	err := c.cc.Invoke(ctx, "/galaxy.clairdrop.Query/ModuleAccountBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseResponse.Merge(m, src)
+}
+func (m *QueryPauseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ClaimRecord(ctx context.Context, in *QueryClaimRecordRequest, opts ...grpc.CallOption) (*QueryClaimRecordResponse, error) {
-	out := new(QueryClaimRecordResponse)
-	err := c.cc.Invoke(ctx, "/galaxy.clairdrop.Query/ClaimRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPauseResponse proto.InternalMessageInfo
+
+func (m *QueryPauseResponse) GetPause() ClairdropPause {
+	if m != nil {
+		return m.Pause
 	}
-	return out, nil
+	return ClairdropPause{}
 }
 
-func (c *queryClient) ClaimableForAction(ctx context.Context, in *QueryClaimableForActionRequest, opts ...grpc.CallOption) (*QueryClaimableForActionResponse, error) {
-	out := new(QueryClaimableForActionResponse)
-	err := c.cc.Invoke(ctx, "/galaxy.clairdrop.Query/ClaimableForAction", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryMerkleAirdropRequest struct {
 }
 
-func (c *queryClient) TotalClaimable(ctx context.Context, in *QueryTotalClaimableRequest, opts ...grpc.CallOption) (*QueryTotalClaimableResponse, error) {
-	out := new(QueryTotalClaimableResponse)
-	err := c.cc.Invoke(ctx, "/galaxy.clairdrop.Query/TotalClaimable", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryMerkleAirdropRequest) Reset()         { *m = QueryMerkleAirdropRequest{} }
+func (m *QueryMerkleAirdropRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkleAirdropRequest) ProtoMessage()    {}
+func (*QueryMerkleAirdropRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_490dbb3da7356033, []int{12}
+}
+func (m *QueryMerkleAirdropRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerkleAirdropRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkleAirdropRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	ModuleAccountBalance(context.Context, *QueryModuleAccountBalanceRequest) (*QueryModuleAccountBalanceResponse, error)
-	ClaimRecord(context.Context, *QueryClaimRecordRequest) (*QueryClaimRecordResponse, error)
-	ClaimableForAction(context.Context, *QueryClaimableForActionRequest) (*QueryClaimableForActionResponse, error)
-	TotalClaimable(context.Context, *QueryTotalClaimableRequest) (*QueryTotalClaimableResponse, error)
+func (m *QueryMerkleAirdropRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkleAirdropRequest.Merge(m, src)
+}
+func (m *QueryMerkleAirdropRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerkleAirdropRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkleAirdropRequest.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryMerkleAirdropRequest proto.InternalMessageInfo
+
+type QueryMerkleAirdropResponse struct {
+	MerkleAirdrop MerkleAirdrop `protobuf:"bytes,1,opt,name=merkle_airdrop,json=merkleAirdrop,proto3" json:"merkle_airdrop"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryMerkleAirdropResponse) Reset()         { *m = QueryMerkleAirdropResponse{} }
+func (m *QueryMerkleAirdropResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkleAirdropResponse) ProtoMessage()    {}
+func (*QueryMerkleAirdropResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_490dbb3da7356033, []int{13}
 }
-func (*UnimplementedQueryServer) ModuleAccountBalance(ctx context.Context, req *QueryModuleAccountBalanceRequest) (*QueryModuleAccountBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccountBalance not implemented")
+func (m *QueryMerkleAirdropResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ClaimRecord(ctx context.Context, req *QueryClaimRecordRequest) (*QueryClaimRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimRecord not implemented")
+func (m *QueryMerkleAirdropResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkleAirdropResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ClaimableForAction(ctx context.Context, req *QueryClaimableForActionRequest) (*QueryClaimableForActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimableForAction not implemented")
+func (m *QueryMerkleAirdropResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkleAirdropResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) TotalClaimable(ctx context.Context, req *QueryTotalClaimableRequest) (*QueryTotalClaimableResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalClaimable not implemented")
+func (m *QueryMerkleAirdropResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryMerkleAirdropResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkleAirdropResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/galaxy.clairdrop.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryMerkleAirdropResponse proto.InternalMessageInfo
+
+func (m *QueryMerkleAirdropResponse) GetMerkleAirdrop() MerkleAirdrop {
+	if m != nil {
+		return m.MerkleAirdrop
 	}
-	return interceptor(ctx, in, info, handler)
+	return MerkleAirdrop{}
 }
 
-func _Query_ModuleAccountBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryModuleAccountBalanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ModuleAccountBalance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/galaxy.clairdrop.Query/ModuleAccountBalance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ModuleAccountBalance(ctx, req.(*QueryModuleAccountBalanceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryClaimRecordsRequest struct {
+	// progress of the claim records over all the actions
+	Status ClaimRecordStatus `protobuf:"varint,1,opt,name=status,proto3,enum=galaxy.clairdrop.ClaimRecordStatus" json:"status,omitempty"`
+	// actions the claim records completed
+	CompletedActions []ClaimAction      `protobuf:"varint,2,rep,packed,name=completed_actions,json=completedActions,proto3,enum=galaxy.clairdrop.ClaimAction" json:"completed_actions,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_ClaimRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClaimRecordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClaimRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/galaxy.clairdrop.Query/ClaimRecord",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClaimRecord(ctx, req.(*QueryClaimRecordRequest))
+func (m *QueryClaimRecordsRequest) Reset()         { *m = QueryClaimRecordsRequest{} }
+func (m *QueryClaimRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimRecordsRequest) ProtoMessage()    {}
+func (*QueryClaimRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_490dbb3da7356033, []int{14}
+}
+func (m *QueryClaimRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryClaimRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimRecordsRequest.Merge(m, src)
+}
+func (m *QueryClaimRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimRecordsRequest.DiscardUnknown(m)
 }
 
-func _Query_ClaimableForAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClaimableForActionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClaimableForAction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/galaxy.clairdrop.Query/ClaimableForAction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClaimableForAction(ctx, req.(*QueryClaimableForActionRequest))
+var xxx_messageInfo_QueryClaimRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryClaimRecordsRequest) GetStatus() ClaimRecordStatus {
+	if m != nil {
+		return m.Status
 	}
-	return interceptor(ctx, in, info, handler)
+	return AnyStatus
 }
 
-func _Query_TotalClaimable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalClaimableRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalClaimable(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/galaxy.clairdrop.Query/TotalClaimable",
+func (m *QueryClaimRecordsRequest) GetCompletedActions() []ClaimAction {
+	if m != nil {
+		return m.CompletedActions
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalClaimable(ctx, req.(*QueryTotalClaimableRequest))
+	return nil
+}
+
+func (m *QueryClaimRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "galaxy.clairdrop.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ModuleAccountBalance",
-			Handler:    _Query_ModuleAccountBalance_Handler,
-		},
-		{
-			MethodName: "ClaimRecord",
-			Handler:    _Query_ClaimRecord_Handler,
-		},
-		{
-			MethodName: "ClaimableForAction",
-			Handler:    _Query_ClaimableForAction_Handler,
-		},
-		{
-			MethodName: "TotalClaimable",
-			Handler:    _Query_TotalClaimable_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "galaxy/clairdrop/query.proto",
+type QueryClaimRecordsResponse struct {
+	ClaimRecords []ClaimRecord       `protobuf:"bytes,1,rep,name=claim_records,json=claimRecords,proto3" json:"claim_records"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryClaimRecordsResponse) Reset()         { *m = QueryClaimRecordsResponse{} }
+func (m *QueryClaimRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimRecordsResponse) ProtoMessage()    {}
+func (*QueryClaimRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_490dbb3da7356033, []int{15}
+}
+func (m *QueryClaimRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryClaimRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimRecordsResponse.Merge(m, src)
+}
+func (m *QueryClaimRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimRecordsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryClaimRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryClaimRecordsResponse) GetClaimRecords() []ClaimRecord {
+	if m != nil {
+		return m.ClaimRecords
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryClaimRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryStatsRequest struct {
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryStatsRequest) Reset()         { *m = QueryStatsRequest{} }
+func (m *QueryStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStatsRequest) ProtoMessage()    {}
+func (*QueryStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_490dbb3da7356033, []int{16}
+}
+func (m *QueryStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryModuleAccountBalanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStatsRequest.Merge(m, src)
 }
-
-func (m *QueryModuleAccountBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryStatsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryModuleAccountBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStatsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryModuleAccountBalanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_QueryStatsRequest proto.InternalMessageInfo
 
-func (m *QueryModuleAccountBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryStatsResponse struct {
+	Stats ClairdropStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
+	// amount left in the module account for the claims and the community pool
+	ModuleAccountBalance types.Coin `protobuf:"bytes,2,opt,name=module_account_balance,json=moduleAccountBalance,proto3" json:"module_account_balance"`
 }
 
-func (m *QueryModuleAccountBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ModuleAccountBalance) > 0 {
-		for iNdEx := len(m.ModuleAccountBalance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ModuleAccountBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryStatsResponse) Reset()         { *m = QueryStatsResponse{} }
+func (m *QueryStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStatsResponse) ProtoMessage()    {}
+func (*QueryStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_490dbb3da7356033, []int{17}
+}
+func (m *QueryStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStatsResponse.Merge(m, src)
+}
+func (m *QueryStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStatsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryClaimRecordRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStatsResponse proto.InternalMessageInfo
+
+func (m *QueryStatsResponse) GetStats() ClairdropStats {
+	if m != nil {
+		return m.Stats
 	}
-	return dAtA[:n], nil
+	return ClairdropStats{}
 }
 
-func (m *QueryClaimRecordRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryStatsResponse) GetModuleAccountBalance() types.Coin {
+	if m != nil {
+		return m.ModuleAccountBalance
+	}
+	return types.Coin{}
 }
 
-func (m *QueryClaimRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type QueryClawbackRequest struct {
 }
 
-func (m *QueryClaimRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryClawbackRequest) Reset()         { *m = QueryClawbackRequest{} }
+func (m *QueryClawbackRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClawbackRequest) ProtoMessage()    {}
+func (*QueryClawbackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_490dbb3da7356033, []int{18}
+}
+func (m *QueryClawbackRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClawbackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClawbackRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryClawbackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClawbackRequest.Merge(m, src)
+}
+func (m *QueryClawbackRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClawbackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClawbackRequest.DiscardUnknown(m)
 }
 
-func (m *QueryClaimRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryClawbackRequest proto.InternalMessageInfo
+
+type QueryClawbackResponse struct {
+	Clawback ClawbackProgress `protobuf:"bytes,1,opt,name=clawback,proto3" json:"clawback"`
+	// number of the claim records the clawback processes per block
+	RecordsPerBlock uint64 `protobuf:"varint,2,opt,name=records_per_block,json=recordsPerBlock,proto3" json:"records_per_block,omitempty"`
 }
 
-func (m *QueryClaimRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ClaimRecord.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryClawbackResponse) Reset()         { *m = QueryClawbackResponse{} }
+func (m *QueryClawbackResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClawbackResponse) ProtoMessage()    {}
+func (*QueryClawbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_490dbb3da7356033, []int{19}
+}
+func (m *QueryClawbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClawbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClawbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryClawbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClawbackResponse.Merge(m, src)
+}
+func (m *QueryClawbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClawbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClawbackResponse.DiscardUnknown(m)
 }
 
-func (m *QueryClaimableForActionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClawbackResponse proto.InternalMessageInfo
+
+func (m *QueryClawbackResponse) GetClawback() ClawbackProgress {
+	if m != nil {
+		return m.Clawback
 	}
-	return dAtA[:n], nil
+	return ClawbackProgress{}
 }
 
-func (m *QueryClaimableForActionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryClawbackResponse) GetRecordsPerBlock() uint64 {
+	if m != nil {
+		return m.RecordsPerBlock
+	}
+	return 0
 }
 
-func (m *QueryClaimableForActionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Action != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Action))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "galaxy.clairdrop.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "galaxy.clairdrop.QueryParamsResponse")
+	proto.RegisterType((*QueryModuleAccountBalanceRequest)(nil), "galaxy.clairdrop.QueryModuleAccountBalanceRequest")
+	proto.RegisterType((*QueryModuleAccountBalanceResponse)(nil), "galaxy.clairdrop.QueryModuleAccountBalanceResponse")
+	proto.RegisterType((*QueryClaimRecordRequest)(nil), "galaxy.clairdrop.QueryClaimRecordRequest")
+	proto.RegisterType((*QueryClaimRecordResponse)(nil), "galaxy.clairdrop.QueryClaimRecordResponse")
+	proto.RegisterType((*QueryClaimableForActionRequest)(nil), "galaxy.clairdrop.QueryClaimableForActionRequest")
+	proto.RegisterType((*QueryClaimableForActionResponse)(nil), "galaxy.clairdrop.QueryClaimableForActionResponse")
+	proto.RegisterType((*QueryTotalClaimableRequest)(nil), "galaxy.clairdrop.QueryTotalClaimableRequest")
+	proto.RegisterType((*QueryTotalClaimableResponse)(nil), "galaxy.clairdrop.QueryTotalClaimableResponse")
+	proto.RegisterType((*QueryPauseRequest)(nil), "galaxy.clairdrop.QueryPauseRequest")
+	proto.RegisterType((*QueryPauseResponse)(nil), "galaxy.clairdrop.QueryPauseResponse")
+	proto.RegisterType((*QueryMerkleAirdropRequest)(nil), "galaxy.clairdrop.QueryMerkleAirdropRequest")
+	proto.RegisterType((*QueryMerkleAirdropResponse)(nil), "galaxy.clairdrop.QueryMerkleAirdropResponse")
+	proto.RegisterType((*QueryClaimRecordsRequest)(nil), "galaxy.clairdrop.QueryClaimRecordsRequest")
+	proto.RegisterType((*QueryClaimRecordsResponse)(nil), "galaxy.clairdrop.QueryClaimRecordsResponse")
+	proto.RegisterType((*QueryStatsRequest)(nil), "galaxy.clairdrop.QueryStatsRequest")
+	proto.RegisterType((*QueryStatsResponse)(nil), "galaxy.clairdrop.QueryStatsResponse")
+	proto.RegisterType((*QueryClawbackRequest)(nil), "galaxy.clairdrop.QueryClawbackRequest")
+	proto.RegisterType((*QueryClawbackResponse)(nil), "galaxy.clairdrop.QueryClawbackResponse")
 }
 
-func (m *QueryClaimableForActionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() { proto.RegisterFile("galaxy/clairdrop/query.proto", fileDescriptor_490dbb3da7356033) }
+
+var fileDescriptor_490dbb3da7356033 = []byte{
+	// 1154 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xa4, 0x4d, 0x28, 0x2f, 0x3f, 0xda, 0x4c, 0x43, 0xeb, 0x6c, 0x53, 0xdb, 0xdd, 0x86,
+	0xc6, 0x24, 0x8d, 0x37, 0x3f, 0x44, 0x25, 0x54, 0x2e, 0x49, 0x51, 0x40, 0x40, 0xa5, 0x60, 0xe0,
+	0xc2, 0xc5, 0x1a, 0xaf, 0x07, 0x63, 0xbc, 0xde, 0xd9, 0xec, 0xac, 0x49, 0xa3, 0xaa, 0xaa, 0xc4,
+	0x8d, 0x5b, 0x25, 0x10, 0x7f, 0x00, 0xe2, 0x02, 0x07, 0x24, 0xee, 0x9c, 0x51, 0x8f, 0x95, 0xb8,
+	0x70, 0x02, 0x94, 0x70, 0xe3, 0xce, 0x81, 0x13, 0xda, 0x99, 0xb7, 0xeb, 0x75, 0x77, 0xd7, 0x76,
+	0x25, 0xb8, 0xf4, 0x94, 0xf5, 0x9b, 0xf7, 0xe6, 0xfb, 0xde, 0x9b, 0xf7, 0xe6, 0x9b, 0xc0, 0x72,
+	0x8b, 0x39, 0xec, 0xde, 0xb1, 0x65, 0x3b, 0xac, 0xed, 0x37, 0x7d, 0xe1, 0x59, 0x87, 0x3d, 0xee,
+	0x1f, 0x57, 0x3d, 0x5f, 0x04, 0x82, 0x5e, 0xd0, 0xab, 0xd5, 0x78, 0xd5, 0x58, 0x6e, 0x09, 0xd1,
+	0x72, 0xb8, 0xc5, 0xbc, 0xb6, 0xc5, 0x5c, 0x57, 0x04, 0x2c, 0x68, 0x0b, 0x57, 0x6a, 0x7f, 0xa3,
+	0x68, 0x0b, 0xd9, 0x15, 0xd2, 0x6a, 0x30, 0xc9, 0xad, 0xcf, 0xb6, 0x1a, 0x3c, 0x60, 0x5b, 0x96,
+	0x2d, 0xda, 0x2e, 0xae, 0xaf, 0x25, 0xd7, 0x15, 0x50, 0xec, 0xe5, 0xb1, 0x56, 0xdb, 0x55, 0x9b,
+	0xa1, 0xef, 0xd5, 0x14, 0x33, 0x8f, 0xf9, 0xac, 0x1b, 0x41, 0x2d, 0xb6, 0x44, 0x4b, 0xa8, 0x4f,
+	0x2b, 0xfc, 0x42, 0x6b, 0x39, 0x15, 0x14, 0x7f, 0x69, 0x0f, 0x73, 0x11, 0xe8, 0x7b, 0x21, 0xf0,
+	0x81, 0xda, 0xac, 0xc6, 0x0f, 0x7b, 0x5c, 0x06, 0xe6, 0x5d, 0xb8, 0x38, 0x60, 0x95, 0x9e, 0x70,
+	0x25, 0xa7, 0xb7, 0x60, 0x5a, 0x83, 0x16, 0x48, 0x99, 0x54, 0x66, 0xb6, 0x0b, 0xd5, 0xa7, 0x0b,
+	0x52, 0xd5, 0x11, 0x7b, 0x67, 0x1f, 0xff, 0x56, 0x9a, 0xa8, 0xa1, 0xb7, 0x69, 0x42, 0x59, 0x6d,
+	0x77, 0x57, 0x34, 0x7b, 0x0e, 0xdf, 0xb5, 0x6d, 0xd1, 0x73, 0x83, 0x3d, 0xe6, 0x30, 0xd7, 0xe6,
+	0x11, 0xe4, 0xb7, 0x04, 0xae, 0x0d, 0x71, 0x42, 0x06, 0x0f, 0x61, 0xb1, 0x9b, 0xb1, 0x5e, 0x20,
+	0xe5, 0x33, 0x95, 0x99, 0xed, 0xa5, 0xaa, 0x2e, 0x68, 0x35, 0x2c, 0x68, 0x15, 0x4b, 0x59, 0xbd,
+	0x23, 0xda, 0xee, 0xde, 0x66, 0x48, 0xe8, 0xfb, 0xdf, 0x4b, 0x95, 0x56, 0x3b, 0xf8, 0xa4, 0xd7,
+	0xa8, 0xda, 0xa2, 0x6b, 0x61, 0xf5, 0xf5, 0x9f, 0x0d, 0xd9, 0xec, 0x58, 0xc1, 0xb1, 0xc7, 0xa5,
+	0x0a, 0x90, 0xb5, 0x4c, 0x20, 0x73, 0x07, 0x2e, 0x2b, 0x96, 0x77, 0x1c, 0xd6, 0xee, 0xd6, 0xb8,
+	0x2d, 0xfc, 0x26, 0x66, 0x40, 0x0b, 0xf0, 0x02, 0x6b, 0x36, 0x7d, 0x2e, 0x75, 0x79, 0x5e, 0xac,
+	0x45, 0x3f, 0xcd, 0x06, 0x14, 0xd2, 0x41, 0x98, 0xd1, 0x3e, 0xcc, 0x86, 0xd5, 0xeb, 0xd6, 0x7d,
+	0x65, 0xc7, 0xca, 0x5e, 0x4d, 0x57, 0x36, 0x11, 0x8c, 0xe5, 0x9d, 0xb1, 0xfb, 0x26, 0xf3, 0x10,
+	0x8a, 0x7d, 0x0c, 0xd6, 0x70, 0xf8, 0xbe, 0xf0, 0x77, 0xed, 0xb0, 0x81, 0x46, 0xf2, 0xa3, 0xaf,
+	0xc2, 0x34, 0x53, 0xae, 0x85, 0xc9, 0x32, 0xa9, 0xcc, 0xe7, 0xa2, 0xe3, 0x7e, 0xe8, 0x6c, 0xfe,
+	0x43, 0xa0, 0x94, 0x8b, 0x89, 0xe9, 0x31, 0x98, 0x0a, 0x1b, 0x5e, 0xfe, 0x1f, 0x27, 0xa4, 0x77,
+	0xa6, 0x01, 0x9c, 0xef, 0xb9, 0x4d, 0x6e, 0xb3, 0x63, 0xde, 0xac, 0x6b, 0xb0, 0xc9, 0xff, 0x1e,
+	0x6c, 0x3e, 0xc6, 0x50, 0xbf, 0xcd, 0x5b, 0x60, 0xa8, 0xdc, 0x3f, 0x10, 0x01, 0x73, 0xe2, 0x02,
+	0x8c, 0xee, 0x85, 0xbf, 0x09, 0x5c, 0xc9, 0x0c, 0x7c, 0xde, 0x0b, 0x76, 0x11, 0x16, 0xf0, 0x4e,
+	0xe9, 0xc9, 0x78, 0xea, 0x6b, 0x40, 0x93, 0x46, 0xac, 0xc1, 0xeb, 0x30, 0xe5, 0x85, 0x06, 0x1c,
+	0x86, 0x72, 0x76, 0x3b, 0xaa, 0x2f, 0x15, 0x88, 0xf3, 0xa0, 0x83, 0xcc, 0x2b, 0xb0, 0xa4, 0x2f,
+	0x12, 0xee, 0x77, 0x1c, 0xbe, 0xab, 0xfd, 0x22, 0xc0, 0x4f, 0xc1, 0xc8, 0x5a, 0x44, 0xe0, 0x77,
+	0x61, 0xbe, 0xab, 0x16, 0xea, 0xb8, 0x3d, 0x32, 0x28, 0xa5, 0x19, 0x0c, 0x6c, 0x80, 0x04, 0xe6,
+	0xba, 0x49, 0xa3, 0xf9, 0x17, 0x49, 0xcf, 0x7d, 0x74, 0xc5, 0xd2, 0xdb, 0x30, 0x2d, 0x03, 0x16,
+	0xf4, 0x74, 0x83, 0xcc, 0x6f, 0x5f, 0x1f, 0x3a, 0xf1, 0xef, 0x2b, 0xd7, 0x1a, 0x86, 0xd0, 0xb7,
+	0x61, 0xc1, 0x16, 0x5d, 0xcf, 0xe1, 0x01, 0x6f, 0xd6, 0xf5, 0x34, 0xea, 0x33, 0x1c, 0x39, 0xbb,
+	0x17, 0xe2, 0x38, 0x6d, 0x90, 0x74, 0x1f, 0xa0, 0x2f, 0x36, 0x85, 0x33, 0x2a, 0xdf, 0x1b, 0x03,
+	0x8d, 0xa0, 0x25, 0x30, 0x6a, 0x87, 0x03, 0xd6, 0x8a, 0x8e, 0xaf, 0x96, 0x88, 0x34, 0x7f, 0x20,
+	0x58, 0xf7, 0xc1, 0x6c, 0xb1, 0xb2, 0x6f, 0xc1, 0x5c, 0xf2, 0x9a, 0x8b, 0xda, 0x7b, 0xac, 0x7b,
+	0x6e, 0x36, 0x71, 0xcf, 0x49, 0xfa, 0xe6, 0x00, 0xdf, 0x49, 0xc5, 0x77, 0x75, 0x24, 0x5f, 0x4d,
+	0x63, 0x80, 0x70, 0xd4, 0x90, 0x61, 0x6d, 0x63, 0xe5, 0xfb, 0x8e, 0x00, 0x4d, 0x5a, 0xfb, 0x1d,
+	0x19, 0x96, 0x5e, 0x8e, 0xd1, 0x91, 0x2a, 0x30, 0xea, 0x48, 0x15, 0x44, 0x3f, 0x84, 0x4b, 0x5a,
+	0x4c, 0xea, 0x4c, 0xab, 0x49, 0xbd, 0x81, 0xba, 0xa5, 0xe9, 0x0f, 0x99, 0x3b, 0xbd, 0x4f, 0xb6,
+	0x16, 0x5d, 0x82, 0xc5, 0xa8, 0xe0, 0x47, 0x0d, 0x66, 0x77, 0xa2, 0x1c, 0xbe, 0x20, 0xf0, 0xd2,
+	0x53, 0x0b, 0x98, 0xc6, 0x1b, 0x70, 0xce, 0x46, 0x1b, 0x66, 0x62, 0x66, 0x66, 0xa2, 0x3c, 0x0e,
+	0x7c, 0xd1, 0xf2, 0xb9, 0x8c, 0x72, 0x89, 0x23, 0xe9, 0x1a, 0x2c, 0xe0, 0x29, 0xd6, 0x3d, 0xee,
+	0xd7, 0x1b, 0x8e, 0xb0, 0x3b, 0x2a, 0x93, 0xb3, 0xb5, 0xf3, 0xb8, 0x70, 0xc0, 0xfd, 0xbd, 0xd0,
+	0xbc, 0xfd, 0xf3, 0x0c, 0x4c, 0x29, 0x2e, 0xf4, 0x08, 0xa6, 0xf5, 0xe3, 0x80, 0xae, 0xa4, 0x31,
+	0xd3, 0x6f, 0x10, 0xe3, 0xe5, 0x11, 0x5e, 0x3a, 0x25, 0xb3, 0xfc, 0xf9, 0x2f, 0x7f, 0x7e, 0x39,
+	0x69, 0xd0, 0x82, 0x95, 0xf3, 0x40, 0xa2, 0x3f, 0x12, 0x58, 0xcc, 0x7a, 0x54, 0xd0, 0xed, 0x1c,
+	0x84, 0x21, 0xcf, 0x14, 0x63, 0xe7, 0x99, 0x62, 0x90, 0xe3, 0xa6, 0xe2, 0xb8, 0x46, 0x2b, 0x69,
+	0x8e, 0xd9, 0x7d, 0x41, 0xbf, 0x26, 0x30, 0x93, 0x18, 0x04, 0xfa, 0x4a, 0x0e, 0x6c, 0xfa, 0x19,
+	0x62, 0xac, 0x8d, 0xe3, 0x3a, 0x9a, 0x58, 0x72, 0x5a, 0xad, 0xfb, 0xa8, 0x5e, 0x0f, 0xe8, 0x4f,
+	0x04, 0x68, 0x5a, 0xee, 0xe9, 0xe6, 0x30, 0xd0, 0xac, 0xd7, 0x88, 0xb1, 0xf5, 0x0c, 0x11, 0xc8,
+	0x76, 0x57, 0xb1, 0xbd, 0x4d, 0x5f, 0xcb, 0x61, 0x1b, 0x46, 0xd5, 0x3f, 0x16, 0x3e, 0xde, 0x88,
+	0x7d, 0xd6, 0xd6, 0x7d, 0x6d, 0x79, 0x40, 0xbf, 0x21, 0x30, 0x3f, 0x28, 0xbc, 0xf4, 0x66, 0x0e,
+	0x91, 0x4c, 0x61, 0x37, 0x36, 0xc6, 0xf4, 0x46, 0xca, 0x3b, 0x8a, 0xf2, 0x06, 0x5d, 0x4f, 0x53,
+	0x0e, 0xc2, 0x88, 0x7a, 0x4c, 0x3c, 0x51, 0x63, 0x09, 0x53, 0x4a, 0xd6, 0xe8, 0xf5, 0xdc, 0x11,
+	0xe8, 0x4b, 0xa8, 0xb1, 0x32, 0xdc, 0x09, 0x89, 0x94, 0x14, 0x91, 0x25, 0x7a, 0x39, 0x6b, 0x4c,
+	0x42, 0xac, 0xaf, 0x08, 0xcc, 0x0d, 0x68, 0x1a, 0x5d, 0xcf, 0x6b, 0xf5, 0x0c, 0x5d, 0x35, 0x6e,
+	0x8e, 0xe7, 0x8c, 0x6c, 0x2a, 0x8a, 0x8d, 0x49, 0xcb, 0x19, 0x03, 0x31, 0xa0, 0xbf, 0xf4, 0x11,
+	0x81, 0xd9, 0xa4, 0xa0, 0xd0, 0x31, 0xda, 0x3b, 0xbe, 0x42, 0xd6, 0xc7, 0xf2, 0x45, 0x4e, 0xab,
+	0x8a, 0xd3, 0x35, 0x5a, 0x1a, 0x3e, 0x0b, 0x32, 0x3c, 0x1e, 0x75, 0xc7, 0xe7, 0x1e, 0x4f, 0x52,
+	0x50, 0x8c, 0x95, 0xe1, 0x4e, 0xa3, 0x8f, 0x47, 0x4b, 0xc8, 0x43, 0x38, 0x17, 0xdd, 0xcb, 0xf4,
+	0x46, 0x7e, 0x5a, 0x49, 0x1d, 0x30, 0x56, 0x47, 0xfa, 0x21, 0xba, 0xa9, 0xd0, 0x97, 0xa9, 0x91,
+	0x99, 0xba, 0xf2, 0xdd, 0x7b, 0xe7, 0xf1, 0x49, 0x91, 0x3c, 0x39, 0x29, 0x92, 0x3f, 0x4e, 0x8a,
+	0xe4, 0xd1, 0x69, 0x71, 0xe2, 0xc9, 0x69, 0x71, 0xe2, 0xd7, 0xd3, 0xe2, 0xc4, 0x47, 0x5b, 0x89,
+	0x27, 0xa1, 0x8e, 0x77, 0x79, 0x70, 0x24, 0xfc, 0x4e, 0xb4, 0xdb, 0xbd, 0x64, 0xd7, 0x87, 0x2f,
+	0xc4, 0xc6, 0xb4, 0xfa, 0xe7, 0x73, 0xe7, 0xdf, 0x01, 0x00, 0x28, 0xab, 0x2a, 0x4b, 0x6f, 0x0f,
+	0x00, 0x00,
 }
 
-func (m *QueryClaimableForActionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	ModuleAccountBalance(ctx context.Context, in *QueryModuleAccountBalanceRequest, opts ...grpc.CallOption) (*QueryModuleAccountBalanceResponse, error)
+	ClaimRecord(ctx context.Context, in *QueryClaimRecordRequest, opts ...grpc.CallOption) (*QueryClaimRecordResponse, error)
+	ClaimableForAction(ctx context.Context, in *QueryClaimableForActionRequest, opts ...grpc.CallOption) (*QueryClaimableForActionResponse, error)
+	TotalClaimable(ctx context.Context, in *QueryTotalClaimableRequest, opts ...grpc.CallOption) (*QueryTotalClaimableResponse, error)
+	// Pause returns the pause state of claiming and clawback.
+	Pause(ctx context.Context, in *QueryPauseRequest, opts ...grpc.CallOption) (*QueryPauseResponse, error)
+	// MerkleAirdrop returns the allocations committed to by a Merkle root.
+	MerkleAirdrop(ctx context.Context, in *QueryMerkleAirdropRequest, opts ...grpc.CallOption) (*QueryMerkleAirdropResponse, error)
+	// ClaimRecords returns the claim records filtered by their progress.
+	ClaimRecords(ctx context.Context, in *QueryClaimRecordsRequest, opts ...grpc.CallOption) (*QueryClaimRecordsResponse, error)
+	// Stats returns the aggregate progress of the clairdrop.
+	Stats(ctx context.Context, in *QueryStatsRequest, opts ...grpc.CallOption) (*QueryStatsResponse, error)
+	// Clawback returns the progress of the clawback run after the clairdrop end.
+	Clawback(ctx context.Context, in *QueryClawbackRequest, opts ...grpc.CallOption) (*QueryClawbackResponse, error)
 }
 
-func (m *QueryClaimableForActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryTotalClaimableRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.clairdrop.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryTotalClaimableRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryTotalClaimableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ModuleAccountBalance(ctx context.Context, in *QueryModuleAccountBalanceRequest, opts ...grpc.CallOption) (*QueryModuleAccountBalanceResponse, error) {
+	out := new(QueryModuleAccountBalanceResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.clairdrop.Query/ModuleAccountBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTotalClaimableResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ClaimRecord(ctx context.Context, in *QueryClaimRecordRequest, opts ...grpc.CallOption) (*QueryClaimRecordResponse, error) {
+	out := new(QueryClaimRecordResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.clairdrop.Query/ClaimRecord", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTotalClaimableResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ClaimableForAction(ctx context.Context, in *QueryClaimableForActionRequest, opts ...grpc.CallOption) (*QueryClaimableForActionResponse, error) {
+	out := new(QueryClaimableForActionResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.clairdrop.Query/ClaimableForAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTotalClaimableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) TotalClaimable(ctx context.Context, in *QueryTotalClaimableRequest, opts ...grpc.CallOption) (*QueryTotalClaimableResponse, error) {
+	out := new(QueryTotalClaimableResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.clairdrop.Query/TotalClaimable", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) Pause(ctx context.Context, in *QueryPauseRequest, opts ...grpc.CallOption) (*QueryPauseResponse, error) {
+	out := new(QueryPauseResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.clairdrop.Query/Pause", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) MerkleAirdrop(ctx context.Context, in *QueryMerkleAirdropRequest, opts ...grpc.CallOption) (*QueryMerkleAirdropResponse, error) {
+	out := new(QueryMerkleAirdropResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.clairdrop.Query/MerkleAirdrop", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ClaimRecords(ctx context.Context, in *QueryClaimRecordsRequest, opts ...grpc.CallOption) (*QueryClaimRecordsResponse, error) {
+	out := new(QueryClaimRecordsResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.clairdrop.Query/ClaimRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryModuleAccountBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Stats(ctx context.Context, in *QueryStatsRequest, opts ...grpc.CallOption) (*QueryStatsResponse, error) {
+	out := new(QueryStatsResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.clairdrop.Query/Stats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryModuleAccountBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ModuleAccountBalance) > 0 {
-		for _, e := range m.ModuleAccountBalance {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) Clawback(ctx context.Context, in *QueryClawbackRequest, opts ...grpc.CallOption) (*QueryClawbackResponse, error) {
+	out := new(QueryClawbackResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.clairdrop.Query/Clawback", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryClaimRecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	ModuleAccountBalance(context.Context, *QueryModuleAccountBalanceRequest) (*QueryModuleAccountBalanceResponse, error)
+	ClaimRecord(context.Context, *QueryClaimRecordRequest) (*QueryClaimRecordResponse, error)
+	ClaimableForAction(context.Context, *QueryClaimableForActionRequest) (*QueryClaimableForActionResponse, error)
+	TotalClaimable(context.Context, *QueryTotalClaimableRequest) (*QueryTotalClaimableResponse, error)
+	// Pause returns the pause state of claiming and clawback.
+	Pause(context.Context, *QueryPauseRequest) (*QueryPauseResponse, error)
+	// MerkleAirdrop returns the allocations committed to by a Merkle root.
+	MerkleAirdrop(context.Context, *QueryMerkleAirdropRequest) (*QueryMerkleAirdropResponse, error)
+	// ClaimRecords returns the claim records filtered by their progress.
+	ClaimRecords(context.Context, *QueryClaimRecordsRequest) (*QueryClaimRecordsResponse, error)
+	// Stats returns the aggregate progress of the clairdrop.
+	Stats(context.Context, *QueryStatsRequest) (*QueryStatsResponse, error)
+	// Clawback returns the progress of the clawback run after the clairdrop end.
+	Clawback(context.Context, *QueryClawbackRequest) (*QueryClawbackResponse, error)
 }
 
-func (m *QueryClaimRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ClaimRecord.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryClaimableForActionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ModuleAccountBalance(ctx context.Context, req *QueryModuleAccountBalanceRequest) (*QueryModuleAccountBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccountBalance not implemented")
+}
+func (*UnimplementedQueryServer) ClaimRecord(ctx context.Context, req *QueryClaimRecordRequest) (*QueryClaimRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRecord not implemented")
+}
+func (*UnimplementedQueryServer) ClaimableForAction(ctx context.Context, req *QueryClaimableForActionRequest) (*QueryClaimableForActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableForAction not implemented")
+}
+func (*UnimplementedQueryServer) TotalClaimable(ctx context.Context, req *QueryTotalClaimableRequest) (*QueryTotalClaimableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalClaimable not implemented")
+}
+func (*UnimplementedQueryServer) Pause(ctx context.Context, req *QueryPauseRequest) (*QueryPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (*UnimplementedQueryServer) MerkleAirdrop(ctx context.Context, req *QueryMerkleAirdropRequest) (*QueryMerkleAirdropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MerkleAirdrop not implemented")
+}
+func (*UnimplementedQueryServer) ClaimRecords(ctx context.Context, req *QueryClaimRecordsRequest) (*QueryClaimRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRecords not implemented")
+}
+func (*UnimplementedQueryServer) Stats(ctx context.Context, req *QueryStatsRequest) (*QueryStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (*UnimplementedQueryServer) Clawback(ctx context.Context, req *QueryClawbackRequest) (*QueryClawbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Clawback not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Action != 0 {
-		n += 1 + sovQuery(uint64(m.Action))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.clairdrop.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryClaimableForActionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ModuleAccountBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleAccountBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleAccountBalance(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.clairdrop.Query/ModuleAccountBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleAccountBalance(ctx, req.(*QueryModuleAccountBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalClaimableRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ClaimRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimRecord(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.clairdrop.Query/ClaimRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimRecord(ctx, req.(*QueryClaimRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalClaimableResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ClaimableForAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableForActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableForAction(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.clairdrop.Query/ClaimableForAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableForAction(ctx, req.(*QueryClaimableForActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalClaimable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalClaimableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalClaimable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.clairdrop.Query/TotalClaimable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalClaimable(ctx, req.(*QueryTotalClaimableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.clairdrop.Query/Pause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pause(ctx, req.(*QueryPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MerkleAirdrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMerkleAirdropRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MerkleAirdrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.clairdrop.Query/MerkleAirdrop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MerkleAirdrop(ctx, req.(*QueryMerkleAirdropRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.clairdrop.Query/ClaimRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimRecords(ctx, req.(*QueryClaimRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.clairdrop.Query/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Stats(ctx, req.(*QueryStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Clawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClawbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Clawback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.clairdrop.Query/Clawback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Clawback(ctx, req.(*QueryClawbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "galaxy.clairdrop.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ModuleAccountBalance",
+			Handler:    _Query_ModuleAccountBalance_Handler,
+		},
+		{
+			MethodName: "ClaimRecord",
+			Handler:    _Query_ClaimRecord_Handler,
+		},
+		{
+			MethodName: "ClaimableForAction",
+			Handler:    _Query_ClaimableForAction_Handler,
+		},
+		{
+			MethodName: "TotalClaimable",
+			Handler:    _Query_TotalClaimable_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _Query_Pause_Handler,
+		},
+		{
+			MethodName: "MerkleAirdrop",
+			Handler:    _Query_MerkleAirdrop_Handler,
+		},
+		{
+			MethodName: "ClaimRecords",
+			Handler:    _Query_ClaimRecords_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _Query_Stats_Handler,
+		},
+		{
+			MethodName: "Clawback",
+			Handler:    _Query_Clawback_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "galaxy/clairdrop/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleAccountBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAccountBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAccountBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleAccountBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAccountBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAccountBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleAccountBalance) > 0 {
+		for iNdEx := len(m.ModuleAccountBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleAccountBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClaimRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableForActionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableForActionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableForActionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Action != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableForActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableForActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableForActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UndecayedCoins) > 0 {
+		for iNdEx := len(m.UndecayedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UndecayedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalClaimableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalClaimableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalClaimableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalClaimableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalClaimableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalClaimableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UndecayedCoins) > 0 {
+		for iNdEx := len(m.UndecayedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UndecayedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pause.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerkleAirdropRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerkleAirdropRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerkleAirdropRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerkleAirdropResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerkleAirdropResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerkleAirdropResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MerkleAirdrop.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CompletedActions) > 0 {
+		dAtA7 := make([]byte, len(m.CompletedActions)*10)
+		var j6 int
+		for _, num := range m.CompletedActions {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintQuery(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClaimRecords) > 0 {
+		for iNdEx := len(m.ClaimRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ModuleAccountBalance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClawbackRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClawbackRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClawbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClawbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClawbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordsPerBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecordsPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Clawback.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryModuleAccountBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleAccountBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ModuleAccountBalance) > 0 {
+		for _, e := range m.ModuleAccountBalance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryClaimRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ClaimRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClaimableForActionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovQuery(uint64(m.Action))
+	}
+	return n
+}
+
+func (m *QueryClaimableForActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UndecayedCoins) > 0 {
+		for _, e := range m.UndecayedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTotalClaimableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalClaimableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UndecayedCoins) > 0 {
+		for _, e := range m.UndecayedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPauseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pause.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMerkleAirdropRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMerkleAirdropResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MerkleAirdrop.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClaimRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if len(m.CompletedActions) > 0 {
+		l = 0
+		for _, e := range m.CompletedActions {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClaimRecords) > 0 {
+		for _, e := range m.ClaimRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ModuleAccountBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClawbackRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryClawbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Clawback.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.RecordsPerBlock != 0 {
+		n += 1 + sovQuery(uint64(m.RecordsPerBlock))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleAccountBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAccountBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAccountBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleAccountBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAccountBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAccountBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccountBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccountBalance = append(m.ModuleAccountBalance, types.Coin{})
+			if err := m.ModuleAccountBalance[len(m.ModuleAccountBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimableForActionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableForActionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableForActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= ClaimAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimableForActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableForActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableForActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UndecayedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UndecayedCoins = append(m.UndecayedCoins, types.Coin{})
+			if err := m.UndecayedCoins[len(m.UndecayedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalClaimableRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalClaimableRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalClaimableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalClaimableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalClaimableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalClaimableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UndecayedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UndecayedCoins = append(m.UndecayedCoins, types.Coin{})
+			if err := m.UndecayedCoins[len(m.UndecayedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPauseRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1236,10 +3301,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPauseRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPauseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1263,7 +3328,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPauseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1286,15 +3351,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPauseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1321,7 +3386,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1346,7 +3411,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleAccountBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMerkleAirdropRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1369,10 +3434,10 @@ func (m *QueryModuleAccountBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAccountBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMerkleAirdropRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAccountBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMerkleAirdropRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1396,7 +3461,7 @@ func (m *QueryModuleAccountBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleAccountBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMerkleAirdropResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1419,15 +3484,15 @@ func (m *QueryModuleAccountBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAccountBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMerkleAirdropResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAccountBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMerkleAirdropResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccountBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleAirdrop", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1454,8 +3519,7 @@ func (m *QueryModuleAccountBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleAccountBalance = append(m.ModuleAccountBalance, types.Coin{})
-			if err := m.ModuleAccountBalance[len(m.ModuleAccountBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MerkleAirdrop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1480,7 +3544,7 @@ func (m *QueryModuleAccountBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClaimRecordRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1503,17 +3567,105 @@ func (m *QueryClaimRecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimRecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ClaimRecordStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v ClaimAction
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ClaimAction(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CompletedActions = append(m.CompletedActions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.CompletedActions) == 0 {
+					m.CompletedActions = make([]ClaimAction, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ClaimAction
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ClaimAction(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CompletedActions = append(m.CompletedActions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedActions", wireType)
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1523,23 +3675,27 @@ func (m *QueryClaimRecordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1562,7 +3718,7 @@ func (m *QueryClaimRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClaimRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1585,15 +3741,15 @@ func (m *QueryClaimRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimRecord", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1620,7 +3776,44 @@ func (m *QueryClaimRecordResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClaimRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClaimRecords = append(m.ClaimRecords, ClaimRecord{})
+			if err := m.ClaimRecords[len(m.ClaimRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1645,7 +3838,7 @@ func (m *QueryClaimRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClaimableForActionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1668,63 +3861,12 @@ func (m *QueryClaimableForActionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimableForActionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimableForActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
-			}
-			m.Action = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Action |= ClaimAction(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1746,7 +3888,7 @@ func (m *QueryClaimableForActionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClaimableForActionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1769,15 +3911,15 @@ func (m *QueryClaimableForActionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimableForActionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimableForActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1804,8 +3946,40 @@ func (m *QueryClaimableForActionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccountBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ModuleAccountBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1830,7 +4004,7 @@ func (m *QueryClaimableForActionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalClaimableRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClawbackRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1853,44 +4027,12 @@ func (m *QueryTotalClaimableRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalClaimableRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClawbackRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalClaimableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClawbackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1912,7 +4054,7 @@ func (m *QueryTotalClaimableRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalClaimableResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClawbackResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1935,15 +4077,15 @@ func (m *QueryTotalClaimableResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalClaimableResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClawbackResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalClaimableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clawback", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1970,11 +4112,29 @@ func (m *QueryTotalClaimableResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Clawback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordsPerBlock", wireType)
+			}
+			m.RecordsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,130 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultClairdropStats returns the stats of a clairdrop without any claim
+// record.
+func DefaultClairdropStats() ClairdropStats {
+	stats := ClairdropStats{
+		TotalAllocated: sdk.Coins{},
+		Actions:        make([]ClaimActionStats, len(ClaimAction_name)),
+	}
+	for i := range stats.Actions {
+		stats.Actions[i] = ClaimActionStats{Action: ClaimAction(i), Claimed: sdk.Coins{}}
+	}
+	return stats
+}
+
+// NewClairdropStats returns the stats of the given claim records and of the
+// allocations of the Merkle airdrop whose claim record is not created yet. The
+// amount claimed for a completed action is taken to be its undecayed share, so
+// that a genesis written before the stats were kept can still be imported.
+func NewClairdropStats(claimRecords []ClaimRecord, merkleAirdrop MerkleAirdrop) ClairdropStats {
+	stats := DefaultClairdropStats().AddAllocation(merkleAirdrop.Unregistered())
+	for _, claimRecord := range claimRecords {
+		stats = stats.AddAllocation(claimRecord.InitalClaimableAmount)
+
+		share := sdk.Coins{}
+		for _, coin := range claimRecord.InitalClaimableAmount {
+			share = share.Add(sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(int64(len(ClaimAction_name)))))
+		}
+		for action, completed := range claimRecord.ActionCompleted {
+			if completed && action < len(stats.Actions) {
+				stats = stats.AddClaim(ClaimAction(action), share)
+			}
+		}
+	}
+	return stats
+}
+
+// AddAllocation adds the initial claimable amount of claim records.
+func (s ClairdropStats) AddAllocation(amount sdk.Coins) ClairdropStats {
+	s.TotalAllocated = s.TotalAllocated.Add(amount...)
+	return s
+}
+
+// AddClaim adds the claim of an action by a claim record.
+func (s ClairdropStats) AddClaim(action ClaimAction, amount sdk.Coins) ClairdropStats {
+	actions := make([]ClaimActionStats, len(s.Actions))
+	copy(actions, s.Actions)
+	actions[action].Claimed = actions[action].Claimed.Add(amount...)
+	actions[action].Claimants++
+	s.Actions = actions
+	return s
+}
+
+// IsEmpty returns true if the stats were never kept, as in a genesis written
+// before the stats were introduced.
+func (s ClairdropStats) IsEmpty() bool {
+	return s.TotalAllocated.Empty() && len(s.Actions) == 0
+}
+
+func (s ClairdropStats) Validate() error {
+	if err := s.TotalAllocated.Validate(); err != nil {
+		return fmt.Errorf("invalid total allocated: %w", err)
+	}
+	if len(s.Actions) != len(ClaimAction_name) {
+		return fmt.Errorf("stats of %d actions, expected %d", len(s.Actions), len(ClaimAction_name))
+	}
+
+	totalClaimed := sdk.Coins{}
+	for i, actionStats := range s.Actions {
+		if actionStats.Action != ClaimAction(i) {
+			return fmt.Errorf("stats of %s at %dth", actionStats.Action, i)
+		}
+		if err := actionStats.Claimed.Validate(); err != nil {
+			return fmt.Errorf("invalid claimed amount of %s: %w", actionStats.Action, err)
+		}
+		totalClaimed = totalClaimed.Add(actionStats.Claimed...)
+	}
+	if !totalClaimed.IsAllLTE(s.TotalAllocated) {
+		return fmt.Errorf("total claimed %s exceeds total allocated %s", totalClaimed, s.TotalAllocated)
+	}
+	return nil
+}
+
+// Status returns the progress of the claim record over all the actions.
+func (c ClaimRecord) Status() ClaimRecordStatus {
+	completed := 0
+	for _, actionCompleted := range c.ActionCompleted {
+		if actionCompleted {
+			completed++
+		}
+	}
+
+	switch completed {
+	case 0:
+		return Untouched
+	case len(ClaimAction_name):
+		return FullyClaimed
+	default:
+		return PartiallyClaimed
+	}
+}
+
+// HasCompleted returns true if the claim record completed all the given
+// actions.
+func (c ClaimRecord) HasCompleted(actions ...ClaimAction) bool {
+	for _, action := range actions {
+		if int(action) >= len(c.ActionCompleted) || !c.ActionCompleted[action] {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseClaimRecordStatus returns the claim record status of the given name,
+// case insensitive.
+func ParseClaimRecordStatus(name string) (ClaimRecordStatus, error) {
+	for value, statusName := range ClaimRecordStatus_name {
+		if strings.EqualFold(name, statusName) {
+			return ClaimRecordStatus(value), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown claim record status: %s", name)
+}
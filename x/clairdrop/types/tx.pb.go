@@ -0,0 +1,1598 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: galaxy/clairdrop/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgSetPaused is the Msg/SetPaused request type.
+type MsgSetPaused struct {
+	// authority is the module authority, or the mint guardian to pause.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// paused is true to pause claiming and false to resume it.
+	Paused bool `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *MsgSetPaused) Reset()         { *m = MsgSetPaused{} }
+func (m *MsgSetPaused) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPaused) ProtoMessage()    {}
+func (*MsgSetPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e5df8e81ba67c2a, []int{0}
+}
+func (m *MsgSetPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPaused.Merge(m, src)
+}
+func (m *MsgSetPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPaused proto.InternalMessageInfo
+
+func (m *MsgSetPaused) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetPaused) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+// MsgSetPausedResponse defines the response structure for executing a
+// MsgSetPaused message.
+type MsgSetPausedResponse struct {
+}
+
+func (m *MsgSetPausedResponse) Reset()         { *m = MsgSetPausedResponse{} }
+func (m *MsgSetPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPausedResponse) ProtoMessage()    {}
+func (*MsgSetPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e5df8e81ba67c2a, []int{1}
+}
+func (m *MsgSetPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPausedResponse.Merge(m, src)
+}
+func (m *MsgSetPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPausedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPausedResponse proto.InternalMessageInfo
+
+// MsgAttestAction is the Msg/AttestAction request type.
+type MsgAttestAction struct {
+	// attester is an attester of the action in the params.
+	Attester string `protobuf:"bytes,1,opt,name=attester,proto3" json:"attester,omitempty"`
+	// address is the address that completed the action.
+	Address string      `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Action  ClaimAction `protobuf:"varint,3,opt,name=action,proto3,enum=galaxy.clairdrop.ClaimAction" json:"action,omitempty"`
+}
+
+func (m *MsgAttestAction) Reset()         { *m = MsgAttestAction{} }
+func (m *MsgAttestAction) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestAction) ProtoMessage()    {}
+func (*MsgAttestAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e5df8e81ba67c2a, []int{2}
+}
+func (m *MsgAttestAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAttestAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestAction.Merge(m, src)
+}
+func (m *MsgAttestAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAttestAction proto.InternalMessageInfo
+
+func (m *MsgAttestAction) GetAttester() string {
+	if m != nil {
+		return m.Attester
+	}
+	return ""
+}
+
+func (m *MsgAttestAction) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgAttestAction) GetAction() ClaimAction {
+	if m != nil {
+		return m.Action
+	}
+	return Delegate
+}
+
+// MsgAttestActionResponse defines the response structure for executing a
+// MsgAttestAction message.
+type MsgAttestActionResponse struct {
+	// claimed is the amount sent to the address for the action.
+	Claimed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=claimed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed"`
+}
+
+func (m *MsgAttestActionResponse) Reset()         { *m = MsgAttestActionResponse{} }
+func (m *MsgAttestActionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestActionResponse) ProtoMessage()    {}
+func (*MsgAttestActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e5df8e81ba67c2a, []int{3}
+}
+func (m *MsgAttestActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAttestActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestActionResponse.Merge(m, src)
+}
+func (m *MsgAttestActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAttestActionResponse proto.InternalMessageInfo
+
+func (m *MsgAttestActionResponse) GetClaimed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Claimed
+	}
+	return nil
+}
+
+// MsgSubmitClaimProof is the Msg/SubmitClaimProof request type.
+type MsgSubmitClaimProof struct {
+	// sender is the address of the allocation.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// amount is the allocation of the sender.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// proof is the hex encoded sibling hashes from the leaf of the allocation
+	// up to the root.
+	Proof []string `protobuf:"bytes,3,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *MsgSubmitClaimProof) Reset()         { *m = MsgSubmitClaimProof{} }
+func (m *MsgSubmitClaimProof) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitClaimProof) ProtoMessage()    {}
+func (*MsgSubmitClaimProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e5df8e81ba67c2a, []int{4}
+}
+func (m *MsgSubmitClaimProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitClaimProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitClaimProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitClaimProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitClaimProof.Merge(m, src)
+}
+func (m *MsgSubmitClaimProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitClaimProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitClaimProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitClaimProof proto.InternalMessageInfo
+
+func (m *MsgSubmitClaimProof) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSubmitClaimProof) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgSubmitClaimProof) GetProof() []string {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// MsgSubmitClaimProofResponse defines the response structure for executing a
+// MsgSubmitClaimProof message.
+type MsgSubmitClaimProofResponse struct {
+	// claimed is the amount sent to the sender for the initial claim action.
+	Claimed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=claimed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed"`
+}
+
+func (m *MsgSubmitClaimProofResponse) Reset()         { *m = MsgSubmitClaimProofResponse{} }
+func (m *MsgSubmitClaimProofResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitClaimProofResponse) ProtoMessage()    {}
+func (*MsgSubmitClaimProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e5df8e81ba67c2a, []int{5}
+}
+func (m *MsgSubmitClaimProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitClaimProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitClaimProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitClaimProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitClaimProofResponse.Merge(m, src)
+}
+func (m *MsgSubmitClaimProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitClaimProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitClaimProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitClaimProofResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitClaimProofResponse) GetClaimed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Claimed
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgSetPaused)(nil), "galaxy.clairdrop.MsgSetPaused")
+	proto.RegisterType((*MsgSetPausedResponse)(nil), "galaxy.clairdrop.MsgSetPausedResponse")
+	proto.RegisterType((*MsgAttestAction)(nil), "galaxy.clairdrop.MsgAttestAction")
+	proto.RegisterType((*MsgAttestActionResponse)(nil), "galaxy.clairdrop.MsgAttestActionResponse")
+	proto.RegisterType((*MsgSubmitClaimProof)(nil), "galaxy.clairdrop.MsgSubmitClaimProof")
+	proto.RegisterType((*MsgSubmitClaimProofResponse)(nil), "galaxy.clairdrop.MsgSubmitClaimProofResponse")
+}
+
+func init() { proto.RegisterFile("galaxy/clairdrop/tx.proto", fileDescriptor_9e5df8e81ba67c2a) }
+
+var fileDescriptor_9e5df8e81ba67c2a = []byte{
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x41, 0x6e, 0xd3, 0x40,
+	0x14, 0xcd, 0xc4, 0x22, 0xad, 0x3f, 0x15, 0x54, 0x43, 0x54, 0x5c, 0x03, 0xae, 0xb1, 0x04, 0x32,
+	0x8b, 0xda, 0x24, 0x88, 0x03, 0xb4, 0x65, 0x87, 0x22, 0x55, 0xee, 0x0e, 0xb1, 0x19, 0xdb, 0x83,
+	0x63, 0x35, 0xf6, 0x58, 0x9e, 0x31, 0x24, 0x1b, 0xc4, 0x82, 0x03, 0x70, 0x06, 0x56, 0x88, 0x93,
+	0x74, 0xd9, 0x25, 0x2b, 0x40, 0xc9, 0x45, 0x90, 0xed, 0x89, 0x6b, 0x92, 0x08, 0x58, 0xa0, 0xae,
+	0x32, 0x2f, 0xff, 0xfd, 0x37, 0xcf, 0xef, 0x7f, 0x0d, 0xec, 0x47, 0x64, 0x42, 0xa6, 0x33, 0x37,
+	0x98, 0x90, 0x38, 0x0f, 0x73, 0x96, 0xb9, 0x62, 0xea, 0x64, 0x39, 0x13, 0x0c, 0xef, 0xd6, 0x25,
+	0xa7, 0x29, 0xe9, 0xfd, 0x88, 0x45, 0xac, 0x2a, 0xba, 0xe5, 0xa9, 0xe6, 0xe9, 0x46, 0xc0, 0x78,
+	0xc2, 0xb8, 0xeb, 0x13, 0x4e, 0xdd, 0xb7, 0x03, 0x9f, 0x0a, 0x32, 0x70, 0x03, 0x16, 0xa7, 0xb2,
+	0x6e, 0xae, 0x5d, 0xd1, 0x9c, 0x6a, 0x86, 0xf5, 0x02, 0x76, 0x46, 0x3c, 0x3a, 0xa3, 0xe2, 0x94,
+	0x14, 0x9c, 0x86, 0xf8, 0x3e, 0xa8, 0xa4, 0x10, 0x63, 0x96, 0xc7, 0x62, 0xa6, 0x21, 0x13, 0xd9,
+	0xaa, 0x77, 0xf5, 0x07, 0xde, 0x83, 0x5e, 0x56, 0xf1, 0xb4, 0xae, 0x89, 0xec, 0x6d, 0x4f, 0x22,
+	0x6b, 0x0f, 0xfa, 0x6d, 0x15, 0x8f, 0xf2, 0x8c, 0xa5, 0x9c, 0x5a, 0xef, 0xe1, 0xf6, 0x88, 0x47,
+	0x47, 0x42, 0x50, 0x2e, 0x8e, 0x02, 0x11, 0xb3, 0x14, 0xeb, 0xb0, 0x4d, 0x2a, 0x4c, 0x73, 0xa9,
+	0xdf, 0x60, 0xac, 0xc1, 0x16, 0x09, 0xc3, 0x9c, 0x72, 0x5e, 0xe9, 0xab, 0xde, 0x12, 0xe2, 0xe7,
+	0xd0, 0x23, 0x55, 0xbf, 0xa6, 0x98, 0xc8, 0xbe, 0x35, 0x7c, 0xe0, 0xac, 0x26, 0xe4, 0x9c, 0x4c,
+	0x48, 0x9c, 0xd4, 0x97, 0x78, 0x92, 0x6c, 0x7d, 0x40, 0x70, 0x77, 0xc5, 0xc0, 0xd2, 0x1b, 0xa6,
+	0xb0, 0x55, 0x36, 0x27, 0x34, 0xd4, 0x90, 0xa9, 0xd8, 0x37, 0x87, 0xfb, 0x4e, 0x9d, 0xa6, 0x53,
+	0xa6, 0xe9, 0xc8, 0x34, 0x9d, 0x13, 0x16, 0xa7, 0xc7, 0x4f, 0x2f, 0xbe, 0x1f, 0x74, 0xbe, 0xfe,
+	0x38, 0xb0, 0xa3, 0x58, 0x8c, 0x0b, 0xdf, 0x09, 0x58, 0xe2, 0xca, 0xe8, 0xeb, 0x9f, 0x43, 0x1e,
+	0x9e, 0xbb, 0x62, 0x96, 0x51, 0x5e, 0x35, 0x70, 0x6f, 0xa9, 0x6d, 0x7d, 0x41, 0x70, 0xa7, 0xcc,
+	0xa6, 0xf0, 0x93, 0x58, 0x54, 0x1e, 0x4f, 0x73, 0xc6, 0xde, 0x94, 0x51, 0x72, 0x9a, 0x86, 0x4d,
+	0x0a, 0x12, 0xe1, 0x00, 0x7a, 0x24, 0x61, 0x45, 0x2a, 0xb4, 0xee, 0xff, 0x77, 0x25, 0xa5, 0x71,
+	0x1f, 0x6e, 0x64, 0xa5, 0x0b, 0x4d, 0x31, 0x15, 0x5b, 0xf5, 0x6a, 0x60, 0x7d, 0x44, 0x70, 0x6f,
+	0x83, 0xd5, 0x6b, 0x4e, 0x6c, 0xf8, 0xb9, 0x0b, 0xca, 0x88, 0x47, 0xf8, 0x0c, 0xd4, 0xab, 0xbd,
+	0x34, 0xd6, 0x07, 0xde, 0xde, 0x38, 0xfd, 0xf1, 0x9f, 0xeb, 0xcd, 0x37, 0xbc, 0x86, 0x9d, 0xdf,
+	0xd6, 0xf1, 0xe1, 0xc6, 0xbe, 0x36, 0x45, 0x7f, 0xf2, 0x57, 0x4a, 0xa3, 0x3e, 0x86, 0xdd, 0xb5,
+	0x41, 0x3f, 0xda, 0xec, 0x6c, 0x85, 0xa6, 0x1f, 0xfe, 0x13, 0x6d, 0x79, 0xd3, 0xf1, 0xcb, 0x8b,
+	0xb9, 0x81, 0x2e, 0xe7, 0x06, 0xfa, 0x39, 0x37, 0xd0, 0xa7, 0x85, 0xd1, 0xb9, 0x5c, 0x18, 0x9d,
+	0x6f, 0x0b, 0xa3, 0xf3, 0x6a, 0xd0, 0x4a, 0xbc, 0x96, 0x4c, 0xa9, 0x78, 0xc7, 0xf2, 0x73, 0x89,
+	0xdc, 0x69, 0xfb, 0xc5, 0x29, 0x07, 0xe0, 0xf7, 0xaa, 0xb7, 0xe0, 0xd9, 0xaf, 0x01, 0x00, 0x6b,
+	0xab, 0xea, 0x67, 0x92, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SetPaused pauses or resumes claiming and the clawback at the end of the
+	// clairdrop. It must be signed by the module authority, or by the mint
+	// guardian to pause.
+	SetPaused(ctx context.Context, in *MsgSetPaused, opts ...grpc.CallOption) (*MsgSetPausedResponse, error)
+	// AttestAction confirms that an address completed a claim action and
+	// claims the amount of the action for it. It must be signed by an attester
+	// of the action.
+	AttestAction(ctx context.Context, in *MsgAttestAction, opts ...grpc.CallOption) (*MsgAttestActionResponse, error)
+	// SubmitClaimProof creates the claim record of an allocation of the Merkle
+	// airdrop from a proof of the allocation. The actions of the record are
+	// claimed as those of the genesis claim records afterwards.
+	SubmitClaimProof(ctx context.Context, in *MsgSubmitClaimProof, opts ...grpc.CallOption) (*MsgSubmitClaimProofResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetPaused(ctx context.Context, in *MsgSetPaused, opts ...grpc.CallOption) (*MsgSetPausedResponse, error) {
+	out := new(MsgSetPausedResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.clairdrop.Msg/SetPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AttestAction(ctx context.Context, in *MsgAttestAction, opts ...grpc.CallOption) (*MsgAttestActionResponse, error) {
+	out := new(MsgAttestActionResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.clairdrop.Msg/AttestAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitClaimProof(ctx context.Context, in *MsgSubmitClaimProof, opts ...grpc.CallOption) (*MsgSubmitClaimProofResponse, error) {
+	out := new(MsgSubmitClaimProofResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.clairdrop.Msg/SubmitClaimProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SetPaused pauses or resumes claiming and the clawback at the end of the
+	// clairdrop. It must be signed by the module authority, or by the mint
+	// guardian to pause.
+	SetPaused(context.Context, *MsgSetPaused) (*MsgSetPausedResponse, error)
+	// AttestAction confirms that an address completed a claim action and
+	// claims the amount of the action for it. It must be signed by an attester
+	// of the action.
+	AttestAction(context.Context, *MsgAttestAction) (*MsgAttestActionResponse, error)
+	// SubmitClaimProof creates the claim record of an allocation of the Merkle
+	// airdrop from a proof of the allocation. The actions of the record are
+	// claimed as those of the genesis claim records afterwards.
+	SubmitClaimProof(context.Context, *MsgSubmitClaimProof) (*MsgSubmitClaimProofResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetPaused(ctx context.Context, req *MsgSetPaused) (*MsgSetPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPaused not implemented")
+}
+func (*UnimplementedMsgServer) AttestAction(ctx context.Context, req *MsgAttestAction) (*MsgAttestActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestAction not implemented")
+}
+func (*UnimplementedMsgServer) SubmitClaimProof(ctx context.Context, req *MsgSubmitClaimProof) (*MsgSubmitClaimProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitClaimProof not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.clairdrop.Msg/SetPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPaused(ctx, req.(*MsgSetPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AttestAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttestAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttestAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.clairdrop.Msg/AttestAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttestAction(ctx, req.(*MsgAttestAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitClaimProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitClaimProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitClaimProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.clairdrop.Msg/SubmitClaimProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitClaimProof(ctx, req.(*MsgSubmitClaimProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "galaxy.clairdrop.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetPaused",
+			Handler:    _Msg_SetPaused_Handler,
+		},
+		{
+			MethodName: "AttestAction",
+			Handler:    _Msg_AttestAction_Handler,
+		},
+		{
+			MethodName: "SubmitClaimProof",
+			Handler:    _Msg_SubmitClaimProof_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "galaxy/clairdrop/tx.proto",
+}
+
+func (m *MsgSetPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Action != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Attester) > 0 {
+		i -= len(m.Attester)
+		copy(dAtA[i:], m.Attester)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Attester)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claimed) > 0 {
+		for iNdEx := len(m.Claimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitClaimProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitClaimProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitClaimProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitClaimProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitClaimProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitClaimProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claimed) > 0 {
+		for iNdEx := len(m.Claimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAttestAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Attester)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovTx(uint64(m.Action))
+	}
+	return n
+}
+
+func (m *MsgAttestActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Claimed) > 0 {
+		for _, e := range m.Claimed {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitClaimProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Proof) > 0 {
+		for _, s := range m.Proof {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitClaimProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Claimed) > 0 {
+		for _, e := range m.Claimed {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetPaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAttestAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAttestAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAttestAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= ClaimAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAttestActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAttestActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAttestActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimed = append(m.Claimed, types.Coin{})
+			if err := m.Claimed[len(m.Claimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitClaimProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitClaimProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitClaimProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitClaimProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitClaimProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitClaimProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimed = append(m.Claimed, types.Coin{})
+			if err := m.Claimed[len(m.Claimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
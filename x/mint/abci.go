@@ -1,6 +1,7 @@
 package mint
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -12,6 +13,12 @@ import (
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
+	// fees are burned before the distribution module allocates them, also
+	// once inflation ended or while minting is paused
+	if err := k.BurnFees(ctx); err != nil {
+		panic(err)
+	}
+
 	minter := k.GetMinter(ctx)
 
 	// inflation end
@@ -19,30 +26,182 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 		return
 	}
 
+	// minting is skipped while paused
+	pause := k.GetMintPause(ctx)
+	if pause.Paused {
+		k.SkipBlock(ctx, pause)
+		return
+	}
+
 	params := k.GetParams(ctx)
-	totalSupply := k.TokenSupply(ctx, params.MintDenom)
+	epoch := k.GetEpoch(ctx)
+	// provisions accumulated in the epoch count as supply
+	totalSupply := k.TokenSupply(ctx, params.MintDenom).Add(epoch.Provision)
 	currentBlock := uint64(ctx.BlockHeight())
 
-	currentPhase := minter.CurrentPhase(params, int64(currentBlock))
-	if minter.Phase != uint64(currentPhase) {
-		minter.Phase = uint64(minter.CurrentPhase(params, int64(currentBlock)))
-		minter.Inflation = minter.PhaseInflationRate(uint64(minter.Phase), params)
-		minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalSupply)
+	currentPhase := uint64(minter.CurrentPhase(params, int64(currentBlock)))
+	phaseStartTime := ctx.BlockTime()
+	if params.PhaseMode == types.PhaseByTime {
+		currentPhase, phaseStartTime = minter.CurrentPhaseByTime(params, ctx.BlockTime())
+		if phaseStartTime.IsZero() {
+			phaseStartTime = ctx.BlockTime()
+		}
 	}
 
+	// the provisions of the blocks skipped while paused are caught up at the
+	// rate of the phase they were skipped in. Provisions by time catch up
+	// through the time elapsed since the last block.
+	var catchUpBlocks uint64
+	if pause.CatchUpBlocks > 0 {
+		if params.PhaseMode == types.PhaseByHeight {
+			catchUpBlocks = pause.CatchUpBlocks
+		}
+		pause.CatchUpBlocks = 0
+		k.SetMintPause(ctx, pause)
+	}
+
+	if minter.Phase != currentPhase {
+		// the provisions of the phases passed since the last block are paid at
+		// their rates, up to the end of each phase
+		if minter.Phase != 0 {
+			provision := sdk.ZeroDec()
+			if params.PhaseMode == types.PhaseByTime {
+				if !minter.PhaseStartTime.IsZero() {
+					provision = passedPhasesProvisionByTime(params, minter, currentPhase, totalSupply)
+				}
+				if phaseStartTime.After(minter.LastBlockTime) {
+					minter.LastBlockTime = phaseStartTime
+				}
+			} else {
+				var skipped uint64
+				provision, skipped = passedPhasesCatchUp(params, minter, currentPhase, currentBlock, catchUpBlocks, totalSupply)
+				catchUpBlocks -= skipped
+			}
+			minter, epoch, totalSupply = catchUp(params, minter, epoch, totalSupply, provision)
+		}
+
+		// provisions accumulated in the previous phase are distributed in it
+		if epoch.Provision.IsPositive() {
+			epoch = mintEpoch(ctx, k, params, minter, epoch, ctx.BlockHeight())
+		}
+
+		oldPhase := minter.Phase
+		minter = minter.PhaseMinter(params, currentPhase, totalSupply)
+		minter.PhaseStartTime = phaseStartTime
+		k.SetMinter(ctx, minter)
+		k.AfterPhaseChange(ctx, oldPhase, currentPhase)
+	}
+
+	if catchUpBlocks > 0 {
+		minter, epoch, totalSupply = catchUp(params, minter, epoch, totalSupply, minter.ExactBlockProvision(params).MulInt64(int64(catchUpBlocks)))
+	}
+
+	minter, provision := k.NextBlockProvision(ctx, params, minter, totalSupply, ctx.BlockTime())
+	// the truncated fraction is carried into the next block
+	var mintedAmount sdk.Int
+	mintedAmount, minter.ProvisionRemainder = minter.CarryProvision(provision)
+	mintedCoin := sdk.NewCoin(params.MintDenom, mintedAmount)
+	minter.LastBlockTime = ctx.BlockTime()
+
 	k.SetMinter(ctx, minter)
 
 	// inflation end
 	if minter.Inflation.Equal(sdk.ZeroDec()) {
-		//if still has ramaning amount  it will be fund to community pool
-		coin := k.ModuleBalance(ctx)
-		if coin.IsPositive() {
-			k.FundToCommuinityPool(ctx, sdk.NewCoins(coin))
+		// provisions accumulated in the epoch are minted before inflation ends
+		if epoch.Provision.IsPositive() {
+			epoch = mintEpoch(ctx, k, params, minter, epoch, ctx.BlockHeight()+1)
 		}
+		k.SetEpoch(ctx, epoch)
+		endInflation(ctx, k)
 		return
 	}
 
-	mintedCoin := minter.BlockProvision(params)
+	// only the remainder up to the max supply is minted
+	mintedCoin, maxSupplyReached := params.CapProvision(mintedCoin, totalSupply)
+	epoch = epoch.Accumulate(mintedCoin.Amount)
+
+	// provisions are minted at the end of the epoch or once the max supply is reached
+	if !maxSupplyReached && !params.IsEpochEnd(epoch, ctx.BlockTime()) {
+		k.SetEpoch(ctx, epoch)
+		return
+	}
+	k.SetEpoch(ctx, mintEpoch(ctx, k, params, minter, epoch, ctx.BlockHeight()+1))
+
+	// inflation ends once the max supply is reached
+	if maxSupplyReached {
+		minter.Inflation = sdk.ZeroDec()
+		minter.AnnualProvisions = sdk.ZeroDec()
+		k.SetMinter(ctx, minter)
+		endInflation(ctx, k)
+	}
+}
+
+// catchUp adds a caught up provision to the epoch, along with the fraction
+// carried from the previous blocks, and returns the total supply including it.
+// Only the remainder up to the max supply is added.
+func catchUp(params types.Params, minter types.Minter, epoch types.Epoch, totalSupply sdk.Int, provision sdk.Dec) (types.Minter, types.Epoch, sdk.Int) {
+	var amount sdk.Int
+	amount, minter.ProvisionRemainder = minter.CarryProvision(provision)
+	coin, _ := params.CapProvision(sdk.NewCoin(params.MintDenom, amount), totalSupply)
+	epoch.Provision = epoch.Provision.Add(coin.Amount)
+	return minter, epoch, totalSupply.Add(coin.Amount)
+}
+
+// passedPhasesCatchUp returns the provisions of the blocks skipped while paused
+// in the phases before the current one, each at the rate of its phase, along
+// with the number of those blocks. The skipped blocks are the ones right
+// before the current block.
+func passedPhasesCatchUp(params types.Params, minter types.Minter, currentPhase, currentBlock, catchUpBlocks uint64, totalSupply sdk.Int) (sdk.Dec, uint64) {
+	provision := sdk.ZeroDec()
+	if catchUpBlocks == 0 {
+		return provision, 0
+	}
+
+	firstSkipped := int64(currentBlock - catchUpBlocks)
+	var skipped uint64
+	phaseMinter := minter
+	for phase := minter.Phase; phase < currentPhase; phase++ {
+		if phase != minter.Phase {
+			phaseMinter = phaseMinter.PhaseMinter(params, phase, totalSupply)
+		}
+		start, end := minter.PhaseHeights(params, phase)
+		if start < firstSkipped {
+			start = firstSkipped
+		}
+		if end < start {
+			continue
+		}
+		provision = provision.Add(phaseMinter.ExactBlockProvision(params).MulInt64(end - start + 1))
+		skipped += uint64(end - start + 1)
+	}
+	return provision, skipped
+}
+
+// passedPhasesProvisionByTime returns the provisions of the time elapsed since
+// the last block in the phases before the current one, each at the rate of its
+// phase up to the end of the phase.
+func passedPhasesProvisionByTime(params types.Params, minter types.Minter, currentPhase uint64, totalSupply sdk.Int) sdk.Dec {
+	provision := sdk.ZeroDec()
+	phaseMinter := minter
+	for phase := minter.Phase; phase < currentPhase; phase++ {
+		if phase != minter.Phase {
+			phaseMinter = phaseMinter.PhaseMinter(params, phase, totalSupply)
+		}
+		end := minter.PhaseStartTime.Add(time.Duration(phase-minter.Phase+1) * params.PhaseDuration)
+		provision = provision.Add(phaseMinter.ExactBlockProvisionByTime(params, end))
+		if end.After(phaseMinter.LastBlockTime) {
+			phaseMinter.LastBlockTime = end
+		}
+	}
+	return provision
+}
+
+// mintEpoch mints and distributes the provisions accumulated in the epoch and
+// returns the next epoch, starting at the given height. The developer rewards
+// of the epoch are escrowed here, so the escrow is written once per epoch and
+// not on every block.
+func mintEpoch(ctx sdk.Context, k keeper.Keeper, params types.Params, minter types.Minter, epoch types.Epoch, nextHeight int64) types.Epoch {
+	mintedCoin := sdk.NewCoin(params.MintDenom, epoch.Provision)
 	mintedCoins := sdk.NewCoins(mintedCoin)
 
 	err := k.MintCoins(ctx, mintedCoins)
@@ -51,14 +210,12 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 		panic(err)
 	}
 
-	err = k.DistributeMintedCoin(ctx, mintedCoin)
+	k.DistributeMintedCoin(ctx, mintedCoin)
 
-	if err != nil {
-		panic(err)
-	}
+	k.AfterBlockMint(ctx, mintedCoin)
 
 	if mintedCoin.Amount.IsInt64() {
-		defer telemetry.ModuleSetGauge(types.ModuleName, float32(mintedCoin.Amount.Int64()), "minted_tokens")
+		telemetry.ModuleSetGauge(types.ModuleName, float32(mintedCoin.Amount.Int64()), "minted_tokens")
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -70,4 +227,38 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 		),
 	)
 
+	next := epoch.Next(nextHeight, ctx.BlockTime())
+
+	// every block is an epoch when epochs are disabled
+	if params.HasEpochs() {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeEpochEnd,
+				sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprint(epoch.Number)),
+				sdk.NewAttribute(types.AttributeKeyStartHeight, fmt.Sprint(epoch.StartHeight)),
+				sdk.NewAttribute(types.AttributeKeyBlocks, fmt.Sprint(epoch.Blocks)),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
+			),
+			sdk.NewEvent(
+				types.EventTypeEpochStart,
+				sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprint(next.Number)),
+				sdk.NewAttribute(types.AttributeKeyStartHeight, fmt.Sprint(next.StartHeight)),
+			),
+		})
+	}
+
+	return next
+}
+
+// endInflation funds the remaining balance of the mint module account to the
+// community pool, along with the units held back by the distribution
+// remainders. Escrowed developer rewards stay withdrawable.
+func endInflation(ctx sdk.Context, k keeper.Keeper) {
+	//if still has ramaning amount  it will be fund to community pool
+	coin := k.LeftoverBalance(ctx)
+	if coin.IsPositive() {
+		k.FundToCommuinityPool(ctx, sdk.NewCoins(coin))
+	}
+	k.SetDistributionRemainder(ctx, types.InitialDistributionRemainder())
+	k.AfterInflationEnd(ctx)
 }
@@ -0,0 +1,466 @@
+package mint_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/galaxynetwork/galaxy/app"
+	"github.com/galaxynetwork/galaxy/x/mint"
+	"github.com/galaxynetwork/galaxy/x/mint/keeper"
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestBeginBlockerMaxSupply(t *testing.T) {
+	galaxyApp := app.Setup(false)
+	ctx := galaxyApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC(), Height: 1})
+	mintKeeper := galaxyApp.MintKeeper
+
+	params := mintKeeper.GetParams(ctx)
+	genesisSupply := sdk.NewInt(1_000_000_000_000)
+	err := mintKeeper.MintCoins(ctx, sdk.NewCoins(sdk.NewCoin(params.MintDenom, genesisSupply)))
+	require.NoError(t, err)
+	totalSupply := mintKeeper.TokenSupply(ctx, params.MintDenom)
+
+	params.MaxSupply = totalSupply.AddRaw(100)
+	mintKeeper.SetParams(ctx, params)
+
+	mint.BeginBlocker(ctx, mintKeeper)
+
+	// the block provision exceeds the cap, only the remainder is minted
+	require.Equal(t, params.MaxSupply, mintKeeper.TokenSupply(ctx, params.MintDenom))
+	minter := mintKeeper.GetMinter(ctx)
+	require.True(t, minter.Inflation.IsZero())
+	require.True(t, minter.AnnualProvisions.IsZero())
+	require.True(t, mintKeeper.ModuleBalance(ctx).IsZero())
+
+	// nothing is minted afterwards
+	ctx = ctx.WithBlockHeight(2)
+	mint.BeginBlocker(ctx, mintKeeper)
+	require.Equal(t, params.MaxSupply, mintKeeper.TokenSupply(ctx, params.MintDenom))
+}
+
+func TestBeginBlockerBondedRatioInflation(t *testing.T) {
+	galaxyApp := app.Setup(false)
+	ctx := galaxyApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC(), Height: 1})
+	mintKeeper := galaxyApp.MintKeeper
+
+	params := mintKeeper.GetParams(ctx)
+	params.InflationMode = types.InflationBondedRatio
+	mintKeeper.SetParams(ctx, params)
+	err := mintKeeper.MintCoins(ctx, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdk.NewInt(1_000_000_000_000))))
+	require.NoError(t, err)
+
+	mint.BeginBlocker(ctx, mintKeeper)
+
+	// nothing is bonded, the rate moves up from the min rate below the phase rate
+	minter := mintKeeper.GetMinter(ctx)
+	ceiling := minter.PhaseInflationRate(minter.Phase, params)
+	require.True(t, minter.Inflation.LTE(ceiling))
+	require.True(t, minter.Inflation.GTE(params.InflationMin))
+
+	prev := minter.Inflation
+	totalSupply := mintKeeper.TokenSupply(ctx, params.MintDenom)
+	ctx = ctx.WithBlockHeight(2)
+	mint.BeginBlocker(ctx, mintKeeper)
+	minter = mintKeeper.GetMinter(ctx)
+	require.True(t, minter.Inflation.LTE(ceiling))
+	require.True(t, minter.Inflation.GTE(prev))
+
+	// annual provisions follow the dynamic rate
+	require.True(t, minter.AnnualProvisions.IsPositive())
+	require.Equal(t, minter.Inflation.MulInt(totalSupply).String(), minter.AnnualProvisions.String())
+}
+
+func TestBeginBlockerBondedRatioPhaseChange(t *testing.T) {
+	galaxyApp := app.Setup(false)
+	ctx := galaxyApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC(), Height: 1})
+	mintKeeper := galaxyApp.MintKeeper
+
+	params := mintKeeper.GetParams(ctx)
+	params.InflationMode = types.InflationBondedRatio
+	params.BlocksPerYear = 4
+	params.InflationMin = sdk.NewDecWithPrec(5, 2)
+	params.InflationRateChange = sdk.ZeroDec()
+	params.InflationSchedule = []types.InflationPhase{
+		{Phase: 1, Inflation: sdk.NewDecWithPrec(20, 2), ProvisionCap: sdk.ZeroInt()},
+		{Phase: 2, Inflation: sdk.NewDecWithPrec(15, 2), ProvisionCap: sdk.ZeroInt()},
+		{Phase: 3, Inflation: sdk.NewDecWithPrec(6, 2), ProvisionCap: sdk.ZeroInt()},
+	}
+	mintKeeper.SetParams(ctx, params)
+	require.NoError(t, mintKeeper.MintCoins(ctx, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdk.NewInt(1_000_000_000_000)))))
+
+	mint.BeginBlocker(ctx, mintKeeper)
+	minter := mintKeeper.GetMinter(ctx)
+	minter.Inflation = sdk.NewDecWithPrec(8, 2)
+	mintKeeper.SetMinter(ctx, minter)
+
+	// the rate carries over into a phase with a higher ceiling
+	for height := int64(2); height <= 5; height++ {
+		mint.BeginBlocker(ctx.WithBlockHeight(height), mintKeeper)
+	}
+	minter = mintKeeper.GetMinter(ctx)
+	require.Equal(t, uint64(2), minter.Phase)
+	require.Equal(t, sdk.NewDecWithPrec(8, 2).String(), minter.Inflation.String())
+
+	// and is clamped into a phase with a lower ceiling
+	for height := int64(6); height <= 9; height++ {
+		mint.BeginBlocker(ctx.WithBlockHeight(height), mintKeeper)
+	}
+	minter = mintKeeper.GetMinter(ctx)
+	require.Equal(t, uint64(3), minter.Phase)
+	require.Equal(t, sdk.NewDecWithPrec(6, 2).String(), minter.Inflation.String())
+}
+
+func TestBeginBlockerInflationEndMidEpoch(t *testing.T) {
+	galaxyApp := app.Setup(false)
+	ctx := galaxyApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC(), Height: 1})
+	mintKeeper := galaxyApp.MintKeeper
+
+	params := mintKeeper.GetParams(ctx)
+	params.InflationMode = types.InflationBondedRatio
+	params.EpochBlocks = 4
+	mintKeeper.SetParams(ctx, params)
+	require.NoError(t, mintKeeper.MintCoins(ctx, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdk.NewInt(1_000_000_000_000)))))
+	genesisSupply := mintKeeper.TokenSupply(ctx, params.MintDenom)
+
+	mint.BeginBlocker(ctx, mintKeeper)
+	mint.BeginBlocker(ctx.WithBlockHeight(2), mintKeeper)
+	pending := mintKeeper.GetEpoch(ctx).Provision
+	require.True(t, pending.IsPositive())
+	require.Equal(t, genesisSupply, mintKeeper.TokenSupply(ctx, params.MintDenom))
+
+	// the ceiling of the phase drops to zero before the epoch ends
+	params.InflationSchedule = []types.InflationPhase{{Phase: 1, Inflation: sdk.ZeroDec(), ProvisionCap: sdk.ZeroInt()}}
+	mintKeeper.SetParams(ctx, params)
+	mint.BeginBlocker(ctx.WithBlockHeight(3), mintKeeper)
+	require.True(t, mintKeeper.GetMinter(ctx).Inflation.IsZero())
+
+	// the provisions of the cut short epoch are minted and leave nothing behind
+	require.True(t, mintKeeper.GetEpoch(ctx).Provision.IsZero())
+	require.Equal(t, genesisSupply.Add(pending), mintKeeper.TokenSupply(ctx, params.MintDenom))
+	require.True(t, mintKeeper.LeftoverBalance(ctx).IsZero())
+	require.Equal(t, mintKeeper.TokenSupply(ctx, params.MintDenom), mintKeeper.EmissionSupply(ctx, params.MintDenom))
+}
+
+func TestBeginBlockerEpochs(t *testing.T) {
+	developer := sdk.AccAddress([]byte("developer"))
+	run := func(epochBlocks uint64, blocks int64, check func(ctx sdk.Context, k keeper.Keeper, height int64)) (sdk.Context, keeper.Keeper) {
+		galaxyApp := app.Setup(false)
+		ctx := galaxyApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC(), Height: 1})
+		mintKeeper := galaxyApp.MintKeeper
+
+		params := mintKeeper.GetParams(ctx)
+		params.BlocksPerYear = 10
+		params.EpochBlocks = epochBlocks
+		params.WeightedDeveloperRewardsReceivers = []types.DevloperWeightedAddress{{Address: developer.String(), Weight: sdk.OneDec()}}
+		mintKeeper.SetParams(ctx, params)
+		err := mintKeeper.MintCoins(ctx, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdk.NewInt(1_000_000_000_000))))
+		require.NoError(t, err)
+
+		for height := int64(1); height <= blocks; height++ {
+			ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+			accrued := mintKeeper.GetDeveloperRewards(ctx, developer.String()).Accrued
+			mint.BeginBlocker(ctx, mintKeeper)
+			// the developer rewards escrow is only written when an epoch is minted
+			escrowWritten := !accrued.IsEqual(mintKeeper.GetDeveloperRewards(ctx, developer.String()).Accrued)
+			epochMinted := false
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeMint {
+					epochMinted = true
+				}
+			}
+			require.Equal(t, epochMinted, escrowWritten, "height %d", height)
+			if check != nil {
+				check(ctx, mintKeeper, height)
+			}
+		}
+		return ctx, mintKeeper
+	}
+
+	// the epochs span the phase changes at heights 11 and 21
+	blocks := int64(26)
+	ctx, perBlock := run(1, blocks, nil)
+	epochCtx, batched := run(4, blocks, func(ctx sdk.Context, k keeper.Keeper, height int64) {
+		epoch := k.GetEpoch(ctx)
+		ended := false
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeEpochEnd {
+				ended = true
+			}
+		}
+
+		// epochs end every 4 blocks and before a phase change
+		switch height {
+		case 4, 8, 14, 18, 24:
+			require.True(t, ended, "height %d", height)
+			require.True(t, epoch.Provision.IsZero())
+		case 11, 21:
+			require.True(t, ended, "height %d", height)
+			require.Equal(t, uint64(1), epoch.Blocks)
+		case 10, 20, 22:
+			require.False(t, ended, "height %d", height)
+			require.True(t, epoch.Provision.IsPositive())
+		}
+	})
+
+	denom := perBlock.GetParams(ctx).MintDenom
+	require.Equal(t, perBlock.EmissionSupply(ctx, denom), batched.EmissionSupply(epochCtx, denom))
+
+	// provisions of the epoch in progress are not minted yet
+	pending := batched.GetEpoch(epochCtx).Provision
+	require.True(t, pending.IsPositive())
+	require.Equal(t, perBlock.TokenSupply(ctx, denom), batched.TokenSupply(epochCtx, denom).Add(pending))
+
+	// every phase distributes the same minted coins
+	records := perBlock.GetAllDistributionRecords(ctx)
+	require.Len(t, records, 3)
+	for _, record := range records {
+		minted := batched.GetDistributionRecord(epochCtx, record.Phase).Minted
+		if record.Phase == 3 {
+			minted = minted.Add(sdk.NewCoin(denom, pending))
+		}
+		require.Equal(t, record.Minted, minted)
+	}
+}
+
+func TestBeginBlockerCarriesProvisionRemainder(t *testing.T) {
+	galaxyApp := app.Setup(false)
+	ctx := galaxyApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC(), Height: 1})
+	mintKeeper := galaxyApp.MintKeeper
+
+	params := mintKeeper.GetParams(ctx)
+	params.BlocksPerYear = 7
+	mintKeeper.SetParams(ctx, params)
+	genesisCoins := sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdk.NewInt(1_000_000_000_003)))
+	require.NoError(t, mintKeeper.MintCoins(ctx, genesisCoins))
+	require.NoError(t, mintKeeper.AddCollectedFees(ctx, genesisCoins))
+	genesisSupply := mintKeeper.TokenSupply(ctx, params.MintDenom)
+
+	for height := int64(1); height <= int64(params.BlocksPerYear); height++ {
+		mint.BeginBlocker(ctx.WithBlockHeight(height), mintKeeper)
+	}
+
+	// a block provision of 71428571428.78 is minted in full over the phase
+	minter := mintKeeper.GetMinter(ctx)
+	require.Equal(t, "500000000001.500000000000000000", minter.AnnualProvisions.String())
+	minted := mintKeeper.TokenSupply(ctx, params.MintDenom).Sub(genesisSupply)
+	require.Equal(t, minter.AnnualProvisions.TruncateInt().String(), minted.String())
+
+	// the held back units stay in the module account
+	undistributed := mintKeeper.GetDistributionRemainder(ctx).Undistributed
+	require.Equal(t, undistributed.String(), mintKeeper.ModuleBalance(ctx).Amount.String())
+	_, broken := keeper.AllInvariants(mintKeeper)(ctx)
+	require.False(t, broken)
+}
+
+// mintHooksRecorder records the calls of the mint hooks.
+type mintHooksRecorder struct {
+	minted       sdk.Int
+	epochMints   int
+	phaseChanges [][2]uint64
+	inflationEnd int
+}
+
+func (h *mintHooksRecorder) AfterBlockMint(_ sdk.Context, minted sdk.Coin) {
+	h.minted = h.minted.Add(minted.Amount)
+	h.epochMints++
+}
+
+func (h *mintHooksRecorder) AfterPhaseChange(_ sdk.Context, oldPhase, newPhase uint64) {
+	h.phaseChanges = append(h.phaseChanges, [2]uint64{oldPhase, newPhase})
+}
+
+func (h *mintHooksRecorder) AfterInflationEnd(_ sdk.Context) {
+	h.inflationEnd++
+}
+
+func TestBeginBlockerMintHooks(t *testing.T) {
+	galaxyApp := app.Setup(false)
+	ctx := galaxyApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC(), Height: 1})
+	recorder := &mintHooksRecorder{minted: sdk.ZeroInt()}
+	mintKeeper := galaxyApp.MintKeeper
+	mintKeeper.SetHooks(types.NewMultiMintHooks(recorder))
+
+	params := mintKeeper.GetParams(ctx)
+	params.BlocksPerYear = 2
+	params.EpochBlocks = 2
+	mintKeeper.SetParams(ctx, params)
+	require.NoError(t, mintKeeper.MintCoins(ctx, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdk.NewInt(1_000_000_000_000)))))
+	genesisSupply := mintKeeper.TokenSupply(ctx, params.MintDenom)
+
+	blocks := int64(params.StopInflationPhase*params.BlocksPerYear) + 2
+	epochs := 0
+	for height := int64(1); height <= blocks; height++ {
+		blockCtx := ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		mint.BeginBlocker(blockCtx, mintKeeper)
+		for _, event := range blockCtx.EventManager().Events() {
+			if event.Type == types.EventTypeEpochEnd {
+				epochs++
+			}
+		}
+	}
+
+	// every minted coin is reported, once per epoch
+	require.Equal(t, mintKeeper.TokenSupply(ctx, params.MintDenom).Sub(genesisSupply).String(), recorder.minted.String())
+	require.Equal(t, epochs, recorder.epochMints)
+	require.Less(t, int64(recorder.epochMints), blocks)
+
+	// every phase is reported up to the stop inflation phase
+	require.Len(t, recorder.phaseChanges, int(params.StopInflationPhase))
+	for i, change := range recorder.phaseChanges {
+		require.Equal(t, [2]uint64{uint64(i), uint64(i + 1)}, change)
+	}
+	require.Equal(t, 1, recorder.inflationEnd)
+}
+
+func TestBeginBlockerPause(t *testing.T) {
+	run := func(policy types.PausePolicy) (sdk.Int, sdk.Dec) {
+		galaxyApp := app.Setup(false)
+		ctx := galaxyApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC(), Height: 1})
+		mintKeeper := galaxyApp.MintKeeper
+
+		params := mintKeeper.GetParams(ctx)
+		params.PausePolicy = policy
+		mintKeeper.SetParams(ctx, params)
+		require.NoError(t, mintKeeper.MintCoins(ctx, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdk.NewInt(1_000_000_000_000)))))
+		genesisSupply := mintKeeper.TokenSupply(ctx, params.MintDenom)
+
+		mint.BeginBlocker(ctx, mintKeeper)
+		require.NoError(t, mintKeeper.SetPaused(ctx.WithBlockHeight(2), true))
+		for height := int64(2); height <= 4; height++ {
+			supply := mintKeeper.TokenSupply(ctx, params.MintDenom)
+			mint.BeginBlocker(ctx.WithBlockHeight(height), mintKeeper)
+			require.Equal(t, supply, mintKeeper.TokenSupply(ctx, params.MintDenom))
+		}
+		pause := mintKeeper.GetMintPause(ctx)
+		require.Equal(t, int64(2), pause.PausedHeight)
+		require.Equal(t, uint64(3), pause.SkippedBlocks)
+
+		require.NoError(t, mintKeeper.SetPaused(ctx, false))
+		mint.BeginBlocker(ctx.WithBlockHeight(5), mintKeeper)
+		require.Zero(t, mintKeeper.GetMintPause(ctx).CatchUpBlocks)
+
+		minter := mintKeeper.GetMinter(ctx)
+		return mintKeeper.TokenSupply(ctx, params.MintDenom).Sub(genesisSupply), minter.ExactBlockProvision(mintKeeper.GetParams(ctx))
+	}
+
+	// the skipped blocks are forfeited, only two blocks are minted
+	forfeited, provision := run(types.PauseForfeit)
+	require.Equal(t, provision.MulInt64(2).TruncateInt().String(), forfeited.String())
+	// the skipped blocks are minted on the first block after the pause
+	caughtUp, provision := run(types.PauseCatchUp)
+	require.Equal(t, provision.MulInt64(5).TruncateInt().String(), caughtUp.String())
+}
+
+func TestBeginBlockerCatchUpAcrossPhases(t *testing.T) {
+	galaxyApp := app.Setup(false)
+	ctx := galaxyApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC(), Height: 1})
+	mintKeeper := galaxyApp.MintKeeper
+
+	params := mintKeeper.GetParams(ctx)
+	params.PausePolicy = types.PauseCatchUp
+	params.BlocksPerYear = 4
+	mintKeeper.SetParams(ctx, params)
+	require.NoError(t, mintKeeper.MintCoins(ctx, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdk.NewInt(1_000_000_000_000)))))
+	genesisSupply := mintKeeper.TokenSupply(ctx, params.MintDenom)
+
+	// blocks 1 and 2 are minted, blocks 3 and 4 of the first phase are skipped
+	mint.BeginBlocker(ctx, mintKeeper)
+	mint.BeginBlocker(ctx.WithBlockHeight(2), mintKeeper)
+	firstPhase := mintKeeper.GetMinter(ctx)
+	require.NoError(t, mintKeeper.SetPaused(ctx, true))
+	mint.BeginBlocker(ctx.WithBlockHeight(3), mintKeeper)
+	mint.BeginBlocker(ctx.WithBlockHeight(4), mintKeeper)
+
+	// minting resumes in the second phase
+	require.NoError(t, mintKeeper.SetPaused(ctx, false))
+	mint.BeginBlocker(ctx.WithBlockHeight(5), mintKeeper)
+	secondPhase := mintKeeper.GetMinter(ctx)
+	require.Equal(t, uint64(2), secondPhase.Phase)
+
+	// the skipped blocks are paid at the rate of the first phase
+	firstProvision := firstPhase.ExactBlockProvision(params)
+	secondProvision := secondPhase.ExactBlockProvision(params)
+	require.False(t, firstProvision.Equal(secondProvision))
+	expected := firstProvision.MulInt64(4).Add(secondProvision).TruncateInt()
+	require.Equal(t, expected.String(), mintKeeper.TokenSupply(ctx, params.MintDenom).Sub(genesisSupply).String())
+}
+
+func TestBeginBlockerCatchUpPauseAcrossPhaseBoundary(t *testing.T) {
+	galaxyApp := app.Setup(false)
+	ctx := galaxyApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC(), Height: 1})
+	mintKeeper := galaxyApp.MintKeeper
+
+	params := mintKeeper.GetParams(ctx)
+	params.PausePolicy = types.PauseCatchUp
+	params.BlocksPerYear = 4
+	mintKeeper.SetParams(ctx, params)
+	require.NoError(t, mintKeeper.MintCoins(ctx, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdk.NewInt(1_000_000_000_000)))))
+	genesisSupply := mintKeeper.TokenSupply(ctx, params.MintDenom)
+
+	// blocks 3 and 4 of the first phase and blocks 5 and 6 of the second
+	// phase are skipped
+	mint.BeginBlocker(ctx, mintKeeper)
+	mint.BeginBlocker(ctx.WithBlockHeight(2), mintKeeper)
+	firstPhase := mintKeeper.GetMinter(ctx)
+	require.NoError(t, mintKeeper.SetPaused(ctx, true))
+	for height := int64(3); height <= 6; height++ {
+		mint.BeginBlocker(ctx.WithBlockHeight(height), mintKeeper)
+	}
+
+	require.NoError(t, mintKeeper.SetPaused(ctx, false))
+	mint.BeginBlocker(ctx.WithBlockHeight(7), mintKeeper)
+	secondPhase := mintKeeper.GetMinter(ctx)
+	require.Equal(t, uint64(2), secondPhase.Phase)
+
+	// every skipped block is paid at the rate of the phase it was skipped in
+	firstProvision := firstPhase.ExactBlockProvision(params)
+	secondProvision := secondPhase.ExactBlockProvision(params)
+	require.False(t, firstProvision.Equal(secondProvision))
+	expected := firstProvision.MulInt64(4).Add(secondProvision.MulInt64(3)).TruncateInt()
+	require.Equal(t, expected.String(), mintKeeper.TokenSupply(ctx, params.MintDenom).Sub(genesisSupply).String())
+}
+
+func TestBeginBlockerCatchUpByTimeAcrossPhaseBoundary(t *testing.T) {
+	galaxyApp := app.Setup(false)
+	start := time.Now().UTC()
+	ctx := galaxyApp.BaseApp.NewContext(false, tmproto.Header{Time: start, Height: 1})
+	mintKeeper := galaxyApp.MintKeeper
+
+	params := mintKeeper.GetParams(ctx)
+	params.PausePolicy = types.PauseCatchUp
+	params.PhaseMode = types.PhaseByTime
+	params.PhaseDuration = 100 * time.Second
+	mintKeeper.SetParams(ctx, params)
+	require.NoError(t, mintKeeper.MintCoins(ctx, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdk.NewInt(1_000_000_000_000)))))
+
+	mint.BeginBlocker(ctx, mintKeeper)
+	block := func(height int64, elapsed time.Duration) {
+		mint.BeginBlocker(ctx.WithBlockHeight(height).WithBlockTime(start.Add(elapsed)), mintKeeper)
+	}
+	block(2, 10*time.Second)
+	firstPhase := mintKeeper.GetMinter(ctx)
+	supply := mintKeeper.TokenSupply(ctx, params.MintDenom)
+
+	// the pause spans the end of the first phase at 100s
+	require.NoError(t, mintKeeper.SetPaused(ctx, true))
+	for height := int64(3); height <= 14; height++ {
+		block(height, time.Duration(height-1)*10*time.Second)
+	}
+	require.NoError(t, mintKeeper.SetPaused(ctx, false))
+	block(15, 140*time.Second)
+	secondPhase := mintKeeper.GetMinter(ctx)
+	require.Equal(t, uint64(2), secondPhase.Phase)
+	require.Equal(t, start.Add(100*time.Second), secondPhase.PhaseStartTime)
+
+	// the time elapsed in each phase is paid at the rate of the phase
+	require.False(t, firstPhase.AnnualProvisions.Equal(secondPhase.AnnualProvisions))
+	exact := firstPhase.AnnualProvisions.MulInt64(90).QuoInt64(100).
+		Add(secondPhase.AnnualProvisions.MulInt64(40).QuoInt64(100)).
+		Add(firstPhase.ProvisionRemainder)
+	require.Equal(t, exact.TruncateInt().String(), mintKeeper.TokenSupply(ctx, params.MintDenom).Sub(supply).String())
+}
@@ -0,0 +1,274 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+)
+
+// CmdSubmitEcosystemPoolSpendProposal implements the command to submit an
+// ecosystem pool spend proposal
+func CmdSubmitEcosystemPoolSpendProposal() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "ecosystem-pool-spend [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an ecosystem pool spend proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit an ecosystem pool spend proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal ecosystem-pool-spend <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Ecosystem Pool Spend",
+  "description": "Fund the ecosystem grants program",
+  "recipient": "%s1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "amount": "1000uglx",
+  "deposit": "1000uglx"
+}
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var proposal types.EcosystemPoolSpendProposalWithDeposit
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &proposal); err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(proposal.Amount)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(proposal.Recipient)
+			if err != nil {
+				return err
+			}
+			content := types.NewEcosystemPoolSpendProposal(proposal.Title, proposal.Description, recipient, amount)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// proposalContent is a governance proposal decoded from its JSON.
+type proposalContent interface {
+	govtypes.Content
+	proto.Message
+}
+
+// newCmdSubmitProposal returns a command submitting the proposal read from a
+// JSON file, along with the deposit of the deposit flag.
+func newCmdSubmitProposal(use, short, example string, newContent func() proposalContent) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: short,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`%s along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal %s <path/to/proposal.json> --deposit=1000uglx --from=<key_or_address>
+
+Where proposal.json contains:
+
+%s
+`,
+				short, version.AppName, use, strings.TrimSpace(example),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			content := newContent()
+			if err := clientCtx.Codec.UnmarshalJSON(bz, content); err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// CmdSubmitUpdateMintParamsProposal implements the command to submit a
+// proposal replacing the mint params
+func CmdSubmitUpdateMintParamsProposal() *cobra.Command {
+	return newCmdSubmitProposal(
+		"update-mint-params",
+		"Submit a proposal replacing the mint params",
+		`{
+  "title": "Update Mint Params",
+  "description": "Lower the inflation of the next phases",
+  "params": { ... the full set of params, see query mint params ... }
+}`,
+		func() proposalContent { return &types.UpdateMintParamsProposal{} },
+	)
+}
+
+// CmdSubmitClawbackDeveloperRewardsProposal implements the command to submit a
+// proposal clawing back the locked rewards of a developer rewards receiver
+func CmdSubmitClawbackDeveloperRewardsProposal() *cobra.Command {
+	return newCmdSubmitProposal(
+		"clawback-developer-rewards",
+		"Submit a proposal clawing back the locked rewards of a developer rewards receiver",
+		fmt.Sprintf(`{
+  "title": "Clawback Developer Rewards",
+  "description": "Return the locked rewards of a departed developer",
+  "receiver": "%s1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq"
+}`, sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		func() proposalContent { return &types.ClawbackDeveloperRewardsProposal{} },
+	)
+}
+
+// CmdSubmitAddDeveloperReceiverProposal implements the command to submit a
+// proposal adding a developer rewards receiver
+func CmdSubmitAddDeveloperReceiverProposal() *cobra.Command {
+	return newCmdSubmitProposal(
+		"add-developer-receiver",
+		"Submit a proposal adding a developer rewards receiver",
+		fmt.Sprintf(`{
+  "title": "Add Developer Receiver",
+  "description": "Reward a new core developer",
+  "receiver": {
+    "address": "%s1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+    "weight": "0.100000000000000000",
+    "vesting": {
+      "type": "VestingContinuous",
+      "start_time": "2023-01-01T00:00:00Z",
+      "end_time": "2025-01-01T00:00:00Z",
+      "period": "0s"
+    }
+  }
+}`, sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		func() proposalContent { return &types.AddDeveloperReceiverProposal{} },
+	)
+}
+
+// CmdSubmitRemoveDeveloperReceiverProposal implements the command to submit a
+// proposal removing a developer rewards receiver
+func CmdSubmitRemoveDeveloperReceiverProposal() *cobra.Command {
+	return newCmdSubmitProposal(
+		"remove-developer-receiver",
+		"Submit a proposal removing a developer rewards receiver",
+		fmt.Sprintf(`{
+  "title": "Remove Developer Receiver",
+  "description": "Stop rewarding a departed developer",
+  "address": "%s1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "redistribution": "RedistributeToReceivers"
+}`, sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		func() proposalContent { return &types.RemoveDeveloperReceiverProposal{} },
+	)
+}
+
+// CmdSubmitReplaceDeveloperReceiverProposal implements the command to submit a
+// proposal handing a developer rewards receiver over to a new address
+func CmdSubmitReplaceDeveloperReceiverProposal() *cobra.Command {
+	prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	return newCmdSubmitProposal(
+		"replace-developer-receiver",
+		"Submit a proposal handing the weight and vesting of a developer rewards receiver over to a new address",
+		fmt.Sprintf(`{
+  "title": "Replace Developer Receiver",
+  "description": "Rotate the key of a developer",
+  "address": "%s1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "new_address": "%s1qyqszqgpqyqszqgpqyqszqgpqyqszqgpwv87xw"
+}`, prefix, prefix),
+		func() proposalContent { return &types.ReplaceDeveloperReceiverProposal{} },
+	)
+}
+
+// CmdSubmitReweightDeveloperReceiverProposal implements the command to submit a
+// proposal changing the weight of a developer rewards receiver
+func CmdSubmitReweightDeveloperReceiverProposal() *cobra.Command {
+	return newCmdSubmitProposal(
+		"reweight-developer-receiver",
+		"Submit a proposal changing the weight of a developer rewards receiver",
+		fmt.Sprintf(`{
+  "title": "Reweight Developer Receiver",
+  "description": "Raise the share of a developer",
+  "address": "%s1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "weight": "0.200000000000000000"
+}`, sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		func() proposalContent { return &types.ReweightDeveloperReceiverProposal{} },
+	)
+}
+
+// CmdSubmitSetMintPausedProposal implements the command to submit a proposal
+// pausing or resuming minting
+func CmdSubmitSetMintPausedProposal() *cobra.Command {
+	return newCmdSubmitProposal(
+		"set-mint-paused",
+		"Submit a proposal pausing or resuming minting",
+		`{
+  "title": "Resume Minting",
+  "description": "The issue behind the guardian pause is fixed",
+  "paused": false
+}`,
+		func() proposalContent { return &types.SetMintPausedProposal{} },
+	)
+}
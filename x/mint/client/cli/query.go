@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -19,7 +20,25 @@ func GetQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(CmdQueryParams(), CmdQueryMinter())
+	cmd.AddCommand(
+		CmdQueryParams(),
+		CmdQueryMinter(),
+		CmdQueryInflation(),
+		CmdQueryAnnualProvisions(),
+		CmdQueryPhase(),
+		CmdQueryBlockProvision(),
+		CmdQueryBlocksRemaining(),
+		CmdQueryProjection(),
+		CmdQueryDeveloperRewards(),
+		CmdQueryAllDeveloperRewards(),
+		CmdQueryDeveloperVesting(),
+		CmdQueryDistributionTotals(),
+		CmdQueryDistributionRecord(),
+		CmdQueryEpoch(),
+		CmdQueryEcosystemPool(),
+		CmdQueryPause(),
+		CmdQueryFeeBurn(),
+	)
 	return cmd
 }
 
@@ -70,3 +89,374 @@ func CmdQueryMinter() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryInflation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inflation",
+		Short: "shows the current inflation rate",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Inflation(context.Background(), &types.QueryInflationRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", res.Inflation))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryAnnualProvisions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "annual-provisions",
+		Short: "shows the current annual provisions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AnnualProvisions(context.Background(), &types.QueryAnnualProvisionsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", res.AnnualProvisions))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPhase() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "phase",
+		Short: "shows the current phase with its start and end",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Phase(context.Background(), &types.QueryPhaseRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryBlockProvision() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-provision",
+		Short: "shows the provision of the next block broken down by destination",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BlockProvision(context.Background(), &types.QueryBlockProvisionRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryBlocksRemaining() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blocks-remaining",
+		Short: "shows the blocks remaining until the next phase",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BlocksRemaining(context.Background(), &types.QueryBlocksRemainingRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryProjection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projection",
+		Short: "shows the projected emissions of every remaining phase",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Projection(context.Background(), &types.QueryProjectionRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryDeveloperRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "developer-rewards [address]",
+		Short: "shows the escrowed rewards of a developer rewards receiver",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DeveloperRewards(context.Background(), &types.QueryDeveloperRewardsRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryAllDeveloperRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-developer-rewards",
+		Short: "shows the escrowed rewards of all developer rewards receivers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllDeveloperRewards(context.Background(), &types.QueryAllDeveloperRewardsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all-developer-rewards")
+
+	return cmd
+}
+
+func CmdQueryDeveloperVesting() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "developer-vesting [address]",
+		Short: "shows the vested and locked rewards of a developer rewards receiver",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DeveloperVesting(context.Background(), &types.QueryDeveloperVestingRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryDistributionTotals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribution-totals",
+		Short: "shows the minted coins sent to each destination, overall and per phase",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DistributionTotals(context.Background(), &types.QueryDistributionTotalsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryDistributionRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribution-record [phase]",
+		Short: "shows the minted coins sent to each destination during a phase",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			phase, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DistributionRecord(context.Background(), &types.QueryDistributionRecordRequest{Phase: phase})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch",
+		Short: "shows the minting epoch in progress",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Epoch(context.Background(), &types.QueryEpochRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryEcosystemPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ecosystem-pool",
+		Short: "shows the balance of the ecosystem incentives pool",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EcosystemPool(context.Background(), &types.QueryEcosystemPoolRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause",
+		Short: "shows the pause state of minting",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Pause(context.Background(), &types.QueryPauseRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryFeeBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-burn",
+		Short: "shows the cumulative fees burned",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeBurn(context.Background(), &types.QueryFeeBurnRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,419 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagToCommunityPool = "to-community-pool"
+)
+
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdUpdateParams(),
+		CmdWithdrawDeveloperRewards(),
+		CmdClawbackDeveloperRewards(),
+		CmdAddDeveloperReceiver(),
+		CmdRemoveDeveloperReceiver(),
+		CmdReplaceDeveloperReceiver(),
+		CmdReweightDeveloperReceiver(),
+		CmdPause(),
+		CmdResume(),
+	)
+	return cmd
+}
+
+func CmdUpdateParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params [params-file]",
+		Short: "update the parameters of the module",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update the full set of mint parameters from a JSON file.
+The transaction must be signed by the module authority (the gov module account by default),
+so it is usually generated with --generate-only and executed through governance.
+
+Example:
+$ %s tx mint update-params params.json --from <authority> --generate-only
+
+Where params.json contains:
+{
+  "mint_denom": "uglx",
+  "threshold_phase": "2",
+  "stop_inflation_phase": "13",
+  "distribution_proportions": {
+    "staking": "0.200000000000000000",
+    "ecosystem_incentives": "0.500000000000000000",
+    "developer_rewards": "0.200000000000000000",
+    "community_pool": "0.100000000000000000"
+  },
+  "weighted_developer_rewards_receivers": [],
+  "blocks_per_year": "6311520",
+  "inflation_schedule": [
+    {"phase": "1", "inflation": "0.500000000000000000", "provision_cap": "0"},
+    {"phase": "2", "inflation": "0.300000000000000000", "provision_cap": "0"}
+  ],
+  "phase_mode": "PhaseByHeight",
+  "phase_duration": "31557600s",
+  "max_supply": "0"
+}
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &params); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateParams(clientCtx.GetFromAddress().String(), params)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdWithdrawDeveloperRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-developer-rewards",
+		Short: "withdraw the escrowed developer rewards of the sender",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawDeveloperRewards(clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdClawbackDeveloperRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback-developer-rewards [receiver]",
+		Short: "send the locked developer rewards of a receiver to the community pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Send the locked developer rewards of a receiver to the community pool.
+The transaction must be signed by the module authority (the gov module account by default).
+
+Example:
+$ %s tx mint clawback-developer-rewards <receiver> --from <authority> --generate-only
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClawbackDeveloperRewards(clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdAddDeveloperReceiver() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-developer-receiver [receiver-file]",
+		Short: "add a developer rewards receiver, scaling down the weights of the others",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add a developer rewards receiver from a JSON file. The weights of the other
+receivers are scaled down proportionally so that the weights still sum to 1.
+The transaction must be signed by the module authority (the gov module account by default).
+
+Example:
+$ %s tx mint add-developer-receiver receiver.json --from <authority> --generate-only
+
+Where receiver.json contains:
+{
+  "address": "<receiver>",
+  "weight": "0.250000000000000000",
+  "vesting": {"type": "VestingNone"}
+}
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var receiver types.DevloperWeightedAddress
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &receiver); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddDeveloperReceiver(clientCtx.GetFromAddress().String(), receiver)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRemoveDeveloperReceiver() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-developer-receiver [receiver]",
+		Short: "remove a developer rewards receiver",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove a developer rewards receiver. Its weight is redistributed to the other
+receivers proportionally, or with --%s its share of the developer rewards
+proportion moves to the community pool proportion.
+The transaction must be signed by the module authority (the gov module account by default).
+
+Example:
+$ %s tx mint remove-developer-receiver <receiver> --from <authority> --generate-only
+`,
+				FlagToCommunityPool, version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toCommunityPool, err := cmd.Flags().GetBool(FlagToCommunityPool)
+			if err != nil {
+				return err
+			}
+			redistribution := types.RedistributeToReceivers
+			if toCommunityPool {
+				redistribution = types.RedistributeToCommunityPool
+			}
+
+			msg := types.NewMsgRemoveDeveloperReceiver(clientCtx.GetFromAddress().String(), args[0], redistribution)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagToCommunityPool, false, "move the share of the receiver to the community pool instead of the other receivers")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdReplaceDeveloperReceiver() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replace-developer-receiver [receiver] [new-receiver]",
+		Short: "replace the address of a developer rewards receiver, keeping its weight and vesting",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the address of a developer rewards receiver, keeping its weight and vesting.
+The rewards already escrowed for the receiver stay withdrawable by it.
+The transaction must be signed by the module authority (the gov module account by default).
+
+Example:
+$ %s tx mint replace-developer-receiver <receiver> <new-receiver> --from <authority> --generate-only
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReplaceDeveloperReceiver(clientCtx.GetFromAddress().String(), args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdReweightDeveloperReceiver() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reweight-developer-receiver [receiver] [weight]",
+		Short: "set the weight of a developer rewards receiver, scaling the weights of the others",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the weight of a developer rewards receiver. The weights of the other
+receivers are scaled proportionally so that the weights still sum to 1.
+The transaction must be signed by the module authority (the gov module account by default).
+
+Example:
+$ %s tx mint reweight-developer-receiver <receiver> 0.4 --from <authority> --generate-only
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			weight, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReweightDeveloperReceiver(clientCtx.GetFromAddress().String(), args[0], weight)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause",
+		Short: "pause minting",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Pause minting. The provisions of the blocks skipped while paused are forfeited
+or caught up on resume, following the pause policy parameter.
+The transaction must be signed by the module authority (the gov module account by default)
+or by the guardian.
+
+Example:
+$ %s tx mint pause --from <guardian>
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetPaused(clientCtx.GetFromAddress().String(), true)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdResume() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume",
+		Short: "resume minting",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Resume minting after a pause.
+The transaction must be signed by the module authority (the gov module account by default).
+
+Example:
+$ %s tx mint resume --from <authority> --generate-only
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetPaused(clientCtx.GetFromAddress().String(), false)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,31 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/galaxynetwork/galaxy/x/mint/client/cli"
+	"github.com/galaxynetwork/galaxy/x/mint/client/rest"
+)
+
+var (
+	// ProposalHandler is the ecosystem pool spend proposal handler.
+	ProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitEcosystemPoolSpendProposal, rest.ProposalRESTHandler)
+	// UpdateParamsProposalHandler is the mint params update proposal handler.
+	UpdateParamsProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitUpdateMintParamsProposal, rest.UpdateMintParamsProposalRESTHandler)
+	// ClawbackDeveloperRewardsProposalHandler is the developer rewards clawback
+	// proposal handler.
+	ClawbackDeveloperRewardsProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitClawbackDeveloperRewardsProposal, rest.ClawbackDeveloperRewardsProposalRESTHandler)
+	// AddDeveloperReceiverProposalHandler is the developer receiver addition
+	// proposal handler.
+	AddDeveloperReceiverProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitAddDeveloperReceiverProposal, rest.AddDeveloperReceiverProposalRESTHandler)
+	// RemoveDeveloperReceiverProposalHandler is the developer receiver removal
+	// proposal handler.
+	RemoveDeveloperReceiverProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitRemoveDeveloperReceiverProposal, rest.RemoveDeveloperReceiverProposalRESTHandler)
+	// ReplaceDeveloperReceiverProposalHandler is the developer receiver
+	// replacement proposal handler.
+	ReplaceDeveloperReceiverProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitReplaceDeveloperReceiverProposal, rest.ReplaceDeveloperReceiverProposalRESTHandler)
+	// ReweightDeveloperReceiverProposalHandler is the developer receiver
+	// reweight proposal handler.
+	ReweightDeveloperReceiverProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitReweightDeveloperReceiverProposal, rest.ReweightDeveloperReceiverProposalRESTHandler)
+	// SetPausedProposalHandler is the mint pause proposal handler.
+	SetPausedProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitSetMintPausedProposal, rest.SetMintPausedProposalRESTHandler)
+)
@@ -0,0 +1,165 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+// EcosystemPoolSpendProposalReq defines an ecosystem pool spend proposal
+// request body.
+type EcosystemPoolSpendProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Recipient   sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the ecosystem
+// pool spend REST handler with a given sub-route.
+func ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "ecosystem_pool_spend",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
+func postProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req EcosystemPoolSpendProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewEcosystemPoolSpendProposal(req.Title, req.Description, req.Recipient, req.Amount)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// ProposalReq defines a governance proposal request body, along with the JSON
+// of the proposal content.
+type ProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Content  json.RawMessage `json:"content" yaml:"content"`
+	Proposer sdk.AccAddress  `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.Coins       `json:"deposit" yaml:"deposit"`
+}
+
+// proposalContent is a governance proposal decoded from its JSON.
+type proposalContent interface {
+	govtypes.Content
+	proto.Message
+}
+
+// newProposalRESTHandler returns a ProposalRESTHandler submitting the
+// proposals decoded by newContent with a given sub-route.
+func newProposalRESTHandler(subRoute string, newContent func() proposalContent) govclient.RESTHandlerFn {
+	return func(clientCtx client.Context) govrest.ProposalRESTHandler {
+		return govrest.ProposalRESTHandler{
+			SubRoute: subRoute,
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				var req ProposalReq
+				if err := json.NewDecoder(r.Body).Decode(&req); rest.CheckBadRequestError(w, err) {
+					return
+				}
+
+				req.BaseReq = req.BaseReq.Sanitize()
+				if !req.BaseReq.ValidateBasic(w) {
+					return
+				}
+
+				content := newContent()
+				if err := clientCtx.Codec.UnmarshalJSON(req.Content, content); rest.CheckBadRequestError(w, err) {
+					return
+				}
+
+				msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+				if rest.CheckBadRequestError(w, err) {
+					return
+				}
+				if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+					return
+				}
+
+				tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+			},
+		}
+	}
+}
+
+// UpdateMintParamsProposalRESTHandler returns the REST handler of proposals
+// replacing the mint params.
+var UpdateMintParamsProposalRESTHandler = newProposalRESTHandler(
+	"update_mint_params",
+	func() proposalContent { return &types.UpdateMintParamsProposal{} },
+)
+
+// ClawbackDeveloperRewardsProposalRESTHandler returns the REST handler of
+// proposals clawing back the locked rewards of a developer rewards receiver.
+var ClawbackDeveloperRewardsProposalRESTHandler = newProposalRESTHandler(
+	"clawback_developer_rewards",
+	func() proposalContent { return &types.ClawbackDeveloperRewardsProposal{} },
+)
+
+// AddDeveloperReceiverProposalRESTHandler returns the REST handler of
+// proposals adding a developer rewards receiver.
+var AddDeveloperReceiverProposalRESTHandler = newProposalRESTHandler(
+	"add_developer_receiver",
+	func() proposalContent { return &types.AddDeveloperReceiverProposal{} },
+)
+
+// RemoveDeveloperReceiverProposalRESTHandler returns the REST handler of
+// proposals removing a developer rewards receiver.
+var RemoveDeveloperReceiverProposalRESTHandler = newProposalRESTHandler(
+	"remove_developer_receiver",
+	func() proposalContent { return &types.RemoveDeveloperReceiverProposal{} },
+)
+
+// ReplaceDeveloperReceiverProposalRESTHandler returns the REST handler of
+// proposals handing a developer rewards receiver over to a new address.
+var ReplaceDeveloperReceiverProposalRESTHandler = newProposalRESTHandler(
+	"replace_developer_receiver",
+	func() proposalContent { return &types.ReplaceDeveloperReceiverProposal{} },
+)
+
+// ReweightDeveloperReceiverProposalRESTHandler returns the REST handler of
+// proposals changing the weight of a developer rewards receiver.
+var ReweightDeveloperReceiverProposalRESTHandler = newProposalRESTHandler(
+	"reweight_developer_receiver",
+	func() proposalContent { return &types.ReweightDeveloperReceiverProposal{} },
+)
+
+// SetMintPausedProposalRESTHandler returns the REST handler of proposals
+// pausing or resuming minting.
+var SetMintPausedProposalRESTHandler = newProposalRESTHandler(
+	"set_mint_paused",
+	func() proposalContent { return &types.SetMintPausedProposal{} },
+)
@@ -7,9 +7,38 @@ import (
 )
 
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, ak types.AccountKeeper, genState types.GenesisState) {
+	// phases advancing by block time start from the genesis time
+	if genState.Minter.PhaseStartTime.IsZero() {
+		genState.Minter.PhaseStartTime = ctx.BlockTime()
+	}
+	if genState.Minter.LastBlockTime.IsZero() {
+		genState.Minter.LastBlockTime = ctx.BlockTime()
+	}
+	if err := k.ValidateMaxSupply(ctx, genState.Params); err != nil {
+		panic(err)
+	}
 	k.SetParams(ctx, genState.Params)
 	k.SetMinter(ctx, genState.Minter)
+	// the first epoch starts with the first block
+	if genState.Epoch.StartTime.IsZero() {
+		genState.Epoch.StartTime = ctx.BlockTime()
+	}
+	if genState.Epoch.StartHeight == 0 {
+		genState.Epoch.StartHeight = ctx.BlockHeight() + 1
+	}
+	k.SetEpoch(ctx, genState.Epoch)
+	k.SetDistributionRemainder(ctx, genState.DistributionRemainder)
+	k.SetMintPause(ctx, genState.Pause)
+	k.SetFeeBurn(ctx, genState.FeeBurn)
+	k.SetDistributionFailures(ctx, genState.DistributionFailures)
+	for _, rewards := range genState.DeveloperRewards {
+		k.SetDeveloperRewards(ctx, rewards)
+	}
+	for _, record := range genState.DistributionRecords {
+		k.SetDistributionRecord(ctx, record)
+	}
 	ak.GetModuleAccount(ctx, types.ModuleName)
+	ak.GetModuleAccount(ctx, types.EcosystemPoolName)
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -17,5 +46,12 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesisState()
 	genesis.Params = k.GetParams(ctx)
 	genesis.Minter = k.GetMinter(ctx)
+	genesis.DeveloperRewards = k.GetAllDeveloperRewards(ctx)
+	genesis.DistributionRecords = k.GetAllDistributionRecords(ctx)
+	genesis.Epoch = k.GetEpoch(ctx)
+	genesis.DistributionRemainder = k.GetDistributionRemainder(ctx)
+	genesis.Pause = k.GetMintPause(ctx)
+	genesis.FeeBurn = k.GetFeeBurn(ctx)
+	genesis.DistributionFailures = k.GetDistributionFailures(ctx)
 	return genesis
 }
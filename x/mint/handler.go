@@ -0,0 +1,95 @@
+package mint
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/galaxynetwork/galaxy/x/mint/keeper"
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+// NewHandler returns a handler for mint module messages
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *types.MsgUpdateParams:
+			res, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgWithdrawDeveloperRewards:
+			res, err := msgServer.WithdrawDeveloperRewards(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgClawbackDeveloperRewards:
+			res, err := msgServer.ClawbackDeveloperRewards(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgAddDeveloperReceiver:
+			res, err := msgServer.AddDeveloperReceiver(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRemoveDeveloperReceiver:
+			res, err := msgServer.RemoveDeveloperReceiver(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgReplaceDeveloperReceiver:
+			res, err := msgServer.ReplaceDeveloperReceiver(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgReweightDeveloperReceiver:
+			res, err := msgServer.ReweightDeveloperReceiver(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSetPaused:
+			res, err := msgServer.SetPaused(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+	}
+}
+
+// NewProposalHandler returns a handler for mint governance proposals. Under
+// gov v1beta1 the gov module account never signs a Msg, so the changes
+// reserved to governance are executed through these proposals.
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.EcosystemPoolSpendProposal:
+			return k.HandleEcosystemPoolSpendProposal(ctx, c)
+
+		case *types.UpdateMintParamsProposal:
+			return k.UpdateParams(ctx, k.GetAuthority(), c.Params)
+
+		case *types.ClawbackDeveloperRewardsProposal:
+			_, err := k.ClawbackDeveloperRewards(ctx, c.Receiver)
+			return err
+
+		case *types.AddDeveloperReceiverProposal:
+			return k.AddDeveloperReceiver(ctx, c.Receiver)
+
+		case *types.RemoveDeveloperReceiverProposal:
+			return k.RemoveDeveloperReceiver(ctx, c.Address, c.Redistribution)
+
+		case *types.ReplaceDeveloperReceiverProposal:
+			return k.ReplaceDeveloperReceiver(ctx, c.Address, c.NewAddress)
+
+		case *types.ReweightDeveloperReceiverProposal:
+			return k.ReweightDeveloperReceiver(ctx, c.Address, c.Weight)
+
+		case *types.SetMintPausedProposal:
+			return k.SetPaused(ctx, c.Paused)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+// AddDeveloperReceiver adds a developer rewards receiver with its weight and
+// vesting.
+func (k Keeper) AddDeveloperReceiver(ctx sdk.Context, receiver types.DevloperWeightedAddress) error {
+	params, err := k.GetParams(ctx).AddDeveloperReceiver(receiver)
+	if err != nil {
+		return err
+	}
+	if err := k.setDeveloperReceivers(ctx, params); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateDeveloperReceivers,
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.TypeMsgAddDeveloperReceiver),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver.Address),
+			sdk.NewAttribute(types.AttributeKeyWeight, receiver.Weight.String()),
+		),
+	)
+
+	return nil
+}
+
+// RemoveDeveloperReceiver removes a developer rewards receiver, its weight
+// going where the redistribution defines.
+func (k Keeper) RemoveDeveloperReceiver(ctx sdk.Context, address string, redistribution types.WeightRedistribution) error {
+	params, err := k.GetParams(ctx).RemoveDeveloperReceiver(address, redistribution)
+	if err != nil {
+		return err
+	}
+	if err := k.setDeveloperReceivers(ctx, params); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateDeveloperReceivers,
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.TypeMsgRemoveDeveloperReceiver),
+			sdk.NewAttribute(types.AttributeKeyReceiver, address),
+		),
+	)
+
+	return nil
+}
+
+// ReplaceDeveloperReceiver hands the weight and vesting of a developer
+// rewards receiver over to a new address. The rewards still locked move to the
+// new address, which keeps vesting them, the vested rewards stay withdrawable
+// by the replaced address.
+func (k Keeper) ReplaceDeveloperReceiver(ctx sdk.Context, address, newAddress string) error {
+	// the rewards are vested with the schedule of the replaced address
+	rewards := k.GetVestedDeveloperRewards(ctx, address)
+
+	params, err := k.GetParams(ctx).ReplaceDeveloperReceiver(address, newAddress)
+	if err != nil {
+		return err
+	}
+	if err := k.setDeveloperReceivers(ctx, params); err != nil {
+		return err
+	}
+
+	if !rewards.Accrued.IsZero() {
+		rewards, moved := rewards.MoveLocked(k.GetDeveloperRewards(ctx, newAddress))
+		k.SetDeveloperRewards(ctx, rewards)
+		if !moved.Accrued.IsZero() {
+			k.SetDeveloperRewards(ctx, moved)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateDeveloperReceivers,
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.TypeMsgReplaceDeveloperReceiver),
+			sdk.NewAttribute(types.AttributeKeyReceiver, address),
+			sdk.NewAttribute(types.AttributeKeyNewReceiver, newAddress),
+		),
+	)
+
+	return nil
+}
+
+// ReweightDeveloperReceiver changes the weight of a developer rewards
+// receiver.
+func (k Keeper) ReweightDeveloperReceiver(ctx sdk.Context, address string, weight sdk.Dec) error {
+	params, err := k.GetParams(ctx).ReweightDeveloperReceiver(address, weight)
+	if err != nil {
+		return err
+	}
+	if err := k.setDeveloperReceivers(ctx, params); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateDeveloperReceivers,
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.TypeMsgReweightDeveloperReceiver),
+			sdk.NewAttribute(types.AttributeKeyReceiver, address),
+			sdk.NewAttribute(types.AttributeKeyWeight, weight.String()),
+		),
+	)
+
+	return nil
+}
+
+// setDeveloperReceivers stores params with updated developer receivers once
+// the full set of params is valid.
+func (k Keeper) setDeveloperReceivers(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	k.SetParams(ctx, params)
+	return nil
+}
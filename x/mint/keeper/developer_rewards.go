@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+// GetDeveloperRewards returns the escrowed rewards of a developer rewards receiver.
+func (k Keeper) GetDeveloperRewards(ctx sdk.Context, address string) types.DeveloperRewards {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DeveloperRewardsKey(address))
+	if bz == nil {
+		return types.DeveloperRewards{Address: address}
+	}
+
+	var rewards types.DeveloperRewards
+	k.cdc.MustUnmarshal(bz, &rewards)
+	return rewards
+}
+
+func (k Keeper) SetDeveloperRewards(ctx sdk.Context, rewards types.DeveloperRewards) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&rewards)
+	store.Set(types.DeveloperRewardsKey(rewards.Address), bz)
+}
+
+// IterateDeveloperRewards iterates over the escrowed rewards of all receivers
+// and stops when the handler returns true.
+func (k Keeper) IterateDeveloperRewards(ctx sdk.Context, handler func(rewards types.DeveloperRewards) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DeveloperRewardsKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rewards types.DeveloperRewards
+		k.cdc.MustUnmarshal(iterator.Value(), &rewards)
+		if handler(rewards) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetAllDeveloperRewards(ctx sdk.Context) []types.DeveloperRewards {
+	rewards := []types.DeveloperRewards{}
+	k.IterateDeveloperRewards(ctx, func(r types.DeveloperRewards) bool {
+		rewards = append(rewards, r)
+		return false
+	})
+	return rewards
+}
+
+// GetEscrowedDeveloperRewards returns the developer rewards held by the mint
+// module account that have not been withdrawn yet, locked or not.
+func (k Keeper) GetEscrowedDeveloperRewards(ctx sdk.Context) sdk.Coins {
+	escrowed := sdk.Coins{}
+	k.IterateDeveloperRewards(ctx, func(r types.DeveloperRewards) bool {
+		escrowed = escrowed.Add(r.Escrowed()...)
+		return false
+	})
+	return escrowed
+}
+
+// GetVestedDeveloperRewards returns the rewards of a receiver with the locked
+// rewards vested up to the current block time. Locked rewards of an address
+// that is no longer a receiver stay locked until they are clawed back.
+func (k Keeper) GetVestedDeveloperRewards(ctx sdk.Context, address string) types.DeveloperRewards {
+	rewards := k.GetDeveloperRewards(ctx, address)
+	vesting, found := k.GetParams(ctx).GetDeveloperVesting(address)
+	if !found {
+		return rewards
+	}
+	return rewards.Vest(vesting, ctx.BlockTime())
+}
+
+// AccrueDeveloperRewards escrows rewards for a developer rewards receiver. The
+// coins stay in the mint module account until the receiver withdraws them,
+// and are locked while the vesting schedule of the receiver is running. The
+// minted developer rewards are accrued once per epoch, when the epoch
+// provisions are distributed.
+func (k Keeper) AccrueDeveloperRewards(ctx sdk.Context, address string, coins sdk.Coins) {
+	if coins.IsZero() {
+		return
+	}
+
+	rewards := k.GetVestedDeveloperRewards(ctx, address)
+	rewards.Accrued = rewards.Accrued.Add(coins...)
+	if vesting, found := k.GetParams(ctx).GetDeveloperVesting(address); found && vesting.IsLocking(ctx.BlockTime()) {
+		rewards.Locked = rewards.Locked.Add(coins...)
+	}
+	k.SetDeveloperRewards(ctx, rewards)
+}
+
+// WithdrawDeveloperRewards sends all vested rewards of the receiver to it.
+func (k Keeper) WithdrawDeveloperRewards(ctx sdk.Context, receiver sdk.AccAddress) (sdk.Coins, error) {
+	rewards := k.GetVestedDeveloperRewards(ctx, receiver.String())
+	withdrawable := rewards.Withdrawable()
+	if withdrawable.IsZero() {
+		return nil, sdkerrors.Wrapf(types.ErrNoDeveloperRewards, "receiver %s", receiver)
+	}
+
+	err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, withdrawable)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards.Withdrawn = rewards.Withdrawn.Add(withdrawable...)
+	k.SetDeveloperRewards(ctx, rewards)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawDeveloperRewards,
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, withdrawable.String()),
+		),
+	)
+
+	return withdrawable, nil
+}
+
+// ClawbackDeveloperRewards sends the locked rewards of a receiver to the
+// community pool.
+func (k Keeper) ClawbackDeveloperRewards(ctx sdk.Context, address string) (sdk.Coins, error) {
+	rewards := k.GetVestedDeveloperRewards(ctx, address)
+	locked := rewards.Locked
+	if locked.IsZero() {
+		return nil, sdkerrors.Wrapf(types.ErrNoLockedDeveloperRewards, "receiver %s", address)
+	}
+
+	if err := k.FundToCommuinityPool(ctx, locked); err != nil {
+		return nil, err
+	}
+
+	rewards.ClawedBack = rewards.ClawedBack.Add(locked...)
+	rewards.Locked = sdk.NewCoins()
+	k.SetDeveloperRewards(ctx, rewards)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClawbackDeveloperRewards,
+			sdk.NewAttribute(types.AttributeKeyReceiver, address),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, locked.String()),
+		),
+	)
+
+	return locked, nil
+}
+
+func (k Keeper) developerRewardsStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.DeveloperRewardsKeyPrefix)
+}
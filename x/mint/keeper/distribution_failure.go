@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+// GetDistributionFailures returns the number of shares of the minted coins
+// that failed to be distributed.
+func (k Keeper) GetDistributionFailures(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DistributionFailuresKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetDistributionFailures(ctx sdk.Context, failures uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DistributionFailuresKey, sdk.Uint64ToBigEndian(failures))
+}
+
+// recordDistributionFailure counts a share that failed to be distributed and
+// reports it with the reason.
+func (k Keeper) recordDistributionFailure(ctx sdk.Context, destination, receiver string, amount sdk.Coins, reason error) {
+	k.SetDistributionFailures(ctx, k.GetDistributionFailures(ctx)+1)
+
+	k.Logger(ctx).Error("failed to distribute minted coins", "destination", destination, "receiver", receiver, "amount", amount.String(), "err", reason)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistributionFailed,
+			sdk.NewAttribute(types.AttributeKeyDestination, destination),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason.Error()),
+		),
+	)
+}
@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/galaxynetwork/galaxy/x/mint/keeper"
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestDistributionFailure() {
+	mintKeeper := suite.app.MintKeeper
+	distrKeeper := suite.app.DistrKeeper
+	receiver := sdk.AccAddress([]byte("addr1---")).String()
+
+	// params validation rejects the malformed address, it is set bypassing it
+	receivers := []types.DevloperWeightedAddress{
+		{Address: receiver, Weight: sdk.NewDecWithPrec(5, 1)},
+		{Address: "galaxy1malformed", Weight: sdk.NewDecWithPrec(5, 1)},
+	}
+	suite.app.GetSubspace(types.ModuleName).Set(suite.ctx, types.KeyWeightedDeveloperRewardsReceiver, receivers)
+
+	params := mintKeeper.GetParams(suite.ctx)
+	mintedCoin := sdk.NewInt64Coin(params.MintDenom, 100_000)
+	suite.Require().NoError(mintKeeper.MintCoins(suite.ctx, sdk.NewCoins(mintedCoin)))
+	communityPool := distrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	mintKeeper.DistributeMintedCoin(ctx, mintedCoin)
+
+	// the share of the malformed address goes to the community pool
+	suite.Require().Equal(uint64(1), mintKeeper.GetDistributionFailures(ctx))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 10_000)), mintKeeper.GetDeveloperRewards(ctx, receiver).Accrued)
+	funded := distrKeeper.GetFeePoolCommunityCoins(ctx).Sub(communityPool)
+	suite.Require().Equal(sdk.NewDec(20_000), funded.AmountOf(params.MintDenom))
+
+	record := mintKeeper.GetDistributionRecord(ctx, mintKeeper.GetMinter(ctx).Phase)
+	suite.Require().Len(record.DeveloperRewards, 1)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 20_000)), record.CommunityPool)
+
+	var failed []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeDistributionFailed {
+			failed = append(failed, event)
+		}
+	}
+	suite.Require().Len(failed, 1)
+	attributes := map[string]string{}
+	for _, attribute := range failed[0].Attributes {
+		attributes[string(attribute.Key)] = string(attribute.Value)
+	}
+	suite.Require().Equal(types.DestinationDeveloperRewards, attributes[types.AttributeKeyDestination])
+	suite.Require().Equal("galaxy1malformed", attributes[types.AttributeKeyReceiver])
+	suite.Require().NotEmpty(attributes[types.AttributeKeyReason])
+
+	_, broken := keeper.ModuleBalanceInvariant(mintKeeper)(ctx)
+	suite.Require().False(broken)
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+// GetDistributionRecord returns the minted coins distributed during a phase.
+func (k Keeper) GetDistributionRecord(ctx sdk.Context, phase uint64) types.DistributionRecord {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DistributionRecordKey(phase))
+	if bz == nil {
+		return types.NewDistributionRecord(phase)
+	}
+
+	var record types.DistributionRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record
+}
+
+func (k Keeper) SetDistributionRecord(ctx sdk.Context, record types.DistributionRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.DistributionRecordKey(record.Phase), bz)
+}
+
+// IterateDistributionRecords iterates over the distribution records in phase
+// order and stops when the handler returns true.
+func (k Keeper) IterateDistributionRecords(ctx sdk.Context, handler func(record types.DistributionRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DistributionRecordKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.DistributionRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if handler(record) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetAllDistributionRecords(ctx sdk.Context) []types.DistributionRecord {
+	records := []types.DistributionRecord{}
+	k.IterateDistributionRecords(ctx, func(r types.DistributionRecord) bool {
+		records = append(records, r)
+		return false
+	})
+	return records
+}
+
+// GetDistributionTotals returns the minted coins distributed during all phases.
+func (k Keeper) GetDistributionTotals(ctx sdk.Context) types.DistributionRecord {
+	total := types.NewDistributionRecord(0)
+	k.IterateDistributionRecords(ctx, func(r types.DistributionRecord) bool {
+		total = total.Add(r)
+		return false
+	})
+	return total
+}
+
+// RecordDistribution adds the distribution of a block to the record of the
+// given phase.
+func (k Keeper) RecordDistribution(ctx sdk.Context, phase uint64, distribution types.DistributionRecord) {
+	record := k.GetDistributionRecord(ctx, phase)
+	k.SetDistributionRecord(ctx, record.Add(distribution))
+}
@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestRecordDistribution() {
+	mintKeeper := suite.app.MintKeeper
+	receiver1 := sdk.AccAddress([]byte("addr1---")).String()
+	receiver2 := sdk.AccAddress([]byte("addr2---")).String()
+
+	params := mintKeeper.GetParams(suite.ctx)
+	params.WeightedDeveloperRewardsReceivers = []types.DevloperWeightedAddress{
+		{Address: receiver1, Weight: sdk.NewDecWithPrec(7, 1)},
+		{Address: receiver2, Weight: sdk.NewDecWithPrec(3, 1)},
+	}
+	mintKeeper.SetParams(suite.ctx, params)
+
+	mintedCoin := sdk.NewInt64Coin(params.MintDenom, 1_000_003)
+	for _, phase := range []uint64{1, 1, 2} {
+		minter := mintKeeper.GetMinter(suite.ctx)
+		minter.Phase = phase
+		mintKeeper.SetMinter(suite.ctx, minter)
+
+		suite.Require().NoError(mintKeeper.MintCoins(suite.ctx, sdk.NewCoins(mintedCoin)))
+		mintKeeper.DistributeMintedCoin(suite.ctx, mintedCoin)
+	}
+
+	records := mintKeeper.GetAllDistributionRecords(suite.ctx)
+	suite.Require().Len(records, 2)
+	suite.Require().Equal(uint64(1), records[0].Phase)
+	suite.Require().Equal(mintedCoin.Amount.MulRaw(2), records[0].Minted.AmountOf(params.MintDenom))
+	suite.Require().Equal(uint64(2), records[1].Phase)
+
+	total := mintKeeper.GetDistributionTotals(suite.ctx)
+	suite.Require().Equal(mintedCoin.Amount.MulRaw(3), total.Minted.AmountOf(params.MintDenom))
+
+	// every minted coin is accounted for, including the units held back
+	undistributed := mintKeeper.GetDistributionRemainder(suite.ctx).Undistributed
+	distributed := total.Staking.Add(total.EcosystemIncentives...).Add(total.CommunityPool...).
+		Add(sdk.NewCoin(params.MintDenom, undistributed))
+	for _, r := range total.DeveloperRewards {
+		suite.Require().Equal(mintKeeper.GetDeveloperRewards(suite.ctx, r.Address).Accrued, r.Amount)
+		distributed = distributed.Add(r.Amount...)
+	}
+	suite.Require().Equal(total.Minted, distributed)
+	suite.Require().Len(total.DeveloperRewards, 2)
+
+	genesis := types.NewGenesisState(mintKeeper.GetMinter(suite.ctx), params)
+	genesis.DistributionRecords = records
+	suite.Require().NoError(types.ValidateGenesis(genesis))
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+// GetDistributionRemainder returns the fractions truncated from the shares of
+// the minted coins so far.
+func (k Keeper) GetDistributionRemainder(ctx sdk.Context) types.DistributionRemainder {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DistributionRemainderKey)
+	if bz == nil {
+		return types.InitialDistributionRemainder()
+	}
+
+	var remainder types.DistributionRemainder
+	k.cdc.MustUnmarshal(bz, &remainder)
+	return remainder
+}
+
+func (k Keeper) SetDistributionRemainder(ctx sdk.Context, remainder types.DistributionRemainder) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&remainder)
+	store.Set(types.DistributionRemainderKey, bz)
+}
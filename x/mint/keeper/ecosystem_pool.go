@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+// GetEcosystemPool returns the balance of the ecosystem incentives pool.
+func (k Keeper) GetEcosystemPool(ctx sdk.Context) sdk.Coins {
+	return k.bk.GetAllBalances(ctx, k.ak.GetModuleAddress(types.EcosystemPoolName))
+}
+
+// FundEcosystemPool sends coins from the mint module account to the ecosystem
+// incentives pool.
+func (k Keeper) FundEcosystemPool(ctx sdk.Context, amount sdk.Coins) error {
+	if amount.Empty() {
+		return nil
+	}
+	return k.bk.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.EcosystemPoolName, amount)
+}
+
+// HandleEcosystemPoolSpendProposal is a handler for executing a passed
+// ecosystem pool spend proposal. Blocked recipients are rejected by the bank
+// keeper.
+func (k Keeper) HandleEcosystemPoolSpendProposal(ctx sdk.Context, p *types.EcosystemPoolSpendProposal) error {
+	recipient, err := sdk.AccAddressFromBech32(p.Recipient)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.EcosystemPoolName, recipient, p.Amount); err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("transferred from the ecosystem pool to recipient", "amount", p.Amount.String(), "recipient", p.Recipient)
+
+	return nil
+}
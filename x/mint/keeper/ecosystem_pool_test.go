@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestEcosystemPoolSpendProposal() {
+	mintKeeper := suite.app.MintKeeper
+	bankKeeper := suite.app.BankKeeper
+	params := mintKeeper.GetParams(suite.ctx)
+
+	mintedCoin := sdk.NewInt64Coin(params.MintDenom, 100_000)
+	suite.Require().NoError(mintKeeper.MintCoins(suite.ctx, sdk.NewCoins(mintedCoin)))
+	mintKeeper.DistributeMintedCoin(suite.ctx, mintedCoin)
+
+	pool := mintKeeper.GetEcosystemPool(suite.ctx)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 50_000)), pool)
+
+	res, err := mintKeeper.EcosystemPool(sdk.WrapSDKContext(suite.ctx), &types.QueryEcosystemPoolRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(pool, res.Pool)
+
+	recipient := sdk.AccAddress([]byte("recipient---"))
+	blocked := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	spend := sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 20_000))
+
+	tests := []struct {
+		name      string
+		recipient sdk.AccAddress
+		amount    sdk.Coins
+		expectErr bool
+	}{
+		{"blocked recipient", blocked, spend, true},
+		{"exceeding the pool", recipient, pool.Add(spend...), true},
+		{"valid spend", recipient, spend, false},
+	}
+
+	for _, tc := range tests {
+		proposal := types.NewEcosystemPoolSpendProposal("title", "description", tc.recipient, tc.amount)
+		err := mintKeeper.HandleEcosystemPoolSpendProposal(suite.ctx, proposal)
+		if tc.expectErr {
+			suite.Require().Error(err, tc.name)
+			continue
+		}
+		suite.Require().NoError(err, tc.name)
+	}
+
+	suite.Require().Equal(spend, bankKeeper.GetAllBalances(suite.ctx, recipient))
+	suite.Require().Equal(pool.Sub(spend), mintKeeper.GetEcosystemPool(suite.ctx))
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+// GetEpoch returns the minting epoch in progress.
+func (k Keeper) GetEpoch(ctx sdk.Context) types.Epoch {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EpochKey)
+	if bz == nil {
+		return types.InitialEpoch()
+	}
+
+	var epoch types.Epoch
+	k.cdc.MustUnmarshal(bz, &epoch)
+	return epoch
+}
+
+func (k Keeper) SetEpoch(ctx sdk.Context, epoch types.Epoch) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&epoch)
+	store.Set(types.EpochKey, bz)
+}
+
+// EmissionSupply returns the total supply of the mint denom including the
+// provisions accumulated in the epoch and not minted yet. Inflation applies to
+// this supply so that epochs emit the same as minting every block.
+func (k Keeper) EmissionSupply(ctx sdk.Context, denom string) sdk.Int {
+	return k.TokenSupply(ctx, denom).Add(k.GetEpoch(ctx).Provision)
+}
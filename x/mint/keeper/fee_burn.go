@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+// GetFeeBurn returns the cumulative fees burned.
+func (k Keeper) GetFeeBurn(ctx sdk.Context) types.FeeBurn {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FeeBurnKey)
+	if bz == nil {
+		return types.FeeBurn{}
+	}
+
+	var feeBurn types.FeeBurn
+	k.cdc.MustUnmarshal(bz, &feeBurn)
+	return feeBurn
+}
+
+func (k Keeper) SetFeeBurn(ctx sdk.Context, feeBurn types.FeeBurn) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&feeBurn)
+	store.Set(types.FeeBurnKey, bz)
+}
+
+// BurnFees burns the fee burn ratio of the mint denom fees collected in the
+// fee collector, before the distribution module allocates them. Fees of other
+// denoms are left to the distribution.
+func (k Keeper) BurnFees(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	if !params.FeeBurnRatio.IsPositive() {
+		return nil
+	}
+
+	fees := k.bk.GetBalance(ctx, k.ak.GetModuleAddress(k.feeCollectorName), params.MintDenom)
+	burned := sdk.NewCoins(k.GetProportions(ctx, fees, params.FeeBurnRatio))
+	if !burned.IsZero() {
+		err := k.bk.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, burned)
+		if err != nil {
+			return err
+		}
+		err = k.bk.BurnCoins(ctx, types.ModuleName, burned)
+		if err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBurnFees,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, burned.String()),
+			),
+		)
+	}
+
+	k.SetFeeBurn(ctx, k.GetFeeBurn(ctx).Record(burned))
+	return nil
+}
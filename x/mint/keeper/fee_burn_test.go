@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestBurnFees() {
+	mintKeeper := suite.app.MintKeeper
+	bankKeeper := suite.app.BankKeeper
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	params := mintKeeper.GetParams(suite.ctx)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1_000), sdk.NewInt64Coin("ibc/fee", 1_000))
+	suite.Require().NoError(bankKeeper.MintCoins(suite.ctx, types.ModuleName, fees))
+	suite.Require().NoError(bankKeeper.SendCoinsFromModuleToModule(suite.ctx, types.ModuleName, authtypes.FeeCollectorName, fees))
+
+	// nothing is burned by default
+	suite.Require().NoError(mintKeeper.BurnFees(suite.ctx))
+	suite.Require().Equal(fees, bankKeeper.GetAllBalances(suite.ctx, feeCollector))
+	suite.Require().Equal(types.FeeBurn{}, mintKeeper.GetFeeBurn(suite.ctx))
+
+	params.FeeBurnRatio = sdk.NewDecWithPrec(25, 2)
+	mintKeeper.SetParams(suite.ctx, params)
+	supply := mintKeeper.TokenSupply(suite.ctx, params.MintDenom)
+
+	// only the mint denom is burned
+	suite.Require().NoError(mintKeeper.BurnFees(suite.ctx))
+	burned := sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 250))
+	suite.Require().Equal(fees.Sub(burned), bankKeeper.GetAllBalances(suite.ctx, feeCollector))
+	suite.Require().Equal(supply.SubRaw(250), mintKeeper.TokenSupply(suite.ctx, params.MintDenom))
+
+	suite.Require().NoError(mintKeeper.BurnFees(suite.ctx))
+	feeBurn := mintKeeper.GetFeeBurn(suite.ctx)
+	suite.Require().Equal(uint64(2), feeBurn.Blocks)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 250+187)), feeBurn.Burned)
+
+	res, err := mintKeeper.FeeBurn(sdk.WrapSDKContext(suite.ctx), &types.QueryFeeBurnRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(feeBurn, res.FeeBurn)
+	suite.Require().Equal(params.FeeBurnRatio, res.FeeBurnRatio)
+}
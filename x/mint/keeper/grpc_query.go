@@ -2,8 +2,13 @@ package keeper
 
 import (
 	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/galaxynetwork/galaxy/x/mint/types"
 )
 
@@ -20,3 +25,231 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+func (k Keeper) Inflation(c context.Context, _ *types.QueryInflationRequest) (*types.QueryInflationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := k.GetMinter(ctx)
+
+	return &types.QueryInflationResponse{Inflation: minter.Inflation}, nil
+}
+
+func (k Keeper) AnnualProvisions(c context.Context, _ *types.QueryAnnualProvisionsRequest) (*types.QueryAnnualProvisionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := k.GetMinter(ctx)
+
+	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
+}
+
+func (k Keeper) Phase(c context.Context, _ *types.QueryPhaseRequest) (*types.QueryPhaseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := k.GetMinter(ctx)
+	params := k.GetParams(ctx)
+
+	// the first phase starts with the first block
+	phase := minter.Phase
+	if phase == 0 {
+		phase = 1
+	}
+
+	res := &types.QueryPhaseResponse{Phase: phase, StartTime: minter.PhaseStartTime}
+	if params.PhaseMode == types.PhaseByTime {
+		endTime := minter.PhaseStartTime.Add(params.PhaseDuration)
+		res.EndTime = &endTime
+	} else {
+		res.StartHeight, res.EndHeight = minter.PhaseHeights(params, phase)
+	}
+
+	return res, nil
+}
+
+func (k Keeper) BlockProvision(c context.Context, _ *types.QueryBlockProvisionRequest) (*types.QueryBlockProvisionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := k.GetMinter(ctx)
+	params := k.GetParams(ctx)
+
+	provision := sdk.NewCoin(params.MintDenom, sdk.ZeroInt())
+	if !minter.Inflation.IsZero() {
+		totalSupply := k.EmissionSupply(ctx, params.MintDenom)
+		// the next block is estimated at blocks per year blocks a phase when
+		// phases advance by block time
+		blockTime := ctx.BlockTime()
+		if params.PhaseMode == types.PhaseByTime {
+			blockTime = minter.LastBlockTime.Add(params.PhaseDuration / time.Duration(params.BlocksPerYear))
+		}
+
+		var exact sdk.Dec
+		minter, exact = k.NextBlockProvision(ctx, params, minter, totalSupply, blockTime)
+		amount, _ := minter.CarryProvision(exact)
+		provision, _ = params.CapProvision(sdk.NewCoin(params.MintDenom, amount), totalSupply)
+	}
+
+	distribution, _ := k.GetDistributionRemainder(ctx).Distribute(provision, params)
+	distribution.Phase = minter.Phase
+
+	return &types.QueryBlockProvisionResponse{BlockProvision: provision, Distribution: distribution}, nil
+}
+
+func (k Keeper) BlocksRemaining(c context.Context, _ *types.QueryBlocksRemainingRequest) (*types.QueryBlocksRemainingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := k.GetMinter(ctx)
+	params := k.GetParams(ctx)
+
+	res := &types.QueryBlocksRemainingResponse{}
+	if params.PhaseMode == types.PhaseByTime {
+		remaining := minter.PhaseStartTime.Add(params.PhaseDuration).Sub(ctx.BlockTime())
+		if remaining < 0 {
+			remaining = 0
+		}
+		res.TimeRemaining = &remaining
+		return res, nil
+	}
+
+	phase := minter.Phase
+	if phase == 0 {
+		phase = 1
+	}
+	_, endHeight := minter.PhaseHeights(params, phase)
+	if remaining := endHeight - ctx.BlockHeight(); remaining > 0 {
+		res.BlocksRemaining = remaining
+	}
+
+	return res, nil
+}
+
+func (k Keeper) Projection(c context.Context, _ *types.QueryProjectionRequest) (*types.QueryProjectionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := k.GetMinter(ctx)
+	params := k.GetParams(ctx)
+	totalSupply := k.EmissionSupply(ctx, params.MintDenom)
+
+	burnPerBlock := k.GetFeeBurn(ctx).BurnPerBlock(params.MintDenom)
+
+	projections := minter.ProjectEmissions(params, totalSupply, ctx.BlockHeight(), ctx.BlockTime(), burnPerBlock)
+
+	return &types.QueryProjectionResponse{Projections: projections}, nil
+}
+
+func (k Keeper) DeveloperRewards(c context.Context, req *types.QueryDeveloperRewardsRequest) (*types.QueryDeveloperRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rewards := k.GetVestedDeveloperRewards(ctx, req.Address)
+
+	return &types.QueryDeveloperRewardsResponse{
+		DeveloperRewards: rewards,
+		Withdrawable:     rewards.Withdrawable(),
+	}, nil
+}
+
+func (k Keeper) AllDeveloperRewards(c context.Context, req *types.QueryAllDeveloperRewardsRequest) (*types.QueryAllDeveloperRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var rewards []types.DeveloperRewards
+	pageRes, err := query.Paginate(k.developerRewardsStore(ctx), req.Pagination, func(_ []byte, value []byte) error {
+		var r types.DeveloperRewards
+		if err := k.cdc.Unmarshal(value, &r); err != nil {
+			return err
+		}
+		rewards = append(rewards, r)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllDeveloperRewardsResponse{DeveloperRewards: rewards, Pagination: pageRes}, nil
+}
+
+func (k Keeper) DeveloperVesting(c context.Context, req *types.QueryDeveloperVestingRequest) (*types.QueryDeveloperVestingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	vesting, _ := k.GetParams(ctx).GetDeveloperVesting(req.Address)
+	rewards := k.GetVestedDeveloperRewards(ctx, req.Address)
+
+	return &types.QueryDeveloperVestingResponse{
+		Vesting: vesting,
+		Vested:  rewards.Vested(),
+		Locked:  rewards.Locked,
+	}, nil
+}
+
+func (k Keeper) DistributionTotals(c context.Context, _ *types.QueryDistributionTotalsRequest) (*types.QueryDistributionTotalsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDistributionTotalsResponse{
+		Total:  k.GetDistributionTotals(ctx),
+		Phases: k.GetAllDistributionRecords(ctx),
+	}, nil
+}
+
+func (k Keeper) DistributionRecord(c context.Context, req *types.QueryDistributionRecordRequest) (*types.QueryDistributionRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Phase == 0 {
+		return nil, status.Error(codes.InvalidArgument, "phase must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	record := k.GetDistributionRecord(ctx, req.Phase)
+
+	return &types.QueryDistributionRecordResponse{DistributionRecord: record}, nil
+}
+
+func (k Keeper) Epoch(c context.Context, _ *types.QueryEpochRequest) (*types.QueryEpochResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	epoch := k.GetEpoch(ctx)
+	params := k.GetParams(ctx)
+
+	res := &types.QueryEpochResponse{Epoch: epoch}
+	if params.EpochDuration > 0 {
+		endTime := epoch.StartTime.Add(params.EpochDuration)
+		res.EndTime = &endTime
+	} else {
+		// every block is an epoch when epoch blocks is zero
+		blocks := int64(params.EpochBlocks)
+		if blocks < 1 {
+			blocks = 1
+		}
+		res.EndHeight = epoch.StartHeight + blocks - 1
+	}
+
+	return res, nil
+}
+
+func (k Keeper) EcosystemPool(c context.Context, _ *types.QueryEcosystemPoolRequest) (*types.QueryEcosystemPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryEcosystemPoolResponse{Pool: k.GetEcosystemPool(ctx)}, nil
+}
+
+func (k Keeper) Pause(c context.Context, _ *types.QueryPauseRequest) (*types.QueryPauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryPauseResponse{
+		Pause:       k.GetMintPause(ctx),
+		PausePolicy: k.GetParams(ctx).PausePolicy,
+	}, nil
+}
+
+func (k Keeper) FeeBurn(c context.Context, _ *types.QueryFeeBurnRequest) (*types.QueryFeeBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryFeeBurnResponse{
+		FeeBurn:      k.GetFeeBurn(ctx),
+		FeeBurnRatio: k.GetParams(ctx).FeeBurnRatio,
+	}, nil
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/galaxynetwork/galaxy/x/mint"
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestGRPCPhaseQueries() {
+	mintKeeper := suite.app.MintKeeper
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, mintKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	params := mintKeeper.GetParams(suite.ctx)
+	params.BlocksPerYear = 100
+	mintKeeper.SetParams(suite.ctx, params)
+
+	minter := types.NewMinter(sdk.NewDecWithPrec(10, 2), sdk.NewDec(1_000_000_050), 3)
+	minter.PhaseStartTime = suite.ctx.BlockTime()
+	mintKeeper.SetMinter(suite.ctx, minter)
+	ctx := suite.ctx.WithBlockHeight(240)
+	queryHelper.Ctx = ctx
+
+	inflation, err := queryClient.Inflation(gocontext.Background(), &types.QueryInflationRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(minter.Inflation, inflation.Inflation)
+
+	annualProvisions, err := queryClient.AnnualProvisions(gocontext.Background(), &types.QueryAnnualProvisionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(minter.AnnualProvisions, annualProvisions.AnnualProvisions)
+
+	phase, err := queryClient.Phase(gocontext.Background(), &types.QueryPhaseRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), phase.Phase)
+	suite.Require().Equal(int64(201), phase.StartHeight)
+	suite.Require().Equal(int64(300), phase.EndHeight)
+	suite.Require().Nil(phase.EndTime)
+
+	remaining, err := queryClient.BlocksRemaining(gocontext.Background(), &types.QueryBlocksRemainingRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(60), remaining.BlocksRemaining)
+
+	// 10000000.5 a block without developer rewards receiver
+	provision, err := queryClient.BlockProvision(gocontext.Background(), &types.QueryBlockProvisionRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(params.MintDenom, 10_000_000), provision.BlockProvision)
+	distribution := provision.Distribution
+	suite.Require().Equal(uint64(3), distribution.Phase)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 2_000_000)), distribution.Staking)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 5_000_000)), distribution.EcosystemIncentives)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 3_000_000)), distribution.CommunityPool)
+	suite.Require().Empty(distribution.DeveloperRewards)
+
+	// the provision is the one of the next block, here following the bonded
+	// ratio
+	mintNextBlock := func(ctx sdk.Context) sdk.Coin {
+		supply := mintKeeper.TokenSupply(ctx, params.MintDenom)
+		mint.BeginBlocker(ctx, mintKeeper)
+		return sdk.NewCoin(params.MintDenom, mintKeeper.TokenSupply(ctx, params.MintDenom).Sub(supply))
+	}
+	params.InflationMode = types.InflationBondedRatio
+	mintKeeper.SetParams(ctx, params)
+	holder := sdk.AccAddress([]byte("holder--"))
+	suite.Require().NoError(simapp.FundAccount(suite.app.BankKeeper, ctx, holder, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1_000_000_000_000))))
+	provision, err = queryClient.BlockProvision(gocontext.Background(), &types.QueryBlockProvisionRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(provision.BlockProvision.IsPositive())
+	suite.Require().NotEqual(sdk.NewInt64Coin(params.MintDenom, 10_000_000), provision.BlockProvision)
+	suite.Require().Equal(mintNextBlock(ctx.WithBlockHeight(241)), provision.BlockProvision)
+	minter = mintKeeper.GetMinter(ctx)
+
+	// phases advancing by block time
+	params.PhaseMode = types.PhaseByTime
+	mintKeeper.SetParams(ctx, params)
+	queryHelper.Ctx = ctx.WithBlockTime(minter.PhaseStartTime.Add(time.Hour))
+
+	phase, err = queryClient.Phase(gocontext.Background(), &types.QueryPhaseRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Zero(phase.StartHeight)
+	suite.Require().Equal(minter.PhaseStartTime.Add(params.PhaseDuration), *phase.EndTime)
+
+	remaining, err = queryClient.BlocksRemaining(gocontext.Background(), &types.QueryBlocksRemainingRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params.PhaseDuration-time.Hour, *remaining.TimeRemaining)
+
+	// the provision by time is estimated at blocks per year blocks a phase
+	provision, err = queryClient.BlockProvision(gocontext.Background(), &types.QueryBlockProvisionRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(provision.BlockProvision.IsPositive())
+	nextBlockTime := minter.LastBlockTime.Add(params.PhaseDuration / time.Duration(params.BlocksPerYear))
+	suite.Require().Equal(mintNextBlock(ctx.WithBlockHeight(242).WithBlockTime(nextBlockTime)), provision.BlockProvision)
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+// Implements MintHooks interface
+var _ types.MintHooks = Keeper{}
+
+// AfterBlockMint - call hook if registered, fires once per epoch
+func (k Keeper) AfterBlockMint(ctx sdk.Context, minted sdk.Coin) {
+	if k.hooks != nil {
+		k.hooks.AfterBlockMint(ctx, minted)
+	}
+}
+
+// AfterPhaseChange - call hook if registered
+func (k Keeper) AfterPhaseChange(ctx sdk.Context, oldPhase, newPhase uint64) {
+	if k.hooks != nil {
+		k.hooks.AfterPhaseChange(ctx, oldPhase, newPhase)
+	}
+}
+
+// AfterInflationEnd - call hook if registered
+func (k Keeper) AfterInflationEnd(ctx sdk.Context) {
+	if k.hooks != nil {
+		k.hooks.AfterInflationEnd(ctx)
+	}
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+// RegisterInvariants registers all mint invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "minter-phase", MinterPhaseInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "distribution-proportions", DistributionProportionsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "developer-rewards-weights", DeveloperRewardsWeightsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the mint module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ModuleBalanceInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = MinterPhaseInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = DistributionProportionsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return DeveloperRewardsWeightsInvariant(k)(ctx)
+	}
+}
+
+// ModuleBalanceInvariant checks that the mint module account distributed all
+// minted tokens while inflation is active. Only escrowed developer rewards and
+// the units held back by the distribution remainders may be left in the module
+// account.
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		minter := k.GetMinter(ctx)
+		if minter.Inflation.IsZero() {
+			return sdk.FormatInvariant(types.ModuleName, "module-balance", "inflation ended"), false
+		}
+
+		balance := k.ModuleBalance(ctx)
+		escrowed := sdk.NewCoin(balance.Denom, k.GetEscrowedDeveloperRewards(ctx).AmountOf(balance.Denom))
+		undistributed := sdk.NewCoin(balance.Denom, k.GetDistributionRemainder(ctx).Undistributed)
+		broken := !balance.IsEqual(escrowed.Add(undistributed))
+
+		return sdk.FormatInvariant(types.ModuleName, "module-balance",
+			fmt.Sprintf("\tmint module account balance: %s\n\texpected escrowed developer rewards: %s\n\texpected undistributed: %s\n",
+				balance, escrowed, undistributed),
+		), broken
+	}
+}
+
+// MinterPhaseInvariant checks that the stored minter phase matches the phase
+// derived from the last block.
+func MinterPhaseInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		minter := k.GetMinter(ctx)
+
+		// nothing minted yet or inflation ended, the phase is no longer updated
+		if minter.Phase == 0 || minter.Inflation.IsZero() {
+			return sdk.FormatInvariant(types.ModuleName, "minter-phase", "phase not tracked"), false
+		}
+		// the phase of a chain started from an export is updated by its first
+		// block, the invariants asserted at genesis run before it
+		if ctx.BlockHeight() == 0 {
+			return sdk.FormatInvariant(types.ModuleName, "minter-phase", "no block minted"), false
+		}
+		// the phase is updated by the first block minted after the pause
+		if k.GetMintPause(ctx).Paused {
+			return sdk.FormatInvariant(types.ModuleName, "minter-phase", "minting paused"), false
+		}
+
+		params := k.GetParams(ctx)
+		expected := uint64(minter.CurrentPhase(params, ctx.BlockHeight()))
+		if params.PhaseMode == types.PhaseByTime {
+			expected, _ = minter.CurrentPhaseByTime(params, ctx.BlockTime())
+		}
+		broken := minter.Phase != expected
+
+		return sdk.FormatInvariant(types.ModuleName, "minter-phase",
+			fmt.Sprintf("\tminter phase: %d\n\texpected phase at height %d: %d\n", minter.Phase, ctx.BlockHeight(), expected),
+		), broken
+	}
+}
+
+// DistributionProportionsInvariant checks that the distribution proportions
+// sum to one.
+func DistributionProportionsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		proportions := k.GetParams(ctx).DistributionProportions
+		sum := proportions.Staking.Add(proportions.EcosystemIncentives).
+			Add(proportions.DeveloperRewards).Add(proportions.CommunityPool)
+		broken := !sum.Equal(sdk.OneDec())
+
+		return sdk.FormatInvariant(types.ModuleName, "distribution-proportions",
+			fmt.Sprintf("\tsum of distribution proportions: %s\n\texpected sum: %s\n", sum, sdk.OneDec()),
+		), broken
+	}
+}
+
+// DeveloperRewardsWeightsInvariant checks that the developer rewards receiver
+// weights sum to one.
+func DeveloperRewardsWeightsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		receivers := k.GetParams(ctx).WeightedDeveloperRewardsReceivers
+
+		// developer rewards are funded to the community pool when there is no receiver
+		if len(receivers) == 0 {
+			return sdk.FormatInvariant(types.ModuleName, "developer-rewards-weights", "no receivers"), false
+		}
+
+		sum := sdk.ZeroDec()
+		for _, receiver := range receivers {
+			sum = sum.Add(receiver.Weight)
+		}
+		broken := !sum.Equal(sdk.OneDec())
+
+		return sdk.FormatInvariant(types.ModuleName, "developer-rewards-weights",
+			fmt.Sprintf("\tsum of %d developer receiver weights: %s\n\texpected sum: %s\n", len(receivers), sum, sdk.OneDec()),
+		), broken
+	}
+}
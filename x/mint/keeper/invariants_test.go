@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/galaxynetwork/galaxy/x/mint"
+	"github.com/galaxynetwork/galaxy/x/mint/keeper"
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	require := suite.Require()
+	mintKeeper := suite.app.MintKeeper
+
+	mint.BeginBlocker(suite.ctx, mintKeeper)
+
+	msg, broken := keeper.AllInvariants(mintKeeper)(suite.ctx)
+	require.False(broken, msg)
+
+	// leftover minted tokens in the module account
+	err := simapp.FundModuleAccount(suite.app.BankKeeper, suite.ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin("uglx", sdk.NewInt(10))))
+	require.NoError(err)
+	msg, broken = keeper.ModuleBalanceInvariant(mintKeeper)(suite.ctx)
+	require.True(broken)
+	require.Contains(msg, "mint module account balance: 10uglx")
+
+	// stored phase differs from the phase of the last height
+	minter := mintKeeper.GetMinter(suite.ctx)
+	minter.Phase = 5
+	mintKeeper.SetMinter(suite.ctx, minter)
+	msg, broken = keeper.MinterPhaseInvariant(mintKeeper)(suite.ctx)
+	require.True(broken)
+	require.Contains(msg, "minter phase: 5")
+
+	// a chain started from an export at height zero has not updated the phase yet
+	msg, broken = keeper.MinterPhaseInvariant(mintKeeper)(suite.ctx.WithBlockHeight(0))
+	require.False(broken, msg)
+
+	// a pause spanning a phase boundary leaves the phase behind until minting
+	// resumes
+	require.NoError(mintKeeper.SetPaused(suite.ctx, true))
+	msg, broken = keeper.MinterPhaseInvariant(mintKeeper)(suite.ctx)
+	require.False(broken, msg)
+	require.NoError(mintKeeper.SetPaused(suite.ctx, false))
+	mint.BeginBlocker(suite.ctx, mintKeeper)
+	msg, broken = keeper.MinterPhaseInvariant(mintKeeper)(suite.ctx)
+	require.False(broken, msg)
+	minter = mintKeeper.GetMinter(suite.ctx)
+
+	// invariants are not checked once inflation ended
+	minter.Inflation = sdk.ZeroDec()
+	mintKeeper.SetMinter(suite.ctx, minter)
+	msg, broken = keeper.AllInvariants(mintKeeper)(suite.ctx)
+	require.False(broken, msg)
+}
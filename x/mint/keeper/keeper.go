@@ -19,8 +19,16 @@ type Keeper struct {
 	ak types.AccountKeeper
 	bk types.BankKeeper
 	dk types.DistributionKeeper
+	sk types.StakingKeeper
+
+	hooks types.MintHooks
 
 	feeCollectorName string
+
+	// the address capable of executing a MsgUpdateParams message, typically
+	// the gov module account. Under gov v1beta1 the gov module account signs
+	// no Msg, and the same changes go through the mint proposals.
+	authority string
 }
 
 func NewKeeper(
@@ -30,13 +38,17 @@ func NewKeeper(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
 	dk types.DistributionKeeper,
+	sk types.StakingKeeper,
 	feeCollectorName string,
-
+	authority string,
 ) Keeper {
 
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
 		panic("the mint module account has not been set")
 	}
+	if addr := ak.GetModuleAddress(types.EcosystemPoolName); addr == nil {
+		panic("the ecosystem pool module account has not been set")
+	}
 
 	if !ps.HasKeyTable() {
 		ps = ps.WithKeyTable(types.ParamKeyTable())
@@ -49,14 +61,33 @@ func NewKeeper(
 		ak:               ak,
 		bk:               bk,
 		dk:               dk,
+		sk:               sk,
 		feeCollectorName: feeCollectorName,
+		authority:        authority,
 	}
 }
 
+// SetHooks sets the mint hooks. The keeper is returned by pointer so that the
+// hooks are set before the keeper is copied into other modules.
+func (k *Keeper) SetHooks(mh types.MintHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set mint hooks twice")
+	}
+
+	k.hooks = mh
+
+	return k
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// GetAuthority returns the address allowed to update the module parameters.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k Keeper) GetDeveloperAddress(ctx sdk.Context) []string {
 	params := k.GetParams(ctx)
 
@@ -84,6 +115,20 @@ func (k Keeper) ModuleBalance(ctx sdk.Context) sdk.Coin {
 	)
 }
 
+// LeftoverBalance returns the balance of the mint module account that is not
+// escrowed for developer rewards receivers, including the units held back by
+// the distribution remainders.
+func (k Keeper) LeftoverBalance(ctx sdk.Context) sdk.Coin {
+	balance := k.ModuleBalance(ctx)
+	escrowed := k.GetEscrowedDeveloperRewards(ctx).AmountOf(balance.Denom)
+	return sdk.NewCoin(balance.Denom, sdk.MaxInt(balance.Amount.Sub(escrowed), sdk.ZeroInt()))
+}
+
+// BondedRatio returns the ratio of the staking token supply that is bonded.
+func (k Keeper) BondedRatio(ctx sdk.Context) sdk.Dec {
+	return k.sk.BondedRatio(ctx)
+}
+
 func (k Keeper) TokenSupply(ctx sdk.Context, denom string) sdk.Int {
 	return k.bk.GetSupply(ctx, denom).Amount
 }
@@ -108,64 +153,81 @@ func (k Keeper) GetProportions(ctx sdk.Context, mintedCoin sdk.Coin, ratio sdk.D
 	return sdk.NewCoin(mintedCoin.Denom, mintedCoin.Amount.ToDec().Mul(ratio).TruncateInt())
 }
 
-func (k Keeper) DistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) error {
+// DistributeMintedCoin sends the minted coin to the destinations of the
+// distribution proportions. Ecosystem incentives go to the ecosystem pool, apart
+// from the community pool. Developer rewards are escrowed in the module
+// account until withdrawn, as are the units held back by the remainders.
+//
+// A destination failing to receive its share does not fail the distribution:
+// the share is redirected to the community pool and the failure is recorded.
+// Shares the community pool fails to receive are held back in the module
+// account, and funded to the community pool once inflation ends.
+func (k Keeper) DistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) {
 	params := k.GetParams(ctx)
-	proportions := params.DistributionProportions
+	distribution, remainder := k.GetDistributionRemainder(ctx).Distribute(mintedCoin, params)
 
-	stakingIncentives := sdk.NewCoins(k.GetProportions(ctx, mintedCoin, proportions.Staking))
-	err := k.bk.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, stakingIncentives)
-	if err != nil {
-		return err
+	failed := sdk.NewCoins()
+	distribute := func(destination, receiver string, amount sdk.Coins, send func(ctx sdk.Context) error) bool {
+		if amount.IsZero() {
+			return true
+		}
+		if err := k.safeDistribute(ctx, send); err != nil {
+			k.recordDistributionFailure(ctx, destination, receiver, amount, err)
+			failed = failed.Add(amount...)
+			return false
+		}
+		return true
 	}
 
-	//fund to community pool before develop
-	ecosystemIncentivesCoins := sdk.NewCoins(k.GetProportions(ctx, mintedCoin, proportions.EcosystemIncentives))
-	err = k.dk.FundCommunityPool(ctx, ecosystemIncentivesCoins, k.ak.GetModuleAddress(types.ModuleName))
+	if !distribute(types.DestinationStaking, "", distribution.Staking, func(ctx sdk.Context) error {
+		return k.bk.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, distribution.Staking)
+	}) {
+		distribution.Staking = sdk.NewCoins()
+	}
 
-	if err != nil {
-		return err
+	if !distribute(types.DestinationEcosystemIncentives, "", distribution.EcosystemIncentives, func(ctx sdk.Context) error {
+		return k.FundEcosystemPool(ctx, distribution.EcosystemIncentives)
+	}) {
+		distribution.EcosystemIncentives = sdk.NewCoins()
 	}
 
-	developerRewards := k.GetProportions(ctx, mintedCoin, proportions.DeveloperRewards)
-	developerRewardsCoins := sdk.NewCoins(developerRewards)
-	developerReceivers := params.WeightedDeveloperRewardsReceivers
-	//if dev is none fund to communiy
-	if len(developerReceivers) == 0 {
-		developerRewardsCoins := sdk.NewCoins(developerRewards)
-		err = k.dk.FundCommunityPool(ctx, developerRewardsCoins, k.ak.GetModuleAddress(types.ModuleName))
-		if err != nil {
-			return err
-		}
-	} else {
-		for _, weightedReceiver := range developerReceivers {
-			devProportions := k.GetProportions(ctx, developerRewards, weightedReceiver.Weight)
-			developerRewardProPortion := sdk.NewCoins(devProportions)
-			if weightedReceiver.Address == "" {
-				err = k.dk.FundCommunityPool(ctx, developerRewardProPortion,
-					k.ak.GetModuleAddress(types.ModuleName))
-				if err != nil {
-					return err
-				}
-			} else {
-				address, err := sdk.AccAddressFromBech32(weightedReceiver.Address)
-				if err != nil {
-					return err
-				}
-				err = k.bk.SendCoinsFromModuleToAccount(
-					ctx, types.ModuleName, address, developerRewardProPortion)
-				if err != nil {
-					return err
-				}
+	developerRewards := distribution.DeveloperRewards
+	distribution.DeveloperRewards = []types.ReceiverDistribution{}
+	for _, receiver := range developerRewards {
+		receiver := receiver
+		if distribute(types.DestinationDeveloperRewards, receiver.Address, receiver.Amount, func(ctx sdk.Context) error {
+			if _, err := sdk.AccAddressFromBech32(receiver.Address); err != nil {
+				return err
 			}
+			k.AccrueDeveloperRewards(ctx, receiver.Address, receiver.Amount)
+			return nil
+		}) {
+			distribution = distribution.AddDeveloperRewards(receiver.Address, receiver.Amount)
 		}
 	}
 
-	communityPool := sdk.NewCoins(mintedCoin).Sub(stakingIncentives).Sub(ecosystemIncentivesCoins).Sub(developerRewardsCoins)
-	err = k.dk.FundCommunityPool(ctx, communityPool, k.ak.GetModuleAddress(types.ModuleName))
+	communityPool := distribution.CommunityPool.Add(failed...)
+	if distribute(types.DestinationCommunityPool, "", communityPool, func(ctx sdk.Context) error {
+		return k.dk.FundCommunityPool(ctx, communityPool, k.ak.GetModuleAddress(types.ModuleName))
+	}) {
+		distribution.CommunityPool = communityPool
+	} else {
+		distribution.CommunityPool = sdk.NewCoins()
+		remainder.Undistributed = remainder.Undistributed.Add(communityPool.AmountOf(mintedCoin.Denom))
+	}
 
-	if err != nil {
+	k.SetDistributionRemainder(ctx, remainder)
+	k.RecordDistribution(ctx, k.GetMinter(ctx).Phase, distribution)
+}
+
+// safeDistribute runs send in a cached context, written along with its events
+// only when it succeeds.
+func (k Keeper) safeDistribute(ctx sdk.Context, send func(ctx sdk.Context) error) error {
+	cacheCtx, write := ctx.CacheContext()
+	if err := send(cacheCtx); err != nil {
 		return err
 	}
-
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
 	return nil
 }
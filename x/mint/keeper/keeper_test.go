@@ -107,9 +107,7 @@ func (suite *KeeperTestSuite) TestDistrAssetToCommunityPoolBeforeDevelopModuleWh
 
 	mintKeeper.MintCoins(suite.ctx, sdk.NewCoins(mintCoin))
 
-	err := mintKeeper.DistributeMintedCoin(suite.ctx, mintCoin)
-
-	require.NoError(err)
+	mintKeeper.DistributeMintedCoin(suite.ctx, mintCoin)
 
 	communityCoins := distrKeeper.GetFeePoolCommunityCoins(suite.ctx)
 
@@ -118,11 +116,14 @@ func (suite *KeeperTestSuite) TestDistrAssetToCommunityPoolBeforeDevelopModuleWh
 		mintCoin.Amount.ToDec().Mul(params.DistributionProportions.Staking).String(),
 	)
 
+	require.Equal(
+		mintKeeper.GetEcosystemPool(suite.ctx).AmountOf("uglx").ToDec().String(),
+		mintCoin.Amount.ToDec().Mul(params.DistributionProportions.EcosystemIncentives).String(),
+	)
+
 	require.Equal(
 		communityCoins.AmountOf("uglx").String(),
-		mintCoin.Amount.ToDec().Mul(params.DistributionProportions.EcosystemIncentives).Add(
-			mintCoin.Amount.ToDec().Mul(params.DistributionProportions.CommunityPool),
-		).Add(
+		mintCoin.Amount.ToDec().Mul(params.DistributionProportions.CommunityPool).Add(
 			mintCoin.Amount.ToDec().Mul(params.DistributionProportions.DeveloperRewards),
 		).String(),
 	)
@@ -155,9 +156,7 @@ func (suite *KeeperTestSuite) TestDistrAssetToCommunityPoolBeforeDevelopModuleWh
 
 	mintKeeper.MintCoins(suite.ctx, sdk.NewCoins(mintCoin))
 
-	err = mintKeeper.DistributeMintedCoin(suite.ctx, mintCoin)
-
-	require.NoError(err)
+	mintKeeper.DistributeMintedCoin(suite.ctx, mintCoin)
 
 	communityCoins := distrKeeper.GetFeePoolCommunityCoins(suite.ctx)
 
@@ -166,11 +165,14 @@ func (suite *KeeperTestSuite) TestDistrAssetToCommunityPoolBeforeDevelopModuleWh
 		mintCoin.Amount.ToDec().Mul(params.DistributionProportions.Staking).String(),
 	)
 
+	require.Equal(
+		mintKeeper.GetEcosystemPool(suite.ctx).AmountOf("uglx").ToDec().String(),
+		mintCoin.Amount.ToDec().Mul(params.DistributionProportions.EcosystemIncentives).String(),
+	)
+
 	require.Equal(
 		communityCoins.AmountOf("uglx").String(),
-		mintCoin.Amount.ToDec().Mul(params.DistributionProportions.EcosystemIncentives).Add(
-			mintCoin.Amount.ToDec().Mul(params.DistributionProportions.CommunityPool),
-		).String(),
+		mintCoin.Amount.ToDec().Mul(params.DistributionProportions.CommunityPool).String(),
 	)
 }
 
@@ -245,7 +247,7 @@ func (suite *KeeperTestSuite) TestMintInflationThenDistr() {
 		if minter.Inflation.Equal(sdk.ZeroDec()) {
 			if minter.Inflation.Equal(sdk.ZeroDec()) {
 				//if still has ramaning amount  it will be fund to community pool
-				devRemaningCoin = mintKeeper.ModuleBalance(suite.ctx)
+				devRemaningCoin = mintKeeper.LeftoverBalance(suite.ctx)
 				if devRemaningCoin.IsPositive() {
 					err := mintKeeper.FundToCommuinityPool(suite.ctx, sdk.NewCoins(devRemaningCoin))
 					require.NoError(err)
@@ -262,8 +264,7 @@ func (suite *KeeperTestSuite) TestMintInflationThenDistr() {
 		err := mintKeeper.MintCoins(suite.ctx, mintCoins)
 		require.NoError(err)
 
-		err = mintKeeper.DistributeMintedCoin(suite.ctx, mintCoin)
-		require.NoError(err)
+		mintKeeper.DistributeMintedCoin(suite.ctx, mintCoin)
 
 		if mintCoin.Amount.IsInt64() {
 			defer telemetry.ModuleSetGauge(types.ModuleName, float32(mintCoin.Amount.Int64()), "minted_tokens")
@@ -279,6 +280,8 @@ func (suite *KeeperTestSuite) TestMintInflationThenDistr() {
 		)
 	}
 
+	// only the escrowed developer rewards are left in the mint module account
+	escrowed := mintKeeper.GetEscrowedDeveloperRewards(suite.ctx).AmountOf(params.MintDenom)
 	require.Equal(
 		bankKeeper.GetBalance(
 			suite.ctx,
@@ -287,26 +290,17 @@ func (suite *KeeperTestSuite) TestMintInflationThenDistr() {
 			),
 			params.MintDenom,
 		).Amount.ToDec(),
-		sdk.ZeroDec(),
+		escrowed.ToDec(),
 	)
 
 	totalSupply := mintKeeper.TokenSupply(suite.ctx, params.MintDenom)
 	feeCollectorAmount := bankKeeper.GetBalance(suite.ctx, authKeeper.GetModuleAddress(authtypes.FeeCollectorName), params.MintDenom)
 	distributionAmount := bankKeeper.GetBalance(suite.ctx, authKeeper.GetModuleAddress(distrtypes.ModuleName), params.MintDenom)
+	ecosystemAmount := mintKeeper.GetEcosystemPool(suite.ctx).AmountOf(params.MintDenom)
 	suite.T().Log("total supply", totalSupply.String())
 
 	require.Equal(
 		totalSupply.ToDec(),
-		feeCollectorAmount.Add(
-			distributionAmount,
-		).Add(
-			mintKeeper.GetProportions(
-				suite.ctx,
-				sdk.NewCoin(params.MintDenom, totalSupply.Sub(genesisSupply)),
-				params.DistributionProportions.DeveloperRewards,
-			),
-		).Sub(
-			devRemaningCoin,
-		).Amount.ToDec(),
+		feeCollectorAmount.Amount.Add(distributionAmount.Amount).Add(ecosystemAmount).Add(escrowed).ToDec(),
 	)
 }
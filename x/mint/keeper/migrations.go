@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the mint state from consensus version 1 to 2. The
+// params added since version 1 are set to their defaults, the stores added
+// since version 1 are seeded the way InitGenesis seeds them, and the mint
+// module account is allowed to burn the fees it receives.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	k := m.keeper
+
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !k.paramStore.Has(ctx, pair.Key) {
+			k.paramStore.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+	if err := k.GetParams(ctx).Validate(); err != nil {
+		return err
+	}
+
+	minter := k.GetMinter(ctx)
+	if minter.ProvisionRemainder.IsNil() {
+		minter.ProvisionRemainder = sdk.ZeroDec()
+	}
+	if minter.PhaseStartTime.IsZero() {
+		minter.PhaseStartTime = ctx.BlockTime()
+	}
+	if minter.LastBlockTime.IsZero() {
+		minter.LastBlockTime = ctx.BlockTime()
+	}
+	k.SetMinter(ctx, minter)
+
+	// the first epoch starts with the next block
+	epoch := k.GetEpoch(ctx)
+	if epoch.StartTime.IsZero() {
+		epoch.StartTime = ctx.BlockTime()
+	}
+	if epoch.StartHeight == 0 {
+		epoch.StartHeight = ctx.BlockHeight() + 1
+	}
+	k.SetEpoch(ctx, epoch)
+	k.SetDistributionRemainder(ctx, k.GetDistributionRemainder(ctx))
+	k.SetMintPause(ctx, k.GetMintPause(ctx))
+	k.SetFeeBurn(ctx, k.GetFeeBurn(ctx))
+	k.SetDistributionFailures(ctx, k.GetDistributionFailures(ctx))
+
+	macc, ok := k.ak.GetModuleAccount(ctx, types.ModuleName).(*authtypes.ModuleAccount)
+	if ok && !macc.HasPermission(authtypes.Burner) {
+		macc.Permissions = append(macc.Permissions, authtypes.Burner)
+		k.ak.SetModuleAccount(ctx, macc)
+	}
+	k.ak.GetModuleAccount(ctx, types.EcosystemPoolName)
+
+	return nil
+}
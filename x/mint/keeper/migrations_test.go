@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/galaxynetwork/galaxy/x/mint/keeper"
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	app := suite.app
+	mintKeeper := app.MintKeeper
+	require := suite.Require()
+
+	// rewind the state to consensus version 1
+	params := mintKeeper.GetParams(suite.ctx)
+	newKeys := [][]byte{
+		types.KeyInflationSchedule, types.KeyPhaseMode, types.KeyPhaseDuration, types.KeyMaxSupply,
+		types.KeyInflationMode, types.KeyGoalBonded, types.KeyInflationRateChange, types.KeyInflationMin,
+		types.KeyEpochBlocks, types.KeyEpochDuration, types.KeyGuardian, types.KeyPausePolicy,
+		types.KeyFeeBurnRatio,
+	}
+	paramStore := prefix.NewStore(suite.ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	for _, key := range newKeys {
+		paramStore.Delete(key)
+	}
+	mintStore := suite.ctx.KVStore(app.GetKey(types.StoreKey))
+	for _, key := range [][]byte{types.EpochKey, types.DistributionRemainderKey, types.PauseKey, types.FeeBurnKey, types.DistributionFailuresKey} {
+		mintStore.Delete(key)
+	}
+	minter := mintKeeper.GetMinter(suite.ctx)
+	minter.PhaseStartTime = time.Time{}
+	minter.LastBlockTime = time.Time{}
+	mintKeeper.SetMinter(suite.ctx, minter)
+
+	macc := app.AccountKeeper.GetModuleAccount(suite.ctx, types.ModuleName).(*authtypes.ModuleAccount)
+	macc.Permissions = []string{authtypes.Minter}
+	app.AccountKeeper.SetModuleAccount(suite.ctx, macc)
+	pool := app.AccountKeeper.GetModuleAccount(suite.ctx, types.EcosystemPoolName)
+	app.AccountKeeper.RemoveAccount(suite.ctx, pool)
+
+	ctx := suite.ctx.WithBlockHeight(10).WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	require.NoError(keeper.NewMigrator(mintKeeper).Migrate1to2(ctx))
+
+	// the new params are set to their defaults and the existing ones kept
+	defaults := types.DefaultParams()
+	migrated := mintKeeper.GetParams(ctx)
+	require.Equal(params.MintDenom, migrated.MintDenom)
+	require.Equal(params.WeightedDeveloperRewardsReceivers, migrated.WeightedDeveloperRewardsReceivers)
+	require.Len(migrated.InflationSchedule, len(defaults.InflationSchedule))
+	require.Equal(defaults.PhaseMode, migrated.PhaseMode)
+	require.Equal(defaults.EpochBlocks, migrated.EpochBlocks)
+	require.Equal(defaults.FeeBurnRatio, migrated.FeeBurnRatio)
+
+	minter = mintKeeper.GetMinter(ctx)
+	require.Equal(ctx.BlockTime(), minter.PhaseStartTime)
+	require.Equal(ctx.BlockTime(), minter.LastBlockTime)
+
+	epoch := mintKeeper.GetEpoch(ctx)
+	require.Equal(ctx.BlockTime(), epoch.StartTime)
+	require.Equal(int64(11), epoch.StartHeight)
+	require.True(mintStore.Has(types.DistributionRemainderKey))
+	require.True(mintStore.Has(types.PauseKey))
+	require.True(mintStore.Has(types.FeeBurnKey))
+
+	macc = app.AccountKeeper.GetModuleAccount(ctx, types.ModuleName).(*authtypes.ModuleAccount)
+	require.True(macc.HasPermission(authtypes.Minter))
+	require.True(macc.HasPermission(authtypes.Burner))
+	require.True(app.AccountKeeper.HasAccount(ctx, app.AccountKeeper.GetModuleAddress(types.EcosystemPoolName)))
+
+	// the fees burned by the mint module account are accepted by bank
+	require.NoError(mintKeeper.MintCoins(ctx, sdk.NewCoins(sdk.NewInt64Coin(migrated.MintDenom, 100))))
+	require.NoError(app.BankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(migrated.MintDenom, 100))))
+}
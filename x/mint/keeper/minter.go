@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/galaxynetwork/galaxy/x/mint/types"
@@ -24,3 +26,21 @@ func (k Keeper) SetMinter(ctx sdk.Context, minter types.Minter) {
 	b := k.cdc.MustMarshal(&minter)
 	store.Set(types.MinterKey, b)
 }
+
+// NextBlockProvision returns the minter updated with the inflation rate of a
+// block at blockTime, along with the provision of the block before the carried
+// fraction and the max supply cap. The rate follows the bonded ratio under the
+// bonded ratio inflation mode, and phases advancing by block time provision
+// the time elapsed since the last minting block.
+func (k Keeper) NextBlockProvision(ctx sdk.Context, params types.Params, minter types.Minter, totalSupply sdk.Int, blockTime time.Time) (types.Minter, sdk.Dec) {
+	// the rate follows the bonded ratio below the phase inflation rate
+	if params.InflationMode == types.InflationBondedRatio {
+		minter.Inflation = minter.NextInflationRate(params, k.BondedRatio(ctx), blockTime)
+		minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalSupply)
+	}
+
+	if params.PhaseMode == types.PhaseByTime {
+		return minter, minter.ExactBlockProvisionByTime(params, blockTime)
+	}
+	return minter, minter.ExactBlockProvision(params)
+}
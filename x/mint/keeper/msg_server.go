@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the mint MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.UpdateParams(ctx, msg.Authority, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (k msgServer) WithdrawDeveloperRewards(goCtx context.Context, msg *types.MsgWithdrawDeveloperRewards) (*types.MsgWithdrawDeveloperRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := k.Keeper.WithdrawDeveloperRewards(ctx, receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawDeveloperRewardsResponse{Amount: amount}, nil
+}
+
+func (k msgServer) ClawbackDeveloperRewards(goCtx context.Context, msg *types.MsgClawbackDeveloperRewards) (*types.MsgClawbackDeveloperRewardsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	amount, err := k.Keeper.ClawbackDeveloperRewards(ctx, msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClawbackDeveloperRewardsResponse{Amount: amount}, nil
+}
+
+func (k msgServer) AddDeveloperReceiver(goCtx context.Context, msg *types.MsgAddDeveloperReceiver) (*types.MsgAddDeveloperReceiverResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.AddDeveloperReceiver(ctx, msg.Receiver); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddDeveloperReceiverResponse{}, nil
+}
+
+func (k msgServer) RemoveDeveloperReceiver(goCtx context.Context, msg *types.MsgRemoveDeveloperReceiver) (*types.MsgRemoveDeveloperReceiverResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.RemoveDeveloperReceiver(ctx, msg.Address, msg.Redistribution); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveDeveloperReceiverResponse{}, nil
+}
+
+func (k msgServer) ReplaceDeveloperReceiver(goCtx context.Context, msg *types.MsgReplaceDeveloperReceiver) (*types.MsgReplaceDeveloperReceiverResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.ReplaceDeveloperReceiver(ctx, msg.Address, msg.NewAddress); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReplaceDeveloperReceiverResponse{}, nil
+}
+
+func (k msgServer) ReweightDeveloperReceiver(goCtx context.Context, msg *types.MsgReweightDeveloperReceiver) (*types.MsgReweightDeveloperReceiverResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.ReweightDeveloperReceiver(ctx, msg.Address, msg.Weight); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReweightDeveloperReceiverResponse{}, nil
+}
+
+func (k msgServer) SetPaused(goCtx context.Context, msg *types.MsgSetPaused) (*types.MsgSetPausedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the guardian can only pause, resuming goes through the authority or a
+	// SetMintPausedProposal
+	guardian := k.GetGuardian(ctx)
+	if k.authority != msg.Authority && !(msg.Paused && guardian != "" && guardian == msg.Authority) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := k.Keeper.SetPaused(ctx, msg.Paused); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetPausedResponse{}, nil
+}
@@ -0,0 +1,276 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/galaxynetwork/galaxy/x/mint/keeper"
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgUpdateParams() {
+	mintKeeper := suite.app.MintKeeper
+	msgServer := keeper.NewMsgServerImpl(mintKeeper)
+	authority := mintKeeper.GetAuthority()
+
+	validParams := types.DefaultParams()
+	validParams.StopInflationPhase = 20
+
+	invalidParams := types.DefaultParams()
+	invalidParams.DistributionProportions.Staking = sdk.NewDecWithPrec(9, 1)
+
+	err := mintKeeper.MintCoins(suite.ctx, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultMintDenom, 1_000)))
+	suite.Require().NoError(err)
+	belowSupplyParams := types.DefaultParams()
+	belowSupplyParams.MaxSupply = mintKeeper.TokenSupply(suite.ctx, belowSupplyParams.MintDenom).SubRaw(1)
+
+	tests := []struct {
+		name      string
+		msg       *types.MsgUpdateParams
+		expectErr bool
+	}{
+		{
+			name:      "invalid authority",
+			msg:       types.NewMsgUpdateParams(sdk.AccAddress([]byte("addr1---")).String(), validParams),
+			expectErr: true,
+		},
+		{
+			name:      "invalid params",
+			msg:       types.NewMsgUpdateParams(authority, invalidParams),
+			expectErr: true,
+		},
+		{
+			name:      "max supply below current supply",
+			msg:       types.NewMsgUpdateParams(authority, belowSupplyParams),
+			expectErr: true,
+		},
+		{
+			name:      "valid params",
+			msg:       types.NewMsgUpdateParams(authority, validParams),
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			before := mintKeeper.GetParams(suite.ctx)
+
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), tc.msg)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				suite.Require().Equal(before.String(), mintKeeper.GetParams(suite.ctx).String())
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.msg.Params.String(), mintKeeper.GetParams(suite.ctx).String())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgWithdrawDeveloperRewards() {
+	mintKeeper := suite.app.MintKeeper
+	msgServer := keeper.NewMsgServerImpl(mintKeeper)
+	receiver := sdk.AccAddress([]byte("addr1---"))
+
+	_, err := msgServer.WithdrawDeveloperRewards(sdk.WrapSDKContext(suite.ctx), types.NewMsgWithdrawDeveloperRewards(receiver))
+	suite.Require().ErrorIs(err, types.ErrNoDeveloperRewards)
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(types.DefaultMintDenom, 1_000))
+	suite.Require().NoError(mintKeeper.MintCoins(suite.ctx, rewards))
+	mintKeeper.AccrueDeveloperRewards(suite.ctx, receiver.String(), rewards)
+	suite.Require().Equal(rewards, mintKeeper.GetEscrowedDeveloperRewards(suite.ctx))
+
+	res, err := msgServer.WithdrawDeveloperRewards(sdk.WrapSDKContext(suite.ctx), types.NewMsgWithdrawDeveloperRewards(receiver))
+	suite.Require().NoError(err)
+	suite.Require().Equal(rewards, res.Amount)
+	suite.Require().Equal(rewards, suite.app.BankKeeper.GetAllBalances(suite.ctx, receiver))
+	suite.Require().True(mintKeeper.GetEscrowedDeveloperRewards(suite.ctx).IsZero())
+	suite.Require().Equal(rewards, mintKeeper.GetDeveloperRewards(suite.ctx, receiver.String()).Withdrawn)
+
+	_, err = msgServer.WithdrawDeveloperRewards(sdk.WrapSDKContext(suite.ctx), types.NewMsgWithdrawDeveloperRewards(receiver))
+	suite.Require().ErrorIs(err, types.ErrNoDeveloperRewards)
+}
+
+func (suite *KeeperTestSuite) TestMsgClawbackDeveloperRewards() {
+	mintKeeper := suite.app.MintKeeper
+	msgServer := keeper.NewMsgServerImpl(mintKeeper)
+	authority := mintKeeper.GetAuthority()
+	receiver := sdk.AccAddress([]byte("addr1---"))
+	start := suite.ctx.BlockTime()
+
+	params := mintKeeper.GetParams(suite.ctx)
+	params.WeightedDeveloperRewardsReceivers = []types.DevloperWeightedAddress{{
+		Address: receiver.String(),
+		Weight:  sdk.OneDec(),
+		Vesting: types.DeveloperVesting{
+			Type:      types.VestingContinuous,
+			StartTime: start,
+			EndTime:   start.Add(100 * time.Second),
+		},
+	}}
+	mintKeeper.SetParams(suite.ctx, params)
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(types.DefaultMintDenom, 1_000))
+	suite.Require().NoError(mintKeeper.MintCoins(suite.ctx, rewards))
+	mintKeeper.AccrueDeveloperRewards(suite.ctx, receiver.String(), rewards)
+	suite.Require().Equal(rewards, mintKeeper.GetDeveloperRewards(suite.ctx, receiver.String()).Locked)
+
+	_, err := msgServer.WithdrawDeveloperRewards(sdk.WrapSDKContext(suite.ctx), types.NewMsgWithdrawDeveloperRewards(receiver))
+	suite.Require().ErrorIs(err, types.ErrNoDeveloperRewards)
+
+	suite.ctx = suite.ctx.WithBlockTime(start.Add(40 * time.Second))
+	res, err := mintKeeper.DeveloperVesting(sdk.WrapSDKContext(suite.ctx), &types.QueryDeveloperVestingRequest{Address: receiver.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(400), res.Vested.AmountOf(types.DefaultMintDenom))
+	suite.Require().Equal(sdk.NewInt(600), res.Locked.AmountOf(types.DefaultMintDenom))
+
+	_, err = msgServer.ClawbackDeveloperRewards(sdk.WrapSDKContext(suite.ctx), types.NewMsgClawbackDeveloperRewards(receiver.String(), receiver.String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidAuthority)
+
+	communityPoolBefore := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(types.DefaultMintDenom)
+	clawback, err := msgServer.ClawbackDeveloperRewards(sdk.WrapSDKContext(suite.ctx), types.NewMsgClawbackDeveloperRewards(authority, receiver.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(600), clawback.Amount.AmountOf(types.DefaultMintDenom))
+	communityPoolAfter := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(types.DefaultMintDenom)
+	suite.Require().Equal(sdk.NewDec(600), communityPoolAfter.Sub(communityPoolBefore))
+
+	_, err = msgServer.ClawbackDeveloperRewards(sdk.WrapSDKContext(suite.ctx), types.NewMsgClawbackDeveloperRewards(authority, receiver.String()))
+	suite.Require().ErrorIs(err, types.ErrNoLockedDeveloperRewards)
+
+	withdraw, err := msgServer.WithdrawDeveloperRewards(sdk.WrapSDKContext(suite.ctx), types.NewMsgWithdrawDeveloperRewards(receiver))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(400), withdraw.Amount.AmountOf(types.DefaultMintDenom))
+
+	_, broken := keeper.ModuleBalanceInvariant(mintKeeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestMsgReplaceDeveloperReceiverLockedRewards() {
+	mintKeeper := suite.app.MintKeeper
+	msgServer := keeper.NewMsgServerImpl(mintKeeper)
+	authority := mintKeeper.GetAuthority()
+	receiver := sdk.AccAddress([]byte("addr1---"))
+	newReceiver := sdk.AccAddress([]byte("addr2---"))
+	start := suite.ctx.BlockTime()
+
+	params := mintKeeper.GetParams(suite.ctx)
+	params.WeightedDeveloperRewardsReceivers = []types.DevloperWeightedAddress{{
+		Address: receiver.String(),
+		Weight:  sdk.OneDec(),
+		Vesting: types.DeveloperVesting{
+			Type:      types.VestingContinuous,
+			StartTime: start,
+			EndTime:   start.Add(100 * time.Second),
+		},
+	}}
+	mintKeeper.SetParams(suite.ctx, params)
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(types.DefaultMintDenom, 1_000))
+	suite.Require().NoError(mintKeeper.MintCoins(suite.ctx, rewards))
+	mintKeeper.AccrueDeveloperRewards(suite.ctx, receiver.String(), rewards)
+
+	// the receiver is replaced while 600 of its rewards are still locked
+	suite.ctx = suite.ctx.WithBlockTime(start.Add(40 * time.Second))
+	_, err := msgServer.ReplaceDeveloperReceiver(sdk.WrapSDKContext(suite.ctx), types.NewMsgReplaceDeveloperReceiver(authority, receiver.String(), newReceiver.String()))
+	suite.Require().NoError(err)
+
+	old := mintKeeper.GetDeveloperRewards(suite.ctx, receiver.String())
+	suite.Require().True(old.Locked.IsZero())
+	suite.Require().Equal(sdk.NewInt(400), old.Withdrawable().AmountOf(types.DefaultMintDenom))
+	moved := mintKeeper.GetDeveloperRewards(suite.ctx, newReceiver.String())
+	suite.Require().Equal(sdk.NewInt(600), moved.Locked.AmountOf(types.DefaultMintDenom))
+	suite.Require().Equal(sdk.NewInt(600), moved.Accrued.AmountOf(types.DefaultMintDenom))
+
+	withdraw, err := msgServer.WithdrawDeveloperRewards(sdk.WrapSDKContext(suite.ctx), types.NewMsgWithdrawDeveloperRewards(receiver))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(400), withdraw.Amount.AmountOf(types.DefaultMintDenom))
+
+	// the new receiver keeps vesting the moved rewards on the same schedule
+	suite.ctx = suite.ctx.WithBlockTime(start.Add(70 * time.Second))
+	withdraw, err = msgServer.WithdrawDeveloperRewards(sdk.WrapSDKContext(suite.ctx), types.NewMsgWithdrawDeveloperRewards(newReceiver))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(300), withdraw.Amount.AmountOf(types.DefaultMintDenom))
+
+	suite.ctx = suite.ctx.WithBlockTime(start.Add(100 * time.Second))
+	withdraw, err = msgServer.WithdrawDeveloperRewards(sdk.WrapSDKContext(suite.ctx), types.NewMsgWithdrawDeveloperRewards(newReceiver))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(300), withdraw.Amount.AmountOf(types.DefaultMintDenom))
+
+	_, broken := keeper.ModuleBalanceInvariant(mintKeeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestMsgUpdateDeveloperReceivers() {
+	mintKeeper := suite.app.MintKeeper
+	msgServer := keeper.NewMsgServerImpl(mintKeeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	authority := mintKeeper.GetAuthority()
+	addr1 := sdk.AccAddress([]byte("addr1---")).String()
+	addr2 := sdk.AccAddress([]byte("addr2---")).String()
+	addr3 := sdk.AccAddress([]byte("addr3---")).String()
+
+	_, err := msgServer.AddDeveloperReceiver(ctx, types.NewMsgAddDeveloperReceiver(addr1, types.DevloperWeightedAddress{Address: addr1, Weight: sdk.OneDec()}))
+	suite.Require().ErrorIs(err, types.ErrInvalidAuthority)
+
+	_, err = msgServer.AddDeveloperReceiver(ctx, types.NewMsgAddDeveloperReceiver(authority, types.DevloperWeightedAddress{Address: addr1, Weight: sdk.OneDec()}))
+	suite.Require().NoError(err)
+	_, err = msgServer.AddDeveloperReceiver(ctx, types.NewMsgAddDeveloperReceiver(authority, types.DevloperWeightedAddress{Address: addr2, Weight: sdk.NewDecWithPrec(4, 1)}))
+	suite.Require().NoError(err)
+	_, err = msgServer.ReweightDeveloperReceiver(ctx, types.NewMsgReweightDeveloperReceiver(authority, addr1, sdk.NewDecWithPrec(5, 1)))
+	suite.Require().NoError(err)
+	_, err = msgServer.ReplaceDeveloperReceiver(ctx, types.NewMsgReplaceDeveloperReceiver(authority, addr2, addr3))
+	suite.Require().NoError(err)
+
+	receivers := mintKeeper.GetParams(suite.ctx).WeightedDeveloperRewardsReceivers
+	suite.Require().Len(receivers, 2)
+	suite.Require().Equal(addr1, receivers[0].Address)
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 1), receivers[0].Weight)
+	suite.Require().Equal(addr3, receivers[1].Address)
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 1), receivers[1].Weight)
+
+	// a rejected update leaves the params untouched
+	before := mintKeeper.GetParams(suite.ctx)
+	_, err = msgServer.RemoveDeveloperReceiver(ctx, types.NewMsgRemoveDeveloperReceiver(authority, addr2, types.RedistributeToReceivers))
+	suite.Require().ErrorIs(err, types.ErrDeveloperReceiverNotFound)
+	suite.Require().Equal(before.String(), mintKeeper.GetParams(suite.ctx).String())
+
+	_, err = msgServer.RemoveDeveloperReceiver(ctx, types.NewMsgRemoveDeveloperReceiver(authority, addr3, types.RedistributeToCommunityPool))
+	suite.Require().NoError(err)
+	params := mintKeeper.GetParams(suite.ctx)
+	suite.Require().Len(params.WeightedDeveloperRewardsReceivers, 1)
+	suite.Require().Equal(sdk.OneDec(), params.WeightedDeveloperRewardsReceivers[0].Weight)
+	moved := before.DistributionProportions.DeveloperRewards.QuoInt64(2)
+	suite.Require().Equal(before.DistributionProportions.CommunityPool.Add(moved), params.DistributionProportions.CommunityPool)
+}
+
+func (suite *KeeperTestSuite) TestMsgSetPaused() {
+	mintKeeper := suite.app.MintKeeper
+	msgServer := keeper.NewMsgServerImpl(mintKeeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	authority := mintKeeper.GetAuthority()
+	guardian := sdk.AccAddress([]byte("guardian")).String()
+
+	// no guardian is set by default
+	_, err := msgServer.SetPaused(ctx, types.NewMsgSetPaused(guardian, true))
+	suite.Require().ErrorIs(err, types.ErrInvalidAuthority)
+
+	params := mintKeeper.GetParams(suite.ctx)
+	params.Guardian = guardian
+	mintKeeper.SetParams(suite.ctx, params)
+
+	_, err = msgServer.SetPaused(ctx, types.NewMsgSetPaused(guardian, true))
+	suite.Require().NoError(err)
+	suite.Require().True(mintKeeper.GetMintPause(suite.ctx).Paused)
+	_, err = msgServer.SetPaused(ctx, types.NewMsgSetPaused(authority, true))
+	suite.Require().ErrorIs(err, types.ErrAlreadyPaused)
+
+	// the guardian cannot resume
+	_, err = msgServer.SetPaused(ctx, types.NewMsgSetPaused(guardian, false))
+	suite.Require().ErrorIs(err, types.ErrInvalidAuthority)
+	_, err = msgServer.SetPaused(ctx, types.NewMsgSetPaused(authority, false))
+	suite.Require().NoError(err)
+	suite.Require().False(mintKeeper.GetMintPause(suite.ctx).Paused)
+	_, err = msgServer.SetPaused(ctx, types.NewMsgSetPaused(authority, false))
+	suite.Require().ErrorIs(err, types.ErrNotPaused)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/galaxynetwork/galaxy/x/mint/types"
 )
 
@@ -15,3 +16,39 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramStore.SetParamSet(ctx, &params)
 }
+
+// UpdateParams replaces the params once they are valid and their max supply
+// is not below the current supply.
+func (k Keeper) UpdateParams(ctx sdk.Context, authority string, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	if err := k.ValidateMaxSupply(ctx, params); err != nil {
+		return err
+	}
+
+	k.SetParams(ctx, params)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateParams,
+			sdk.NewAttribute(types.AttributeKeyAuthority, authority),
+		),
+	)
+
+	return nil
+}
+
+// ValidateMaxSupply checks that the max supply of the params is not below the
+// current supply of the mint denom.
+func (k Keeper) ValidateMaxSupply(ctx sdk.Context, params types.Params) error {
+	if !params.HasMaxSupply() {
+		return nil
+	}
+
+	supply := k.TokenSupply(ctx, params.MintDenom)
+	if params.MaxSupply.LT(supply) {
+		return sdkerrors.Wrapf(types.ErrInvalidMaxSupply, "max supply %s, current supply %s", params.MaxSupply, supply)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+// GetMintPause returns the pause state of minting.
+func (k Keeper) GetMintPause(ctx sdk.Context) types.MintPause {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PauseKey)
+	if bz == nil {
+		return types.MintPause{}
+	}
+
+	var pause types.MintPause
+	k.cdc.MustUnmarshal(bz, &pause)
+	return pause
+}
+
+func (k Keeper) SetMintPause(ctx sdk.Context, pause types.MintPause) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&pause)
+	store.Set(types.PauseKey, bz)
+}
+
+// GetGuardian returns the address allowed to pause besides the authority,
+// empty when there is none.
+func (k Keeper) GetGuardian(ctx sdk.Context) string {
+	return k.GetParams(ctx).Guardian
+}
+
+// SetPaused pauses or resumes minting. The skipped blocks of a new pause are
+// counted from zero, the blocks still to be caught up are kept.
+func (k Keeper) SetPaused(ctx sdk.Context, paused bool) error {
+	pause := k.GetMintPause(ctx)
+	if paused && pause.Paused {
+		return types.ErrAlreadyPaused
+	}
+	if !paused && !pause.Paused {
+		return types.ErrNotPaused
+	}
+
+	pause.Paused = paused
+	if paused {
+		pause.PausedHeight = ctx.BlockHeight()
+		pause.PausedTime = ctx.BlockTime()
+		pause.SkippedBlocks = 0
+	}
+	k.SetMintPause(ctx, pause)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetPaused,
+			sdk.NewAttribute(types.AttributeKeyPaused, strconv.FormatBool(paused)),
+		),
+	)
+
+	return nil
+}
+
+// SkipBlock records a block skipped while minting is paused. Under the forfeit
+// policy the minter clock moves on, so that provisions by time skip the pause
+// as well.
+func (k Keeper) SkipBlock(ctx sdk.Context, pause types.MintPause) {
+	pause.SkippedBlocks++
+	if k.GetParams(ctx).PausePolicy == types.PauseCatchUp {
+		pause.CatchUpBlocks++
+	} else {
+		minter := k.GetMinter(ctx)
+		minter.LastBlockTime = ctx.BlockTime()
+		k.SetMinter(ctx, minter)
+	}
+	k.SetMintPause(ctx, pause)
+}
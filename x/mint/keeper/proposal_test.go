@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/galaxynetwork/galaxy/x/mint/keeper"
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+// executeProposal runs a passed proposal through the route of the gov router,
+// as the gov EndBlocker does.
+func (suite *KeeperTestSuite) executeProposal(content govtypes.Content) error {
+	suite.Require().NoError(content.ValidateBasic())
+	handler := suite.app.GovKeeper.Router().GetRoute(content.ProposalRoute())
+	cacheCtx, write := suite.ctx.CacheContext()
+	if err := handler(cacheCtx, content); err != nil {
+		return err
+	}
+	write()
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestUpdateMintParamsProposal() {
+	mintKeeper := suite.app.MintKeeper
+
+	params := types.DefaultParams()
+	params.StopInflationPhase = 20
+	suite.Require().NoError(suite.executeProposal(types.NewUpdateMintParamsProposal("title", "description", params)))
+	suite.Require().Equal(params.String(), mintKeeper.GetParams(suite.ctx).String())
+
+	suite.Require().NoError(mintKeeper.MintCoins(suite.ctx, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultMintDenom, 1_000))))
+	belowSupplyParams := types.DefaultParams()
+	belowSupplyParams.MaxSupply = mintKeeper.TokenSupply(suite.ctx, belowSupplyParams.MintDenom).SubRaw(1)
+	suite.Require().ErrorIs(
+		suite.executeProposal(types.NewUpdateMintParamsProposal("title", "description", belowSupplyParams)),
+		types.ErrInvalidMaxSupply,
+	)
+	suite.Require().Equal(params.String(), mintKeeper.GetParams(suite.ctx).String())
+
+	invalidParams := types.DefaultParams()
+	invalidParams.DistributionProportions.Staking = sdk.NewDecWithPrec(9, 1)
+	suite.Require().Error(types.NewUpdateMintParamsProposal("title", "description", invalidParams).ValidateBasic())
+}
+
+func (suite *KeeperTestSuite) TestClawbackDeveloperRewardsProposal() {
+	mintKeeper := suite.app.MintKeeper
+	receiver := sdk.AccAddress([]byte("addr1---"))
+	start := suite.ctx.BlockTime()
+
+	params := mintKeeper.GetParams(suite.ctx)
+	params.WeightedDeveloperRewardsReceivers = []types.DevloperWeightedAddress{{
+		Address: receiver.String(),
+		Weight:  sdk.OneDec(),
+		Vesting: types.DeveloperVesting{
+			Type:      types.VestingContinuous,
+			StartTime: start,
+			EndTime:   start.Add(100 * time.Second),
+		},
+	}}
+	mintKeeper.SetParams(suite.ctx, params)
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(types.DefaultMintDenom, 1_000))
+	suite.Require().NoError(mintKeeper.MintCoins(suite.ctx, rewards))
+	mintKeeper.AccrueDeveloperRewards(suite.ctx, receiver.String(), rewards)
+	suite.ctx = suite.ctx.WithBlockTime(start.Add(40 * time.Second))
+
+	proposal := types.NewClawbackDeveloperRewardsProposal("title", "description", receiver.String())
+	communityPoolBefore := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(types.DefaultMintDenom)
+	suite.Require().NoError(suite.executeProposal(proposal))
+	communityPoolAfter := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(types.DefaultMintDenom)
+	suite.Require().Equal(sdk.NewDec(600), communityPoolAfter.Sub(communityPoolBefore))
+	suite.Require().Equal(sdk.NewInt(400), mintKeeper.GetVestedDeveloperRewards(suite.ctx, receiver.String()).Withdrawable().AmountOf(types.DefaultMintDenom))
+
+	suite.Require().ErrorIs(suite.executeProposal(proposal), types.ErrNoLockedDeveloperRewards)
+	suite.Require().Error(types.NewClawbackDeveloperRewardsProposal("title", "description", "invalid").ValidateBasic())
+}
+
+func (suite *KeeperTestSuite) TestDeveloperReceiverProposals() {
+	mintKeeper := suite.app.MintKeeper
+	addr1 := sdk.AccAddress([]byte("addr1---")).String()
+	addr2 := sdk.AccAddress([]byte("addr2---")).String()
+	addr3 := sdk.AccAddress([]byte("addr3---")).String()
+
+	suite.Require().NoError(suite.executeProposal(types.NewAddDeveloperReceiverProposal("title", "description", types.DevloperWeightedAddress{Address: addr1, Weight: sdk.OneDec()})))
+	suite.Require().NoError(suite.executeProposal(types.NewAddDeveloperReceiverProposal("title", "description", types.DevloperWeightedAddress{Address: addr2, Weight: sdk.NewDecWithPrec(4, 1)})))
+	suite.Require().NoError(suite.executeProposal(types.NewReweightDeveloperReceiverProposal("title", "description", addr1, sdk.NewDecWithPrec(5, 1))))
+	suite.Require().NoError(suite.executeProposal(types.NewReplaceDeveloperReceiverProposal("title", "description", addr2, addr3)))
+
+	receivers := mintKeeper.GetParams(suite.ctx).WeightedDeveloperRewardsReceivers
+	suite.Require().Len(receivers, 2)
+	suite.Require().Equal(addr1, receivers[0].Address)
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 1), receivers[0].Weight)
+	suite.Require().Equal(addr3, receivers[1].Address)
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 1), receivers[1].Weight)
+
+	// a failed proposal leaves the params untouched
+	before := mintKeeper.GetParams(suite.ctx)
+	suite.Require().ErrorIs(
+		suite.executeProposal(types.NewRemoveDeveloperReceiverProposal("title", "description", addr2, types.RedistributeToReceivers)),
+		types.ErrDeveloperReceiverNotFound,
+	)
+	suite.Require().Equal(before.String(), mintKeeper.GetParams(suite.ctx).String())
+
+	suite.Require().NoError(suite.executeProposal(types.NewRemoveDeveloperReceiverProposal("title", "description", addr3, types.RedistributeToReceivers)))
+	receivers = mintKeeper.GetParams(suite.ctx).WeightedDeveloperRewardsReceivers
+	suite.Require().Len(receivers, 1)
+	suite.Require().Equal(sdk.OneDec(), receivers[0].Weight)
+
+	suite.Require().Error(types.NewReplaceDeveloperReceiverProposal("title", "description", addr1, addr1).ValidateBasic())
+	suite.Require().Error(types.NewRemoveDeveloperReceiverProposal("title", "description", addr1, types.WeightRedistribution(5)).ValidateBasic())
+	suite.Require().Error(types.NewReweightDeveloperReceiverProposal("title", "description", "invalid", sdk.OneDec()).ValidateBasic())
+}
+
+func (suite *KeeperTestSuite) TestSetMintPausedProposal() {
+	mintKeeper := suite.app.MintKeeper
+	msgServer := keeper.NewMsgServerImpl(mintKeeper)
+	guardian := sdk.AccAddress([]byte("guardian")).String()
+
+	params := mintKeeper.GetParams(suite.ctx)
+	params.Guardian = guardian
+	mintKeeper.SetParams(suite.ctx, params)
+
+	// the guardian pauses but cannot resume
+	_, err := msgServer.SetPaused(sdk.WrapSDKContext(suite.ctx), types.NewMsgSetPaused(guardian, true))
+	suite.Require().NoError(err)
+	_, err = msgServer.SetPaused(sdk.WrapSDKContext(suite.ctx), types.NewMsgSetPaused(guardian, false))
+	suite.Require().ErrorIs(err, types.ErrInvalidAuthority)
+	suite.Require().True(mintKeeper.GetMintPause(suite.ctx).Paused)
+
+	suite.Require().NoError(suite.executeProposal(types.NewSetMintPausedProposal("title", "description", false)))
+	suite.Require().False(mintKeeper.GetMintPause(suite.ctx).Paused)
+	suite.Require().ErrorIs(
+		suite.executeProposal(types.NewSetMintPausedProposal("title", "description", false)),
+		types.ErrNotPaused,
+	)
+}
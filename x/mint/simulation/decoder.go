@@ -0,0 +1,68 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding mint type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key, types.MinterKey):
+			var minterA, minterB types.Minter
+			cdc.MustUnmarshal(kvA.Value, &minterA)
+			cdc.MustUnmarshal(kvB.Value, &minterB)
+			return fmt.Sprintf("%v\n%v", minterA, minterB)
+
+		case bytes.HasPrefix(kvA.Key, types.DeveloperRewardsKeyPrefix):
+			var rewardsA, rewardsB types.DeveloperRewards
+			cdc.MustUnmarshal(kvA.Value, &rewardsA)
+			cdc.MustUnmarshal(kvB.Value, &rewardsB)
+			return fmt.Sprintf("%v\n%v", rewardsA, rewardsB)
+
+		case bytes.HasPrefix(kvA.Key, types.DistributionRecordKeyPrefix):
+			var recordA, recordB types.DistributionRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+		case bytes.Equal(kvA.Key, types.EpochKey):
+			var epochA, epochB types.Epoch
+			cdc.MustUnmarshal(kvA.Value, &epochA)
+			cdc.MustUnmarshal(kvB.Value, &epochB)
+			return fmt.Sprintf("%v\n%v", epochA, epochB)
+
+		case bytes.Equal(kvA.Key, types.DistributionRemainderKey):
+			var remainderA, remainderB types.DistributionRemainder
+			cdc.MustUnmarshal(kvA.Value, &remainderA)
+			cdc.MustUnmarshal(kvB.Value, &remainderB)
+			return fmt.Sprintf("%v\n%v", remainderA, remainderB)
+
+		case bytes.Equal(kvA.Key, types.PauseKey):
+			var pauseA, pauseB types.MintPause
+			cdc.MustUnmarshal(kvA.Value, &pauseA)
+			cdc.MustUnmarshal(kvB.Value, &pauseB)
+			return fmt.Sprintf("%v\n%v", pauseA, pauseB)
+
+		case bytes.Equal(kvA.Key, types.FeeBurnKey):
+			var feeBurnA, feeBurnB types.FeeBurn
+			cdc.MustUnmarshal(kvA.Value, &feeBurnA)
+			cdc.MustUnmarshal(kvB.Value, &feeBurnB)
+			return fmt.Sprintf("%v\n%v", feeBurnA, feeBurnB)
+
+		case bytes.Equal(kvA.Key, types.DistributionFailuresKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		default:
+			panic(fmt.Sprintf("invalid mint key %X", kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,339 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+// Simulation parameter constants
+const (
+	ThresholdPhase           = "threshold_phase"
+	StopInflationPhase       = "stop_inflation_phase"
+	DistributionProportions  = "distribution_proportions"
+	DeveloperRewardsReceiver = "weighted_developer_rewards_receivers"
+	BlocksPerYear            = "blocks_per_year"
+	InflationSchedule        = "inflation_schedule"
+	PhaseMode                = "phase_mode"
+	PhaseDuration            = "phase_duration"
+	MaxSupply                = "max_supply"
+	InflationMode            = "inflation_mode"
+	GoalBonded               = "goal_bonded"
+	InflationRateChange      = "inflation_rate_change"
+	InflationMin             = "inflation_min"
+	EpochBlocks              = "epoch_blocks"
+	EpochDuration            = "epoch_duration"
+	PausePolicy              = "pause_policy"
+	FeeBurnRatio             = "fee_burn_ratio"
+)
+
+// GenThresholdPhase randomized ThresholdPhase
+func GenThresholdPhase(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 5))
+}
+
+// GenStopInflationPhase randomized StopInflationPhase, always after the threshold phase
+func GenStopInflationPhase(r *rand.Rand, thresholdPhase uint64) uint64 {
+	return thresholdPhase + uint64(simtypes.RandIntBetween(r, 1, 10))
+}
+
+// GenDistributionProportions randomized DistributionProportions summing up to one
+func GenDistributionProportions(r *rand.Rand) types.DistributionProportions {
+	staking := simtypes.RandIntBetween(r, 0, 100)
+	ecosystemIncentives := simtypes.RandIntBetween(r, 0, 100-staking)
+	developerRewards := simtypes.RandIntBetween(r, 0, 100-staking-ecosystemIncentives)
+	communityPool := 100 - staking - ecosystemIncentives - developerRewards
+
+	return types.DistributionProportions{
+		Staking:             sdk.NewDecWithPrec(int64(staking), 2),
+		EcosystemIncentives: sdk.NewDecWithPrec(int64(ecosystemIncentives), 2),
+		DeveloperRewards:    sdk.NewDecWithPrec(int64(developerRewards), 2),
+		CommunityPool:       sdk.NewDecWithPrec(int64(communityPool), 2),
+	}
+}
+
+// GenDeveloperRewardsReceivers randomized developer rewards receivers picked
+// from the simulation accounts, with weights summing up to one
+func GenDeveloperRewardsReceivers(r *rand.Rand, accs []simtypes.Account, genTime time.Time) []types.DevloperWeightedAddress {
+	n := simtypes.RandIntBetween(r, 0, 4)
+	if n > len(accs) {
+		n = len(accs)
+	}
+
+	receivers := []types.DevloperWeightedAddress{}
+	remaining := 100
+	for i, idx := range r.Perm(len(accs))[:n] {
+		weight := remaining
+		if i < n-1 {
+			weight = simtypes.RandIntBetween(r, 1, remaining-(n-1-i)+1)
+		}
+		remaining -= weight
+
+		receivers = append(receivers, types.DevloperWeightedAddress{
+			Address: accs[idx].Address.String(),
+			Weight:  sdk.NewDecWithPrec(int64(weight), 2),
+			Vesting: GenDeveloperVesting(r, genTime),
+		})
+	}
+	return receivers
+}
+
+// GenDeveloperVesting randomized vesting schedule of a developer rewards receiver
+func GenDeveloperVesting(r *rand.Rand, genTime time.Time) types.DeveloperVesting {
+	vestingType := types.VestingType(r.Intn(3))
+	if vestingType == types.VestingNone {
+		return types.DeveloperVesting{}
+	}
+
+	startTime := genTime.Add(time.Duration(r.Intn(24)) * time.Hour)
+	return types.DeveloperVesting{
+		Type:      vestingType,
+		StartTime: startTime,
+		EndTime:   startTime.Add(time.Duration(simtypes.RandIntBetween(r, 1, 30*24)) * time.Hour),
+		Period:    time.Duration(simtypes.RandIntBetween(r, 1, 24)) * time.Hour,
+	}
+}
+
+// GenBlocksPerYear randomized BlocksPerYear, small enough to go through
+// several phases during a simulation
+func GenBlocksPerYear(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 20, 200))
+}
+
+// GenInflationSchedule randomized InflationSchedule, empty for the default curve
+func GenInflationSchedule(r *rand.Rand, stopInflationPhase uint64) []types.InflationPhase {
+	schedule := []types.InflationPhase{}
+	if r.Intn(2) == 0 {
+		return schedule
+	}
+
+	for phase := uint64(1); phase < stopInflationPhase; phase++ {
+		provisionCap := sdk.ZeroInt()
+		if r.Intn(4) == 0 {
+			provisionCap = sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 1_000_000_000)))
+		}
+		schedule = append(schedule, types.InflationPhase{
+			Phase:        phase,
+			Inflation:    sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 50)), 2),
+			ProvisionCap: provisionCap,
+		})
+	}
+	return schedule
+}
+
+// GenPhaseMode randomized PhaseMode
+func GenPhaseMode(r *rand.Rand) types.PhaseMode {
+	return types.PhaseMode(r.Intn(2))
+}
+
+// GenPhaseDuration randomized PhaseDuration
+func GenPhaseDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simtypes.RandIntBetween(r, 1, 48)) * time.Hour
+}
+
+// GenMaxSupply randomized MaxSupply, zero for no cap. The cap leaves room for
+// the bonded tokens added to the initial supply at genesis.
+func GenMaxSupply(r *rand.Rand, initialSupply sdk.Int) sdk.Int {
+	if r.Intn(2) == 0 {
+		return sdk.ZeroInt()
+	}
+	return initialSupply.MulRaw(2).Add(initialSupply.QuoRaw(int64(simtypes.RandIntBetween(r, 1, 100))))
+}
+
+// GenInflationMode randomized InflationMode
+func GenInflationMode(r *rand.Rand) types.InflationMode {
+	return types.InflationMode(r.Intn(2))
+}
+
+// GenGoalBonded randomized GoalBonded
+func GenGoalBonded(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 101)), 2)
+}
+
+// GenInflationRateChange randomized InflationRateChange
+func GenInflationRateChange(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(99)), 2)
+}
+
+// GenInflationMin randomized InflationMin
+func GenInflationMin(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 20)), 2)
+}
+
+// GenEpochBlocks randomized EpochBlocks, minting every block half of the time
+func GenEpochBlocks(r *rand.Rand) uint64 {
+	if r.Intn(2) == 0 {
+		return 1
+	}
+	return uint64(simtypes.RandIntBetween(r, 2, 20))
+}
+
+// GenEpochDuration randomized EpochDuration, zero for epochs by block height
+func GenEpochDuration(r *rand.Rand) time.Duration {
+	if r.Intn(4) != 0 {
+		return 0
+	}
+	return time.Duration(simtypes.RandIntBetween(r, 1, 60)) * time.Minute
+}
+
+// GenPausePolicy randomized PausePolicy
+func GenPausePolicy(r *rand.Rand) types.PausePolicy {
+	return types.PausePolicy(r.Intn(len(types.PausePolicy_name)))
+}
+
+// GenFeeBurnRatio randomized FeeBurnRatio, burning no fees half of the time
+func GenFeeBurnRatio(r *rand.Rand) sdk.Dec {
+	if r.Intn(2) == 0 {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 101)), 2)
+}
+
+// RandomizedGenState generates a random GenesisState for mint
+func RandomizedGenState(simState *module.SimulationState) {
+	var thresholdPhase uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ThresholdPhase, &thresholdPhase, simState.Rand,
+		func(r *rand.Rand) { thresholdPhase = GenThresholdPhase(r) },
+	)
+
+	var stopInflationPhase uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, StopInflationPhase, &stopInflationPhase, simState.Rand,
+		func(r *rand.Rand) { stopInflationPhase = GenStopInflationPhase(r, thresholdPhase) },
+	)
+
+	var distributionProportions types.DistributionProportions
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DistributionProportions, &distributionProportions, simState.Rand,
+		func(r *rand.Rand) { distributionProportions = GenDistributionProportions(r) },
+	)
+
+	var developerRewardsReceivers []types.DevloperWeightedAddress
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DeveloperRewardsReceiver, &developerRewardsReceivers, simState.Rand,
+		func(r *rand.Rand) {
+			developerRewardsReceivers = GenDeveloperRewardsReceivers(r, simState.Accounts, simState.GenTimestamp)
+		},
+	)
+
+	var blocksPerYear uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BlocksPerYear, &blocksPerYear, simState.Rand,
+		func(r *rand.Rand) { blocksPerYear = GenBlocksPerYear(r) },
+	)
+
+	var inflationSchedule []types.InflationPhase
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, InflationSchedule, &inflationSchedule, simState.Rand,
+		func(r *rand.Rand) { inflationSchedule = GenInflationSchedule(r, stopInflationPhase) },
+	)
+
+	var phaseMode types.PhaseMode
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, PhaseMode, &phaseMode, simState.Rand,
+		func(r *rand.Rand) { phaseMode = GenPhaseMode(r) },
+	)
+
+	var phaseDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, PhaseDuration, &phaseDuration, simState.Rand,
+		func(r *rand.Rand) { phaseDuration = GenPhaseDuration(r) },
+	)
+
+	// the initial supply of the bond denom is the stake of every account
+	initialSupply := sdk.NewInt(simState.InitialStake).MulRaw(int64(len(simState.Accounts)))
+	var maxSupply sdk.Int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxSupply, &maxSupply, simState.Rand,
+		func(r *rand.Rand) { maxSupply = GenMaxSupply(r, initialSupply) },
+	)
+
+	var inflationMode types.InflationMode
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, InflationMode, &inflationMode, simState.Rand,
+		func(r *rand.Rand) { inflationMode = GenInflationMode(r) },
+	)
+
+	var goalBonded sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, GoalBonded, &goalBonded, simState.Rand,
+		func(r *rand.Rand) { goalBonded = GenGoalBonded(r) },
+	)
+
+	var inflationRateChange sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, InflationRateChange, &inflationRateChange, simState.Rand,
+		func(r *rand.Rand) { inflationRateChange = GenInflationRateChange(r) },
+	)
+
+	var inflationMin sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, InflationMin, &inflationMin, simState.Rand,
+		func(r *rand.Rand) { inflationMin = GenInflationMin(r) },
+	)
+
+	var epochBlocks uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EpochBlocks, &epochBlocks, simState.Rand,
+		func(r *rand.Rand) { epochBlocks = GenEpochBlocks(r) },
+	)
+
+	var epochDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EpochDuration, &epochDuration, simState.Rand,
+		func(r *rand.Rand) { epochDuration = GenEpochDuration(r) },
+	)
+
+	var pausePolicy types.PausePolicy
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, PausePolicy, &pausePolicy, simState.Rand,
+		func(r *rand.Rand) { pausePolicy = GenPausePolicy(r) },
+	)
+
+	var feeBurnRatio sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FeeBurnRatio, &feeBurnRatio, simState.Rand,
+		func(r *rand.Rand) { feeBurnRatio = GenFeeBurnRatio(r) },
+	)
+
+	params := types.NewParams(
+		sdk.DefaultBondDenom,
+		thresholdPhase,
+		stopInflationPhase,
+		distributionProportions,
+		developerRewardsReceivers,
+		blocksPerYear,
+		inflationSchedule,
+		phaseMode,
+		phaseDuration,
+		maxSupply,
+		inflationMode,
+		goalBonded,
+		inflationRateChange,
+		inflationMin,
+		epochBlocks,
+		epochDuration,
+		"",
+		pausePolicy,
+		feeBurnRatio,
+	)
+
+	mintGenesis := types.NewGenesisState(types.DefaultInitialMinter(), params)
+
+	bz, err := json.MarshalIndent(&mintGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated minting parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&mintGenesis)
+}
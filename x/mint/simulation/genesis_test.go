@@ -0,0 +1,48 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/galaxynetwork/galaxy/x/mint/simulation"
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+// TestRandomizedGenState tests that the randomized genesis state of the mint
+// module is always valid.
+func TestRandomizedGenState(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	for seed := int64(0); seed < 50; seed++ {
+		r := rand.New(rand.NewSource(seed))
+
+		simState := module.SimulationState{
+			AppParams:    make(simtypes.AppParams),
+			Cdc:          cdc,
+			Rand:         r,
+			NumBonded:    3,
+			Accounts:     simtypes.RandomAccounts(r, 3),
+			InitialStake: 1000,
+			GenState:     make(map[string]json.RawMessage),
+			GenTimestamp: time.Unix(1_000_000, 0).UTC(),
+		}
+
+		simulation.RandomizedGenState(&simState)
+
+		var mintGenesis types.GenesisState
+		simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &mintGenesis)
+
+		require.NoError(t, types.ValidateGenesis(mintGenesis), "seed %d", seed)
+		require.Equal(t, types.DefaultInitialMinter().Inflation, mintGenesis.Minter.Inflation)
+	}
+}
@@ -0,0 +1,92 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/galaxynetwork/galaxy/x/mint/keeper"
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgWithdrawDeveloperRewards = "op_weight_msg_withdraw_developer_rewards" //nolint:gosec
+
+	DefaultWeightMsgWithdrawDeveloperRewards = 50
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simulation.WeightedOperations {
+	var weightMsgWithdrawDeveloperRewards int
+	appParams.GetOrGenerate(cdc, OpWeightMsgWithdrawDeveloperRewards, &weightMsgWithdrawDeveloperRewards, nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdrawDeveloperRewards = DefaultWeightMsgWithdrawDeveloperRewards
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgWithdrawDeveloperRewards,
+			SimulateMsgWithdrawDeveloperRewards(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgWithdrawDeveloperRewards generates a MsgWithdrawDeveloperRewards
+// for a random developer rewards receiver with vested rewards.
+func SimulateMsgWithdrawDeveloperRewards(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		receivers := k.GetParams(ctx).WeightedDeveloperRewardsReceivers
+		if len(receivers) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawDeveloperRewards, "no developer rewards receivers"), nil, nil
+		}
+
+		receiver := receivers[r.Intn(len(receivers))]
+		if k.GetVestedDeveloperRewards(ctx, receiver.Address).Withdrawable().IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawDeveloperRewards, "no withdrawable developer rewards"), nil, nil
+		}
+
+		receiverAddr, err := sdk.AccAddressFromBech32(receiver.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawDeveloperRewards, "invalid receiver address"), nil, err
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, receiverAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawDeveloperRewards, "receiver is not a simulation account"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		if account == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawDeveloperRewards, "receiver account does not exist"), nil, nil
+		}
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		msg := types.NewMsgWithdrawDeveloperRewards(simAccount.Address)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -0,0 +1,50 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation. Params validated against each other, like the phases,
+// are left out as a param change proposal validates a single param.
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyBlocksPerYear),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenBlocksPerYear(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyDistributionProportions),
+			func(r *rand.Rand) string {
+				proportions := GenDistributionProportions(r)
+				return fmt.Sprintf(
+					`{"staking":"%s","ecosystem_incentives":"%s","developer_rewards":"%s","community_pool":"%s"}`,
+					proportions.Staking, proportions.EcosystemIncentives, proportions.DeveloperRewards, proportions.CommunityPool,
+				)
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyGoalBonded),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenGoalBonded(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyInflationRateChange),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenInflationRateChange(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyFeeBurnRatio),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenFeeBurnRatio(r))
+			},
+		),
+	}
+}
@@ -0,0 +1,56 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/galaxynetwork/galaxy/x/mint/keeper"
+	"github.com/galaxynetwork/galaxy/x/mint/types"
+)
+
+// Simulation proposal weights constants
+const (
+	OpWeightSubmitEcosystemPoolSpendProposal = "op_weight_submit_ecosystem_pool_spend_proposal" //nolint:gosec
+
+	DefaultWeightEcosystemPoolSpendProposal = 5
+)
+
+// ProposalContents defines the module weighted proposals' contents
+func ProposalContents(k keeper.Keeper) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitEcosystemPoolSpendProposal,
+			DefaultWeightEcosystemPoolSpendProposal,
+			SimulateEcosystemPoolSpendProposalContent(k),
+		),
+	}
+}
+
+// SimulateEcosystemPoolSpendProposalContent generates random ecosystem pool
+// spend proposal content
+func SimulateEcosystemPoolSpendProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		balance := k.GetEcosystemPool(ctx)
+		if balance.Empty() {
+			return nil
+		}
+
+		denomIndex := r.Intn(len(balance))
+		amount, err := simtypes.RandPositiveInt(r, balance[denomIndex].Amount)
+		if err != nil {
+			return nil
+		}
+
+		return types.NewEcosystemPoolSpendProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			simAccount.Address,
+			sdk.NewCoins(sdk.NewCoin(balance[denomIndex].Denom, amount)),
+		)
+	}
+}
@@ -3,9 +3,65 @@ package types
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "galaxy/mint/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgWithdrawDeveloperRewards{}, "galaxy/mint/MsgWithdrawDeveloperRewards", nil)
+	cdc.RegisterConcrete(&MsgClawbackDeveloperRewards{}, "galaxy/mint/MsgClawbackDeveloperRewards", nil)
+	cdc.RegisterConcrete(&MsgAddDeveloperReceiver{}, "galaxy/mint/MsgAddDeveloperReceiver", nil)
+	cdc.RegisterConcrete(&MsgRemoveDeveloperReceiver{}, "galaxy/mint/MsgRemoveDeveloperReceiver", nil)
+	cdc.RegisterConcrete(&MsgReplaceDeveloperReceiver{}, "galaxy/mint/MsgReplaceDeveloperReceiver", nil)
+	cdc.RegisterConcrete(&MsgReweightDeveloperReceiver{}, "galaxy/mint/MsgReweightDeveloperReceiver", nil)
+	cdc.RegisterConcrete(&MsgSetPaused{}, "galaxy/mint/MsgSetPaused", nil)
+	cdc.RegisterConcrete(&EcosystemPoolSpendProposal{}, "galaxy/mint/EcosystemPoolSpendProposal", nil)
+	cdc.RegisterConcrete(&UpdateMintParamsProposal{}, "galaxy/mint/UpdateMintParamsProposal", nil)
+	cdc.RegisterConcrete(&SetMintPausedProposal{}, "galaxy/mint/SetMintPausedProposal", nil)
+	cdc.RegisterConcrete(&ReweightDeveloperReceiverProposal{}, "galaxy/mint/ReweightDeveloperReceiverProposal", nil)
+	cdc.RegisterConcrete(&ReplaceDeveloperReceiverProposal{}, "galaxy/mint/ReplaceDeveloperReceiverProposal", nil)
+	cdc.RegisterConcrete(&RemoveDeveloperReceiverProposal{}, "galaxy/mint/RemoveDeveloperReceiverProposal", nil)
+	cdc.RegisterConcrete(&AddDeveloperReceiverProposal{}, "galaxy/mint/AddDeveloperReceiverProposal", nil)
+	cdc.RegisterConcrete(&ClawbackDeveloperRewardsProposal{}, "galaxy/mint/ClawbackDeveloperRewardsProposal", nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+		&MsgWithdrawDeveloperRewards{},
+		&MsgClawbackDeveloperRewards{},
+		&MsgAddDeveloperReceiver{},
+		&MsgRemoveDeveloperReceiver{},
+		&MsgReplaceDeveloperReceiver{},
+		&MsgReweightDeveloperReceiver{},
+		&MsgSetPaused{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&EcosystemPoolSpendProposal{},
+		&UpdateMintParamsProposal{},
+		&SetMintPausedProposal{},
+		&ReweightDeveloperReceiverProposal{},
+		&ReplaceDeveloperReceiverProposal{},
+		&RemoveDeveloperReceiverProposal{},
+		&AddDeveloperReceiverProposal{},
+		&ClawbackDeveloperRewardsProposal{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
 var (
 	Amino     = codec.NewLegacyAmino()
 	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
 )
+
+func init() {
+	RegisterLegacyAminoCodec(Amino)
+	cryptocodec.RegisterCrypto(Amino)
+	Amino.Seal()
+}
@@ -0,0 +1,151 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AddDeveloperReceiver returns the params with the receiver added. The weights
+// of the other receivers are scaled down proportionally to make room for it.
+func (p Params) AddDeveloperReceiver(receiver DevloperWeightedAddress) (Params, error) {
+	if p.developerReceiverIndex(receiver.Address) >= 0 {
+		return p, sdkerrors.Wrapf(ErrDeveloperReceiverExists, "receiver %s", receiver.Address)
+	}
+
+	receivers := rescaleWeights(p.WeightedDeveloperRewardsReceivers, sdk.OneDec().Sub(receiver.Weight))
+	p.WeightedDeveloperRewardsReceivers = append(receivers, receiver)
+
+	return p, validateWeightedDeveloperRewardsReceivers(p.WeightedDeveloperRewardsReceivers)
+}
+
+// RemoveDeveloperReceiver returns the params with every entry of the receiver
+// removed. Its weight goes to the remaining receivers or its share of the
+// developer rewards proportion goes to the community pool proportion.
+func (p Params) RemoveDeveloperReceiver(address string, redistribution WeightRedistribution) (Params, error) {
+	removed := sdk.ZeroDec()
+	remaining := []DevloperWeightedAddress{}
+	for _, receiver := range p.WeightedDeveloperRewardsReceivers {
+		if receiver.Address == address {
+			removed = removed.Add(receiver.Weight)
+			continue
+		}
+		remaining = append(remaining, receiver)
+	}
+	if len(remaining) == len(p.WeightedDeveloperRewardsReceivers) {
+		return p, sdkerrors.Wrapf(ErrDeveloperReceiverNotFound, "receiver %s", address)
+	}
+
+	switch redistribution {
+	case RedistributeToReceivers:
+		if len(remaining) == 0 {
+			return p, fmt.Errorf("no receiver left to redistribute the weight of %s to", address)
+		}
+
+	case RedistributeToCommunityPool:
+		proportions := p.DistributionProportions
+		moved := proportions.DeveloperRewards.Mul(removed)
+		if len(remaining) == 0 {
+			moved = proportions.DeveloperRewards
+		}
+		proportions.DeveloperRewards = proportions.DeveloperRewards.Sub(moved)
+		proportions.CommunityPool = proportions.CommunityPool.Add(moved)
+		if err := validateDistributionProportions(proportions); err != nil {
+			return p, err
+		}
+		p.DistributionProportions = proportions
+
+	default:
+		return p, fmt.Errorf("invalid weight redistribution: %s", redistribution)
+	}
+
+	p.WeightedDeveloperRewardsReceivers = rescaleWeights(remaining, sdk.OneDec())
+
+	return p, validateWeightedDeveloperRewardsReceivers(p.WeightedDeveloperRewardsReceivers)
+}
+
+// ReplaceDeveloperReceiver returns the params with the address of every entry
+// of the receiver replaced, keeping their weights and vesting.
+func (p Params) ReplaceDeveloperReceiver(address, newAddress string) (Params, error) {
+	if p.developerReceiverIndex(address) < 0 {
+		return p, sdkerrors.Wrapf(ErrDeveloperReceiverNotFound, "receiver %s", address)
+	}
+	if p.developerReceiverIndex(newAddress) >= 0 {
+		return p, sdkerrors.Wrapf(ErrDeveloperReceiverExists, "receiver %s", newAddress)
+	}
+
+	receivers := make([]DevloperWeightedAddress, len(p.WeightedDeveloperRewardsReceivers))
+	copy(receivers, p.WeightedDeveloperRewardsReceivers)
+	for i := range receivers {
+		if receivers[i].Address == address {
+			receivers[i].Address = newAddress
+		}
+	}
+	p.WeightedDeveloperRewardsReceivers = receivers
+
+	return p, validateWeightedDeveloperRewardsReceivers(p.WeightedDeveloperRewardsReceivers)
+}
+
+// ReweightDeveloperReceiver returns the params with the weight of the receiver
+// set. The weights of the other receivers are scaled proportionally so that
+// the weights still sum to one.
+func (p Params) ReweightDeveloperReceiver(address string, weight sdk.Dec) (Params, error) {
+	index := p.developerReceiverIndex(address)
+	if index < 0 {
+		return p, sdkerrors.Wrapf(ErrDeveloperReceiverNotFound, "receiver %s", address)
+	}
+
+	others := []DevloperWeightedAddress{}
+	for i, receiver := range p.WeightedDeveloperRewardsReceivers {
+		if i != index {
+			others = append(others, receiver)
+		}
+	}
+	others = rescaleWeights(others, sdk.OneDec().Sub(weight))
+
+	receiver := p.WeightedDeveloperRewardsReceivers[index]
+	receiver.Weight = weight
+	receivers := append([]DevloperWeightedAddress{}, others[:index]...)
+	receivers = append(receivers, receiver)
+	p.WeightedDeveloperRewardsReceivers = append(receivers, others[index:]...)
+
+	return p, validateWeightedDeveloperRewardsReceivers(p.WeightedDeveloperRewardsReceivers)
+}
+
+// developerReceiverIndex returns the index of the first entry of the receiver,
+// or -1 if it is not a receiver.
+func (p Params) developerReceiverIndex(address string) int {
+	for i, receiver := range p.WeightedDeveloperRewardsReceivers {
+		if receiver.Address == address {
+			return i
+		}
+	}
+	return -1
+}
+
+// rescaleWeights returns a copy of the receivers with their weights scaled
+// proportionally to sum to total. The rounding difference goes to the last
+// receiver so that the sum is exact.
+func rescaleWeights(receivers []DevloperWeightedAddress, total sdk.Dec) []DevloperWeightedAddress {
+	rescaled := make([]DevloperWeightedAddress, len(receivers))
+	copy(rescaled, receivers)
+
+	sum := sdk.ZeroDec()
+	for _, receiver := range receivers {
+		sum = sum.Add(receiver.Weight)
+	}
+	if len(rescaled) == 0 || !sum.IsPositive() {
+		return rescaled
+	}
+
+	scaled := sdk.ZeroDec()
+	for i := range rescaled {
+		rescaled[i].Weight = rescaled[i].Weight.Mul(total).Quo(sum)
+		scaled = scaled.Add(rescaled[i].Weight)
+	}
+	last := len(rescaled) - 1
+	rescaled[last].Weight = rescaled[last].Weight.Add(total.Sub(scaled))
+
+	return rescaled
+}
@@ -0,0 +1,148 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDeveloperReceiverUpdates(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1---")).String()
+	addr2 := sdk.AccAddress([]byte("addr2---")).String()
+	addr3 := sdk.AccAddress([]byte("addr3---")).String()
+	receiver := func(address, weight string) DevloperWeightedAddress {
+		return DevloperWeightedAddress{Address: address, Weight: sdk.MustNewDecFromStr(weight)}
+	}
+
+	params := DefaultParams()
+	params.WeightedDeveloperRewardsReceivers = []DevloperWeightedAddress{receiver(addr1, "0.6"), receiver(addr2, "0.4")}
+	require.NoError(t, params.Validate())
+
+	weights := func(p Params) []string {
+		res := []string{}
+		for _, r := range p.WeightedDeveloperRewardsReceivers {
+			res = append(res, r.Address+"="+r.Weight.String())
+		}
+		return res
+	}
+
+	tests := []struct {
+		name      string
+		update    func() (Params, error)
+		expected  []string
+		expectErr bool
+	}{
+		{
+			"add receiver",
+			func() (Params, error) { return params.AddDeveloperReceiver(receiver(addr3, "0.5")) },
+			[]string{addr1 + "=0.300000000000000000", addr2 + "=0.200000000000000000", addr3 + "=0.500000000000000000"},
+			false,
+		},
+		{
+			"add existing receiver",
+			func() (Params, error) { return params.AddDeveloperReceiver(receiver(addr1, "0.5")) },
+			nil,
+			true,
+		},
+		{
+			"add receiver with all the weight",
+			func() (Params, error) { return params.AddDeveloperReceiver(receiver(addr3, "1")) },
+			nil,
+			true,
+		},
+		{
+			"remove receiver",
+			func() (Params, error) { return params.RemoveDeveloperReceiver(addr1, RedistributeToReceivers) },
+			[]string{addr2 + "=1.000000000000000000"},
+			false,
+		},
+		{
+			"remove last receiver to receivers",
+			func() (Params, error) {
+				single := params
+				single.WeightedDeveloperRewardsReceivers = []DevloperWeightedAddress{receiver(addr1, "1")}
+				return single.RemoveDeveloperReceiver(addr1, RedistributeToReceivers)
+			},
+			nil,
+			true,
+		},
+		{
+			"remove unknown receiver",
+			func() (Params, error) { return params.RemoveDeveloperReceiver(addr3, RedistributeToReceivers) },
+			nil,
+			true,
+		},
+		{
+			"replace receiver",
+			func() (Params, error) { return params.ReplaceDeveloperReceiver(addr1, addr3) },
+			[]string{addr3 + "=0.600000000000000000", addr2 + "=0.400000000000000000"},
+			false,
+		},
+		{
+			"replace with existing receiver",
+			func() (Params, error) { return params.ReplaceDeveloperReceiver(addr1, addr2) },
+			nil,
+			true,
+		},
+		{
+			"reweight receiver",
+			func() (Params, error) { return params.ReweightDeveloperReceiver(addr2, sdk.MustNewDecFromStr("0.7")) },
+			[]string{addr1 + "=0.300000000000000000", addr2 + "=0.700000000000000000"},
+			false,
+		},
+		{
+			"reweight unknown receiver",
+			func() (Params, error) { return params.ReweightDeveloperReceiver(addr3, sdk.MustNewDecFromStr("0.7")) },
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			updated, err := tc.update()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, updated.Validate())
+			require.Equal(t, tc.expected, weights(updated))
+		})
+	}
+
+	// the original params are left untouched
+	require.Equal(t, []string{addr1 + "=0.600000000000000000", addr2 + "=0.400000000000000000"}, weights(params))
+}
+
+func TestRemoveDeveloperReceiverToCommunityPool(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1---")).String()
+	addr2 := sdk.AccAddress([]byte("addr2---")).String()
+
+	params := DefaultParams()
+	params.WeightedDeveloperRewardsReceivers = []DevloperWeightedAddress{
+		{Address: addr1, Weight: sdk.NewDecWithPrec(25, 2)},
+		{Address: addr2, Weight: sdk.NewDecWithPrec(75, 2)},
+	}
+	proportions := params.DistributionProportions
+
+	updated, err := params.RemoveDeveloperReceiver(addr1, RedistributeToCommunityPool)
+	require.NoError(t, err)
+	require.NoError(t, updated.Validate())
+
+	// the share of addr2 is unchanged
+	moved := proportions.DeveloperRewards.Mul(sdk.NewDecWithPrec(25, 2))
+	require.Equal(t, proportions.DeveloperRewards.Sub(moved), updated.DistributionProportions.DeveloperRewards)
+	require.Equal(t, proportions.CommunityPool.Add(moved), updated.DistributionProportions.CommunityPool)
+	require.Equal(t, sdk.OneDec(), updated.WeightedDeveloperRewardsReceivers[0].Weight)
+
+	// removing the last receiver moves the whole developer rewards proportion
+	updated, err = updated.RemoveDeveloperReceiver(addr2, RedistributeToCommunityPool)
+	require.NoError(t, err)
+	require.NoError(t, updated.Validate())
+	require.Empty(t, updated.WeightedDeveloperRewardsReceivers)
+	require.True(t, updated.DistributionProportions.DeveloperRewards.IsZero())
+	require.Equal(t, proportions.CommunityPool.Add(proportions.DeveloperRewards), updated.DistributionProportions.CommunityPool)
+}
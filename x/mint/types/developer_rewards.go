@@ -0,0 +1,139 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Escrowed returns the rewards held by the mint module account for the
+// receiver, locked or not.
+func (r DeveloperRewards) Escrowed() sdk.Coins {
+	escrowed, _ := r.Accrued.SafeSub(r.Withdrawn.Add(r.ClawedBack...))
+	return escrowed
+}
+
+// Withdrawable returns the rewards vested but not withdrawn yet.
+func (r DeveloperRewards) Withdrawable() sdk.Coins {
+	withdrawable, _ := r.Escrowed().SafeSub(r.Locked)
+	return withdrawable
+}
+
+// Vested returns the total rewards vested so far, including withdrawn rewards.
+func (r DeveloperRewards) Vested() sdk.Coins {
+	vested, _ := r.Accrued.SafeSub(r.ClawedBack.Add(r.Locked...))
+	return vested
+}
+
+// Vest releases the locked rewards that vested between the last vesting time
+// and the given time.
+func (r DeveloperRewards) Vest(vesting DeveloperVesting, blockTime time.Time) DeveloperRewards {
+	if !r.Locked.IsZero() {
+		numerator, denominator := vesting.vestedRatio(r.LastVestingTime, blockTime)
+		vested := sdk.NewCoins()
+		for _, coin := range r.Locked {
+			vested = vested.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(numerator).QuoRaw(denominator)))
+		}
+		r.Locked = r.Locked.Sub(vested)
+	}
+	if blockTime.After(r.LastVestingTime) {
+		r.LastVestingTime = blockTime
+	}
+	return r
+}
+
+// MoveLocked moves the locked rewards to the rewards of another address, along
+// with the accrued rewards they are part of. The vested rewards stay
+// withdrawable by the address.
+func (r DeveloperRewards) MoveLocked(to DeveloperRewards) (DeveloperRewards, DeveloperRewards) {
+	locked := r.Locked
+	r.Accrued = r.Accrued.Sub(locked)
+	r.Locked = sdk.NewCoins()
+
+	to.Accrued = to.Accrued.Add(locked...)
+	to.Locked = to.Locked.Add(locked...)
+	if r.LastVestingTime.After(to.LastVestingTime) {
+		to.LastVestingTime = r.LastVestingTime
+	}
+	return r, to
+}
+
+func (r DeveloperRewards) Validate() error {
+	if r.Address == "" {
+		return fmt.Errorf("developer rewards address cannot be empty")
+	}
+	for _, coins := range []sdk.Coins{r.Accrued, r.Withdrawn, r.Locked, r.ClawedBack} {
+		if err := coins.Validate(); err != nil {
+			return err
+		}
+	}
+	spent := r.Withdrawn.Add(r.ClawedBack...).Add(r.Locked...)
+	if !r.Accrued.IsAllGTE(spent) {
+		return fmt.Errorf("withdrawn, clawed back and locked %s exceed accrued %s for %s", spent, r.Accrued, r.Address)
+	}
+	return nil
+}
+
+// IsLocking returns true if rewards accrued at the given time are locked.
+func (v DeveloperVesting) IsLocking(blockTime time.Time) bool {
+	return v.Type != VestingNone && blockTime.Before(v.EndTime)
+}
+
+// vestedRatio returns the ratio of the rewards locked at from that vests by
+// to, as a numerator and a denominator.
+func (v DeveloperVesting) vestedRatio(from, to time.Time) (int64, int64) {
+	if v.Type == VestingNone || !to.Before(v.EndTime) {
+		return 1, 1
+	}
+
+	switch v.Type {
+	case VestingContinuous:
+		if from.Before(v.StartTime) {
+			from = v.StartTime
+		}
+		if !to.After(from) {
+			return 0, 1
+		}
+		return int64(to.Sub(from)), int64(v.EndTime.Sub(from))
+
+	case VestingPeriodic:
+		done := v.completedPeriods(from)
+		now := v.completedPeriods(to)
+		if now <= done {
+			return 0, 1
+		}
+		// the last period may be shorter and ends at end time
+		total := int64((v.EndTime.Sub(v.StartTime) + v.Period - 1) / v.Period)
+		return now - done, total - done
+	}
+
+	return 0, 1
+}
+
+// completedPeriods returns the number of vesting periods completed at the
+// given time.
+func (v DeveloperVesting) completedPeriods(t time.Time) int64 {
+	if !t.After(v.StartTime) {
+		return 0
+	}
+	return int64(t.Sub(v.StartTime) / v.Period)
+}
+
+func (v DeveloperVesting) Validate() error {
+	switch v.Type {
+	case VestingNone:
+		return nil
+	case VestingContinuous, VestingPeriodic:
+	default:
+		return fmt.Errorf("invalid vesting type: %s", v.Type)
+	}
+
+	if !v.EndTime.After(v.StartTime) {
+		return fmt.Errorf("vesting end time must be after start time")
+	}
+	if v.Type == VestingPeriodic && v.Period <= 0 {
+		return fmt.Errorf("vesting period must be positive: %s", v.Period)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDeveloperVestingValidate(t *testing.T) {
+	start := time.Unix(1_000_000, 0).UTC()
+
+	tests := []struct {
+		name      string
+		vesting   DeveloperVesting
+		expectErr bool
+	}{
+		{"no vesting", DeveloperVesting{}, false},
+		{"continuous", DeveloperVesting{Type: VestingContinuous, StartTime: start, EndTime: start.Add(time.Hour)}, false},
+		{"periodic", DeveloperVesting{Type: VestingPeriodic, StartTime: start, EndTime: start.Add(time.Hour), Period: time.Minute}, false},
+		{"end before start", DeveloperVesting{Type: VestingContinuous, StartTime: start, EndTime: start}, true},
+		{"periodic without period", DeveloperVesting{Type: VestingPeriodic, StartTime: start, EndTime: start.Add(time.Hour)}, true},
+		{"unknown type", DeveloperVesting{Type: 3, StartTime: start, EndTime: start.Add(time.Hour)}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.vesting.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeveloperRewardsVest(t *testing.T) {
+	start := time.Unix(1_000_000, 0).UTC()
+	locked := sdk.NewCoins(sdk.NewInt64Coin(DefaultMintDenom, 1_000))
+	rewards := DeveloperRewards{Address: "addr", Accrued: locked, Locked: locked, LastVestingTime: start}
+
+	continuous := DeveloperVesting{Type: VestingContinuous, StartTime: start, EndTime: start.Add(100 * time.Second)}
+	periodic := DeveloperVesting{Type: VestingPeriodic, StartTime: start, EndTime: start.Add(100 * time.Second), Period: 30 * time.Second}
+
+	tests := []struct {
+		name         string
+		vesting      DeveloperVesting
+		elapsed      []time.Duration
+		expectLocked int64
+	}{
+		{"continuous before start", continuous, []time.Duration{0}, 1_000},
+		{"continuous halfway", continuous, []time.Duration{50 * time.Second}, 500},
+		{"continuous in steps", continuous, []time.Duration{25 * time.Second, 50 * time.Second}, 500},
+		{"continuous after end", continuous, []time.Duration{101 * time.Second}, 0},
+		{"periodic within first period", periodic, []time.Duration{29 * time.Second}, 1_000},
+		{"periodic after first period", periodic, []time.Duration{30 * time.Second}, 750},
+		{"periodic in steps", periodic, []time.Duration{30 * time.Second, 65 * time.Second}, 500},
+		{"periodic last period", periodic, []time.Duration{95 * time.Second}, 250},
+		{"periodic after end", periodic, []time.Duration{100 * time.Second}, 0},
+		{"no vesting", DeveloperVesting{}, []time.Duration{time.Second}, 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := rewards
+			for _, elapsed := range tc.elapsed {
+				r = r.Vest(tc.vesting, start.Add(elapsed))
+			}
+			require.Equal(t, sdk.NewInt(tc.expectLocked), r.Locked.AmountOf(DefaultMintDenom))
+			require.Equal(t, locked, r.Vested().Add(r.Locked...))
+			require.Equal(t, r.Vested(), r.Withdrawable())
+			require.NoError(t, r.Validate())
+		})
+	}
+}
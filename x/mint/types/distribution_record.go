@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewDistributionRecord(phase uint64) DistributionRecord {
+	return DistributionRecord{
+		Phase:               phase,
+		Minted:              sdk.NewCoins(),
+		Staking:             sdk.NewCoins(),
+		EcosystemIncentives: sdk.NewCoins(),
+		CommunityPool:       sdk.NewCoins(),
+		DeveloperRewards:    []ReceiverDistribution{},
+	}
+}
+
+// Add adds the distributed coins of other to the record, keeping the phase of
+// the record.
+func (r DistributionRecord) Add(other DistributionRecord) DistributionRecord {
+	r.Minted = r.Minted.Add(other.Minted...)
+	r.Staking = r.Staking.Add(other.Staking...)
+	r.EcosystemIncentives = r.EcosystemIncentives.Add(other.EcosystemIncentives...)
+	r.CommunityPool = r.CommunityPool.Add(other.CommunityPool...)
+	for _, receiver := range other.DeveloperRewards {
+		r = r.AddDeveloperRewards(receiver.Address, receiver.Amount)
+	}
+	return r
+}
+
+// AddDeveloperRewards adds coins accrued to a developer rewards receiver.
+func (r DistributionRecord) AddDeveloperRewards(address string, amount sdk.Coins) DistributionRecord {
+	receivers := make([]ReceiverDistribution, len(r.DeveloperRewards), len(r.DeveloperRewards)+1)
+	copy(receivers, r.DeveloperRewards)
+	r.DeveloperRewards = receivers
+
+	for i, receiver := range r.DeveloperRewards {
+		if receiver.Address == address {
+			r.DeveloperRewards[i].Amount = receiver.Amount.Add(amount...)
+			return r
+		}
+	}
+	r.DeveloperRewards = append(r.DeveloperRewards, ReceiverDistribution{Address: address, Amount: amount})
+	return r
+}
+
+func (r DistributionRecord) Validate() error {
+	if r.Phase == 0 {
+		return fmt.Errorf("distribution record phase must be positive")
+	}
+	for _, coins := range []sdk.Coins{r.Minted, r.Staking, r.EcosystemIncentives, r.CommunityPool} {
+		if err := coins.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seen := make(map[string]bool, len(r.DeveloperRewards))
+	for _, receiver := range r.DeveloperRewards {
+		if seen[receiver.Address] {
+			return fmt.Errorf("duplicate developer rewards receiver %s in phase %d", receiver.Address, r.Phase)
+		}
+		seen[receiver.Address] = true
+		if err := receiver.Amount.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func InitialDistributionRemainder() DistributionRemainder {
+	return DistributionRemainder{
+		Staking:             sdk.ZeroDec(),
+		EcosystemIncentives: sdk.ZeroDec(),
+		CommunityPool:       sdk.ZeroDec(),
+		DeveloperRewards:    []ReceiverRemainder{},
+		Undistributed:       sdk.ZeroInt(),
+	}
+}
+
+// Distribute splits the minted coin between the destinations of the
+// distribution proportions and returns the shares along with the next
+// remainder. The fraction truncated from each share is carried into the next
+// distribution, and the units the fractions add up to are held back until a
+// destination is owed them, so every destination receives its exact share of
+// all minted coins, truncated to the unit.
+func (r DistributionRemainder) Distribute(minted sdk.Coin, params Params) (DistributionRecord, DistributionRemainder) {
+	proportions := params.DistributionProportions
+	receivers := params.WeightedDeveloperRewardsReceivers
+
+	record := NewDistributionRecord(0)
+	record.Minted = sdk.NewCoins(minted)
+	next := InitialDistributionRemainder()
+	next.Undistributed = minted.Amount
+	if !r.Undistributed.IsNil() {
+		next.Undistributed = next.Undistributed.Add(r.Undistributed)
+	}
+
+	share := func(ratio, remainder sdk.Dec) (sdk.Coins, sdk.Dec) {
+		exact := ratio.MulInt(minted.Amount)
+		if !remainder.IsNil() {
+			exact = exact.Add(remainder)
+		}
+		// never pay out more than held back
+		amount := sdk.MinInt(exact.TruncateInt(), next.Undistributed)
+		next.Undistributed = next.Undistributed.Sub(amount)
+		return sdk.NewCoins(sdk.NewCoin(minted.Denom, amount)), exact.Sub(amount.ToDec())
+	}
+
+	// the community pool takes the rest of the ratios, including the developer
+	// rewards when there is no receiver, so that the ratios add up to one
+	communityPoolRatio := sdk.OneDec().Sub(proportions.Staking).Sub(proportions.EcosystemIncentives)
+	communityPoolRemainder := sdk.ZeroDec()
+	if !r.CommunityPool.IsNil() {
+		communityPoolRemainder = r.CommunityPool
+	}
+
+	record.Staking, next.Staking = share(proportions.Staking, r.Staking)
+	record.EcosystemIncentives, next.EcosystemIncentives = share(proportions.EcosystemIncentives, r.EcosystemIncentives)
+
+	carried := make([]bool, len(r.DeveloperRewards))
+	for _, receiver := range receivers {
+		// rewards without address are funded to the community pool
+		if receiver.Address == "" {
+			continue
+		}
+
+		// receivers listed twice keep a remainder each
+		remainder := sdk.ZeroDec()
+		for i, prev := range r.DeveloperRewards {
+			if !carried[i] && prev.Address == receiver.Address {
+				remainder = prev.Remainder
+				carried[i] = true
+				break
+			}
+		}
+
+		ratio := proportions.DeveloperRewards.Mul(receiver.Weight)
+		communityPoolRatio = communityPoolRatio.Sub(ratio)
+
+		rewards, rest := share(ratio, remainder)
+		record = record.AddDeveloperRewards(receiver.Address, rewards)
+		next.DeveloperRewards = append(next.DeveloperRewards, ReceiverRemainder{Address: receiver.Address, Remainder: rest})
+	}
+
+	// remainders of former receivers go to the community pool
+	for i, carry := range carried {
+		if !carry && !r.DeveloperRewards[i].Remainder.IsNil() {
+			communityPoolRemainder = communityPoolRemainder.Add(r.DeveloperRewards[i].Remainder)
+		}
+	}
+
+	record.CommunityPool, next.CommunityPool = share(communityPoolRatio, communityPoolRemainder)
+
+	return record, next
+}
+
+func (r DistributionRemainder) Validate() error {
+	for _, remainder := range []sdk.Dec{r.Staking, r.EcosystemIncentives, r.CommunityPool} {
+		if remainder.IsNil() || remainder.IsNegative() {
+			return fmt.Errorf("distribution remainder cannot be negative: %s", remainder)
+		}
+	}
+	for _, receiver := range r.DeveloperRewards {
+		if receiver.Remainder.IsNil() || receiver.Remainder.IsNegative() {
+			return fmt.Errorf("distribution remainder of %s cannot be negative: %s", receiver.Address, receiver.Remainder)
+		}
+	}
+	if r.Undistributed.IsNil() || r.Undistributed.IsNegative() {
+		return fmt.Errorf("undistributed amount cannot be negative: %s", r.Undistributed)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDistributionRemainderDistribute(t *testing.T) {
+	receiver1 := sdk.AccAddress([]byte("addr1---")).String()
+	receiver2 := sdk.AccAddress([]byte("addr2---")).String()
+	receiver3 := sdk.AccAddress([]byte("addr3---")).String()
+
+	params := DefaultParams()
+	params.DistributionProportions = DistributionProportions{
+		Staking:             sdk.NewDecWithPrec(333, 3),
+		EcosystemIncentives: sdk.NewDecWithPrec(333, 3),
+		DeveloperRewards:    sdk.NewDecWithPrec(257, 3),
+		CommunityPool:       sdk.NewDecWithPrec(77, 3),
+	}
+	params.WeightedDeveloperRewardsReceivers = []DevloperWeightedAddress{
+		{Address: receiver1, Weight: sdk.NewDecWithPrec(1, 1)},
+		{Address: receiver2, Weight: sdk.NewDecWithPrec(2, 1)},
+		{Address: receiver3, Weight: sdk.NewDecWithPrec(7, 1)},
+	}
+	require.NoError(t, params.Validate())
+
+	remainder := InitialDistributionRemainder()
+	total := NewDistributionRecord(0)
+	minted := sdk.ZeroInt()
+	for i := int64(1); i <= 1000; i++ {
+		amount := sdk.NewInt64Coin(params.MintDenom, i*7%13)
+		available := amount.Amount.Add(remainder.Undistributed)
+		var record DistributionRecord
+		record, remainder = remainder.Distribute(amount, params)
+		total = total.Add(record)
+		minted = minted.Add(amount.Amount)
+
+		// only the minted coins and the units held back are paid out
+		paid := record.Staking.Add(record.EcosystemIncentives...).Add(record.CommunityPool...)
+		for _, r := range record.DeveloperRewards {
+			paid = paid.Add(r.Amount...)
+		}
+		require.Equal(t, available, paid.AmountOf(params.MintDenom).Add(remainder.Undistributed))
+		require.NoError(t, remainder.Validate())
+	}
+
+	// every share is exact to the unit
+	exact := func(ratio sdk.Dec) sdk.Int {
+		return ratio.MulInt(minted).TruncateInt()
+	}
+	proportions := params.DistributionProportions
+	require.Equal(t, exact(proportions.Staking), total.Staking.AmountOf(params.MintDenom))
+	require.Equal(t, exact(proportions.EcosystemIncentives), total.EcosystemIncentives.AmountOf(params.MintDenom))
+	require.Equal(t, exact(proportions.CommunityPool), total.CommunityPool.AmountOf(params.MintDenom))
+	paid := total.Staking.Add(total.EcosystemIncentives...).Add(total.CommunityPool...)
+	for i, r := range total.DeveloperRewards {
+		weight := params.WeightedDeveloperRewardsReceivers[i].Weight
+		require.Equal(t, exact(proportions.DeveloperRewards.Mul(weight)), r.Amount.AmountOf(params.MintDenom), r.Address)
+		paid = paid.Add(r.Amount...)
+	}
+
+	// the units held back are less than the number of destinations
+	require.Equal(t, minted, paid.AmountOf(params.MintDenom).Add(remainder.Undistributed))
+	require.True(t, remainder.Undistributed.LT(sdk.NewInt(6)))
+
+	// the remainder of a former receiver goes to the community pool
+	params.WeightedDeveloperRewardsReceivers = params.WeightedDeveloperRewardsReceivers[1:]
+	params.WeightedDeveloperRewardsReceivers[0].Weight = sdk.NewDecWithPrec(3, 1)
+	require.NoError(t, params.Validate())
+
+	communityPool := remainder.CommunityPool.Add(remainder.DeveloperRewards[0].Remainder)
+	record, next := remainder.Distribute(sdk.NewInt64Coin(params.MintDenom, 0), params)
+	require.Equal(t, communityPool.TruncateInt().String(), record.CommunityPool.AmountOf(params.MintDenom).String())
+	require.Equal(t, communityPool.Sub(communityPool.TruncateInt().ToDec()).String(), next.CommunityPool.String())
+
+	// the remaining receivers keep their remainders
+	require.Len(t, next.DeveloperRewards, 2)
+	for i, r := range next.DeveloperRewards {
+		require.Equal(t, remainder.DeveloperRewards[i+1], r)
+	}
+}
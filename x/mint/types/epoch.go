@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewEpoch(number uint64, startHeight int64, startTime time.Time) Epoch {
+	return Epoch{
+		Number:      number,
+		StartHeight: startHeight,
+		StartTime:   startTime,
+		Provision:   sdk.ZeroInt(),
+	}
+}
+
+// InitialEpoch returns the first epoch, which starts at genesis.
+func InitialEpoch() Epoch {
+	return NewEpoch(1, 0, time.Time{})
+}
+
+// Accumulate adds the provision of a block to the epoch.
+func (e Epoch) Accumulate(provision sdk.Int) Epoch {
+	e.Provision = e.Provision.Add(provision)
+	e.Blocks++
+	return e
+}
+
+// Next returns the epoch following the epoch, starting at the given block.
+func (e Epoch) Next(startHeight int64, startTime time.Time) Epoch {
+	return NewEpoch(e.Number+1, startHeight, startTime)
+}
+
+func (e Epoch) Validate() error {
+	if e.Number == 0 {
+		return fmt.Errorf("epoch number must be positive")
+	}
+	if e.Provision.IsNil() || e.Provision.IsNegative() {
+		return fmt.Errorf("epoch provision cannot be negative: %s", e.Provision)
+	}
+	return nil
+}
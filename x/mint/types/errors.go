@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/mint module sentinel errors
+var (
+	ErrInvalidAuthority          = sdkerrors.Register(ModuleName, 2, "invalid authority")
+	ErrInvalidMaxSupply          = sdkerrors.Register(ModuleName, 3, "max supply is below the current supply")
+	ErrNoDeveloperRewards        = sdkerrors.Register(ModuleName, 4, "no developer rewards to withdraw")
+	ErrNoLockedDeveloperRewards  = sdkerrors.Register(ModuleName, 5, "no locked developer rewards to claw back")
+	ErrInvalidProposalAmount     = sdkerrors.Register(ModuleName, 6, "invalid ecosystem pool spend proposal amount")
+	ErrEmptyProposalRecipient    = sdkerrors.Register(ModuleName, 7, "invalid ecosystem pool spend proposal recipient")
+	ErrDeveloperReceiverNotFound = sdkerrors.Register(ModuleName, 8, "developer rewards receiver not found")
+	ErrDeveloperReceiverExists   = sdkerrors.Register(ModuleName, 9, "developer rewards receiver already exists")
+	ErrAlreadyPaused             = sdkerrors.Register(ModuleName, 10, "already paused")
+	ErrNotPaused                 = sdkerrors.Register(ModuleName, 11, "not paused")
+)
@@ -2,8 +2,35 @@ package types
 
 // Minting module event types
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint                     = ModuleName
+	EventTypeUpdateParams             = "update_params"
+	EventTypeWithdrawDeveloperRewards = "withdraw_developer_rewards"
+	EventTypeClawbackDeveloperRewards = "clawback_developer_rewards"
+	EventTypeUpdateDeveloperReceivers = "update_developer_receivers"
+	EventTypeSetPaused                = "set_paused"
+	EventTypeBurnFees                 = "burn_fees"
+	EventTypeDistributionFailed       = "mint_distribution_failed"
+	EventTypeEpochStart               = "mint_epoch_start"
+	EventTypeEpochEnd                 = "mint_epoch_end"
 
 	AttributeKeyInflation        = "inflation"
 	AttributeKeyAnnualProvisions = "annual_provisions"
+	AttributeKeyAuthority        = "authority"
+	AttributeKeyReceiver         = "receiver"
+	AttributeKeyNewReceiver      = "new_receiver"
+	AttributeKeyWeight           = "weight"
+	AttributeKeyPaused           = "paused"
+	AttributeKeyEpochNumber      = "epoch_number"
+	AttributeKeyStartHeight      = "start_height"
+	AttributeKeyBlocks           = "blocks"
+	AttributeKeyDestination      = "destination"
+	AttributeKeyReason           = "reason"
+)
+
+// Destinations of the minted coins
+const (
+	DestinationStaking             = "staking"
+	DestinationEcosystemIncentives = "ecosystem_incentives"
+	DestinationDeveloperRewards    = "developer_rewards"
+	DestinationCommunityPool       = "community_pool"
 )
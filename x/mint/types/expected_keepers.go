@@ -10,17 +10,31 @@ type AccountKeeper interface {
 	HasAccount(ctx sdk.Context, addr sdk.AccAddress) bool
 	SetModuleAccount(sdk.Context, types.ModuleAccountI)
 	GetModuleAccount(ctx sdk.Context, moduleName string) types.ModuleAccountI
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) types.AccountI
 }
 
 type BankKeeper interface {
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+type StakingKeeper interface {
+	BondedRatio(ctx sdk.Context) sdk.Dec
 }
 
 type DistributionKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
+
+// MintHooks event hooks for the minting of the mint denom
+type MintHooks interface {
+	AfterBlockMint(ctx sdk.Context, minted sdk.Coin)             // Must be called once per epoch, not per block, when its minted coins are distributed
+	AfterPhaseChange(ctx sdk.Context, oldPhase, newPhase uint64) // Must be called when the minter moves to a new phase
+	AfterInflationEnd(ctx sdk.Context)                           // Must be called once when inflation ends
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Record adds the fees burned in a block.
+func (b FeeBurn) Record(burned sdk.Coins) FeeBurn {
+	b.Burned = b.Burned.Add(burned...)
+	b.Blocks++
+	return b
+}
+
+// BurnPerBlock returns the average amount of the denom burned per block.
+func (b FeeBurn) BurnPerBlock(denom string) sdk.Dec {
+	if b.Blocks == 0 {
+		return sdk.ZeroDec()
+	}
+	return b.Burned.AmountOf(denom).ToDec().QuoInt64(int64(b.Blocks))
+}
+
+func (b FeeBurn) Validate() error {
+	if err := b.Burned.Validate(); err != nil {
+		return err
+	}
+	if b.Blocks == 0 && !b.Burned.IsZero() {
+		return fmt.Errorf("fees burned %s in no block", b.Burned)
+	}
+	return nil
+}
@@ -1,16 +1,26 @@
 package types
 
+import "fmt"
+
 func NewGenesisState(minter Minter, params Params) GenesisState {
 	return GenesisState{
-		Minter: minter,
-		Params: params,
+		Minter:                minter,
+		Params:                params,
+		Epoch:                 InitialEpoch(),
+		DistributionRemainder: InitialDistributionRemainder(),
+		Pause:                 MintPause{},
+		FeeBurn:               FeeBurn{},
 	}
 }
 
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
-		Minter: DefaultInitialMinter(),
+		Params:                DefaultParams(),
+		Minter:                DefaultInitialMinter(),
+		Epoch:                 InitialEpoch(),
+		DistributionRemainder: InitialDistributionRemainder(),
+		Pause:                 MintPause{},
+		FeeBurn:               FeeBurn{},
 	}
 }
 
@@ -18,5 +28,39 @@ func ValidateGenesis(data GenesisState) error {
 	if err := data.Params.Validate(); err != nil {
 		return err
 	}
-	return ValidateMinter(data.Minter)
+	if err := ValidateMinter(data.Minter); err != nil {
+		return err
+	}
+	if err := data.Epoch.Validate(); err != nil {
+		return err
+	}
+	if err := data.DistributionRemainder.Validate(); err != nil {
+		return err
+	}
+	if err := data.FeeBurn.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(data.DeveloperRewards))
+	for _, rewards := range data.DeveloperRewards {
+		if seen[rewards.Address] {
+			return fmt.Errorf("duplicate developer rewards for %s", rewards.Address)
+		}
+		seen[rewards.Address] = true
+		if err := rewards.Validate(); err != nil {
+			return err
+		}
+	}
+
+	phases := make(map[uint64]bool, len(data.DistributionRecords))
+	for _, record := range data.DistributionRecords {
+		if phases[record.Phase] {
+			return fmt.Errorf("duplicate distribution record for phase %d", record.Phase)
+		}
+		phases[record.Phase] = true
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -25,8 +25,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the galaxy module's genesis state.
 type GenesisState struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Minter Minter `protobuf:"bytes,2,opt,name=minter,proto3" json:"minter"`
+	Params                Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Minter                Minter                `protobuf:"bytes,2,opt,name=minter,proto3" json:"minter"`
+	DeveloperRewards      []DeveloperRewards    `protobuf:"bytes,3,rep,name=developer_rewards,json=developerRewards,proto3" json:"developer_rewards"`
+	DistributionRecords   []DistributionRecord  `protobuf:"bytes,4,rep,name=distribution_records,json=distributionRecords,proto3" json:"distribution_records"`
+	Epoch                 Epoch                 `protobuf:"bytes,5,opt,name=epoch,proto3" json:"epoch"`
+	DistributionRemainder DistributionRemainder `protobuf:"bytes,6,opt,name=distribution_remainder,json=distributionRemainder,proto3" json:"distribution_remainder"`
+	Pause                 MintPause             `protobuf:"bytes,7,opt,name=pause,proto3" json:"pause"`
+	FeeBurn               FeeBurn               `protobuf:"bytes,8,opt,name=fee_burn,json=feeBurn,proto3" json:"fee_burn"`
+	// number of shares of the minted coins that failed to be distributed
+	DistributionFailures uint64 `protobuf:"varint,9,opt,name=distribution_failures,json=distributionFailures,proto3" json:"distribution_failures,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +84,55 @@ func (m *GenesisState) GetMinter() Minter {
 	return Minter{}
 }
 
+func (m *GenesisState) GetDeveloperRewards() []DeveloperRewards {
+	if m != nil {
+		return m.DeveloperRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDistributionRecords() []DistributionRecord {
+	if m != nil {
+		return m.DistributionRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpoch() Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return Epoch{}
+}
+
+func (m *GenesisState) GetDistributionRemainder() DistributionRemainder {
+	if m != nil {
+		return m.DistributionRemainder
+	}
+	return DistributionRemainder{}
+}
+
+func (m *GenesisState) GetPause() MintPause {
+	if m != nil {
+		return m.Pause
+	}
+	return MintPause{}
+}
+
+func (m *GenesisState) GetFeeBurn() FeeBurn {
+	if m != nil {
+		return m.FeeBurn
+	}
+	return FeeBurn{}
+}
+
+func (m *GenesisState) GetDistributionFailures() uint64 {
+	if m != nil {
+		return m.DistributionFailures
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "galaxy.mint.GenesisState")
 }
@@ -83,21 +140,33 @@ func init() {
 func init() { proto.RegisterFile("galaxy/mint/genesis.proto", fileDescriptor_502af2cf550e3cdf) }
 
 var fileDescriptor_502af2cf550e3cdf = []byte{
-	// 214 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4c, 0x4f, 0xcc, 0x49,
-	0xac, 0xa8, 0xd4, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x86, 0x48, 0xe9, 0x81, 0xa4, 0xa4, 0x44, 0xd2, 0xf3,
-	0xd3, 0xf3, 0xc1, 0xe2, 0xfa, 0x20, 0x16, 0x44, 0x89, 0x94, 0x04, 0xb2, 0xee, 0x82, 0xc4, 0xa2,
-	0xc4, 0x5c, 0xa8, 0x66, 0x29, 0x31, 0x64, 0x19, 0x10, 0x01, 0x11, 0x57, 0x2a, 0xe1, 0xe2, 0x71,
-	0x87, 0xd8, 0x12, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc8, 0xc5, 0x06, 0xd1, 0x27, 0xc1, 0xa8,
-	0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xac, 0x87, 0x64, 0xab, 0x5e, 0x00, 0x58, 0xca, 0x89, 0xe5, 0xc4,
-	0x3d, 0x79, 0x86, 0x20, 0xa8, 0x42, 0x90, 0x16, 0x90, 0x64, 0x6a, 0x91, 0x04, 0x13, 0x16, 0x2d,
-	0xbe, 0x60, 0x29, 0x98, 0x16, 0x88, 0x42, 0x27, 0xb7, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92,
-	0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c,
-	0x96, 0x63, 0x88, 0xd2, 0x49, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87,
-	0x18, 0x93, 0x97, 0x5a, 0x52, 0x9e, 0x5f, 0x94, 0x0d, 0xe5, 0xe9, 0x57, 0x40, 0xbc, 0x50, 0x52,
-	0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0x84, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x3b,
-	0x13, 0x2a, 0x36, 0x01, 0x00, 0x00,
+	// 413 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc7, 0x13, 0x96, 0x76, 0xc3, 0xe5, 0x00, 0x5e, 0xa9, 0x4c, 0x25, 0xb2, 0x6a, 0xa7, 0x1e,
+	0x50, 0x22, 0x3a, 0xf1, 0x02, 0x15, 0x94, 0x13, 0x52, 0x15, 0x2e, 0x88, 0x4b, 0xe4, 0x34, 0xbf,
+	0x66, 0x16, 0x4d, 0x1c, 0xd9, 0x0e, 0xdb, 0xde, 0x82, 0xc7, 0xda, 0x71, 0xdc, 0x38, 0x21, 0xd4,
+	0xbe, 0x08, 0xf2, 0x9f, 0x4a, 0xc9, 0x5a, 0xed, 0x52, 0xd5, 0xfe, 0x7c, 0x3f, 0x5f, 0xff, 0x62,
+	0x19, 0xbd, 0x29, 0xe8, 0x86, 0xde, 0xde, 0xc5, 0x25, 0xab, 0x54, 0x5c, 0x40, 0x05, 0x92, 0xc9,
+	0xa8, 0x16, 0x5c, 0x71, 0x3c, 0xb0, 0x28, 0xd2, 0x68, 0x3c, 0x2c, 0x78, 0xc1, 0xcd, 0x7e, 0xac,
+	0xff, 0xd9, 0xc8, 0x98, 0xb4, 0xed, 0x9a, 0x0a, 0x5a, 0x3a, 0x79, 0x3c, 0x6a, 0x13, 0xfd, 0x63,
+	0xf7, 0x2f, 0x7f, 0x07, 0xe8, 0xc5, 0x67, 0x7b, 0xcc, 0x57, 0x45, 0x15, 0xe0, 0xf7, 0xa8, 0x6f,
+	0x45, 0xe2, 0x4f, 0xfc, 0xe9, 0x60, 0x76, 0x1e, 0xb5, 0x8e, 0x8d, 0x96, 0x06, 0xcd, 0x83, 0xfb,
+	0xbf, 0x17, 0x5e, 0xe2, 0x82, 0x5a, 0xd1, 0x10, 0x04, 0x79, 0x76, 0x44, 0xf9, 0x62, 0xd0, 0x5e,
+	0xb1, 0x41, 0xbc, 0x44, 0xaf, 0x72, 0xf8, 0x09, 0x1b, 0x5e, 0x83, 0x48, 0x05, 0xdc, 0x50, 0x91,
+	0x4b, 0x72, 0x32, 0x39, 0x99, 0x0e, 0x66, 0x6f, 0x3b, 0xf6, 0xc7, 0x7d, 0x2a, 0xb1, 0x21, 0xd7,
+	0xf3, 0x32, 0x7f, 0xb4, 0x8f, 0xbf, 0xa1, 0x61, 0xce, 0xa4, 0x12, 0x2c, 0x6b, 0x14, 0xe3, 0x55,
+	0x2a, 0x60, 0xc5, 0x75, 0x69, 0x60, 0x4a, 0x2f, 0xba, 0xa5, 0xad, 0x60, 0x62, 0x72, 0xae, 0xf6,
+	0x3c, 0x3f, 0x20, 0x12, 0x47, 0xa8, 0x07, 0x35, 0x5f, 0x5d, 0x93, 0x9e, 0xf9, 0x3a, 0xdc, 0xa9,
+	0xfa, 0xa4, 0x89, 0xb3, 0x6d, 0x0c, 0xa7, 0x68, 0xf4, 0x68, 0x92, 0x92, 0xb2, 0x2a, 0x07, 0x41,
+	0xfa, 0xa6, 0xe0, 0xf2, 0x89, 0x59, 0x5c, 0xd2, 0x15, 0xbe, 0xce, 0x8f, 0x41, 0x3c, 0x43, 0xbd,
+	0x9a, 0x36, 0x12, 0xc8, 0xa9, 0xe9, 0x1b, 0x1d, 0x5c, 0xf7, 0x52, 0xd3, 0xfd, 0x50, 0x26, 0x8a,
+	0x3f, 0xa0, 0xb3, 0x35, 0x40, 0x9a, 0x35, 0xa2, 0x22, 0x67, 0x46, 0x1b, 0x76, 0xb4, 0x05, 0xc0,
+	0xbc, 0x11, 0x95, 0x93, 0x4e, 0xd7, 0x76, 0x89, 0xaf, 0x50, 0x67, 0x86, 0x74, 0x4d, 0xd9, 0xa6,
+	0x11, 0x20, 0xc9, 0xf3, 0x89, 0x3f, 0x0d, 0x92, 0xce, 0x95, 0x2f, 0x1c, 0x9b, 0x2f, 0xee, 0xb7,
+	0xa1, 0xff, 0xb0, 0x0d, 0xfd, 0x7f, 0xdb, 0xd0, 0xff, 0xb5, 0x0b, 0xbd, 0x87, 0x5d, 0xe8, 0xfd,
+	0xd9, 0x85, 0xde, 0xf7, 0x77, 0x05, 0x53, 0xd7, 0x4d, 0x16, 0xad, 0x78, 0x19, 0xdb, 0xd3, 0x2b,
+	0x50, 0x37, 0x5c, 0xfc, 0x70, 0xab, 0xf8, 0xd6, 0x3e, 0x50, 0x75, 0x57, 0x83, 0xcc, 0xfa, 0xe6,
+	0x89, 0x5e, 0xfd, 0x1f, 0x00, 0x1d, 0x0b, 0x43, 0xb1, 0x14, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -120,6 +189,79 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DistributionFailures != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DistributionFailures))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size, err := m.FeeBurn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Pause.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.DistributionRemainder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Epoch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.DistributionRecords) > 0 {
+		for iNdEx := len(m.DistributionRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DeveloperRewards) > 0 {
+		for iNdEx := len(m.DeveloperRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeveloperRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Minter.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -164,6 +306,29 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.Minter.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DeveloperRewards) > 0 {
+		for _, e := range m.DeveloperRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DistributionRecords) > 0 {
+		for _, e := range m.DistributionRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Epoch.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.DistributionRemainder.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Pause.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.FeeBurn.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.DistributionFailures != 0 {
+		n += 1 + sovGenesis(uint64(m.DistributionFailures))
+	}
 	return n
 }
 
@@ -268,6 +433,225 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeveloperRewards = append(m.DeveloperRewards, DeveloperRewards{})
+			if err := m.DeveloperRewards[len(m.DeveloperRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionRecords = append(m.DistributionRecords, DistributionRecord{})
+			if err := m.DistributionRecords[len(m.DistributionRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionRemainder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributionRemainder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeBurn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionFailures", wireType)
+			}
+			m.DistributionFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// combine multiple mint hooks, all hook functions are run in array sequence
+type MultiMintHooks []MintHooks
+
+func NewMultiMintHooks(hooks ...MintHooks) MultiMintHooks {
+	return hooks
+}
+
+func (h MultiMintHooks) AfterBlockMint(ctx sdk.Context, minted sdk.Coin) {
+	for i := range h {
+		h[i].AfterBlockMint(ctx, minted)
+	}
+}
+
+func (h MultiMintHooks) AfterPhaseChange(ctx sdk.Context, oldPhase, newPhase uint64) {
+	for i := range h {
+		h[i].AfterPhaseChange(ctx, oldPhase, newPhase)
+	}
+}
+
+func (h MultiMintHooks) AfterInflationEnd(ctx sdk.Context) {
+	for i := range h {
+		h[i].AfterInflationEnd(ctx)
+	}
+}
@@ -1,6 +1,33 @@
 package types
 
-var MinterKey = []byte{0x00}
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+var (
+	MinterKey = []byte{0x00}
+
+	// DeveloperRewardsKeyPrefix is the prefix of the escrowed developer rewards
+	DeveloperRewardsKeyPrefix = []byte{0x01}
+
+	// DistributionRecordKeyPrefix is the prefix of the per phase distribution records
+	DistributionRecordKeyPrefix = []byte{0x02}
+
+	// EpochKey is the key of the minting epoch in progress
+	EpochKey = []byte{0x03}
+
+	// DistributionRemainderKey is the key of the fractions truncated from the
+	// shares of the minted coins
+	DistributionRemainderKey = []byte{0x04}
+
+	// PauseKey is the key of the pause state of minting
+	PauseKey = []byte{0x05}
+
+	// FeeBurnKey is the key of the cumulative fees burned
+	FeeBurnKey = []byte{0x06}
+
+	// DistributionFailuresKey is the key of the number of shares of the minted
+	// coins that failed to be distributed
+	DistributionFailuresKey = []byte{0x07}
+)
 
 const (
 	// ModuleName defines the module name
@@ -17,8 +44,25 @@ const (
 	QuerierRoute = ModuleName
 
 	DefaultMintDenom = "uglx"
+
+	// EcosystemPoolName is the name of the module account holding the
+	// ecosystem incentives until spent by governance
+	EcosystemPoolName = "ecosystem_pool"
 )
 
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+// DeveloperRewardsKey returns the store key of the escrowed rewards of a
+// developer rewards receiver. The bech32 address is used as is, so a
+// malformed address never fails the distribution.
+func DeveloperRewardsKey(address string) []byte {
+	return append(DeveloperRewardsKeyPrefix, []byte(address)...)
+}
+
+// DistributionRecordKey returns the store key of the distribution record of a
+// phase.
+func DistributionRecordKey(phase uint64) []byte {
+	return append(DistributionRecordKeyPrefix, sdk.Uint64ToBigEndian(phase)...)
+}
@@ -6,17 +6,22 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -24,10 +29,167 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PhaseMode defines how the current phase is derived.
+type PhaseMode int32
+
+const (
+	// phases advance every blocks_per_year blocks
+	PhaseByHeight PhaseMode = 0
+	// phases advance every phase_duration of block time
+	PhaseByTime PhaseMode = 1
+)
+
+var PhaseMode_name = map[int32]string{
+	0: "PhaseByHeight",
+	1: "PhaseByTime",
+}
+
+var PhaseMode_value = map[string]int32{
+	"PhaseByHeight": 0,
+	"PhaseByTime":   1,
+}
+
+func (x PhaseMode) String() string {
+	return proto.EnumName(PhaseMode_name, int32(x))
+}
+
+func (PhaseMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dc99ab6713fcf834, []int{0}
+}
+
+// InflationMode defines how the inflation rate is derived within a phase.
+type InflationMode int32
+
+const (
+	// the phase inflation rate is applied as is
+	InflationFixed InflationMode = 0
+	// the phase inflation rate is a ceiling and the rate moves between
+	// inflation_min and the ceiling toward goal_bonded
+	InflationBondedRatio InflationMode = 1
+)
+
+var InflationMode_name = map[int32]string{
+	0: "InflationFixed",
+	1: "InflationBondedRatio",
+}
+
+var InflationMode_value = map[string]int32{
+	"InflationFixed":       0,
+	"InflationBondedRatio": 1,
+}
+
+func (x InflationMode) String() string {
+	return proto.EnumName(InflationMode_name, int32(x))
+}
+
+func (InflationMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dc99ab6713fcf834, []int{1}
+}
+
+// PausePolicy defines what happens to the provisions of the blocks skipped
+// while minting is paused.
+type PausePolicy int32
+
+const (
+	// the provisions of the skipped blocks are never minted
+	PauseForfeit PausePolicy = 0
+	// the provisions of the skipped blocks are minted with the first block
+	// after minting resumes
+	PauseCatchUp PausePolicy = 1
+)
+
+var PausePolicy_name = map[int32]string{
+	0: "PauseForfeit",
+	1: "PauseCatchUp",
+}
+
+var PausePolicy_value = map[string]int32{
+	"PauseForfeit": 0,
+	"PauseCatchUp": 1,
+}
+
+func (x PausePolicy) String() string {
+	return proto.EnumName(PausePolicy_name, int32(x))
+}
+
+func (PausePolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dc99ab6713fcf834, []int{2}
+}
+
+// WeightRedistribution defines where the weight of a removed developer rewards
+// receiver goes.
+type WeightRedistribution int32
+
+const (
+	// the remaining receivers are reweighted proportionally
+	RedistributeToReceivers WeightRedistribution = 0
+	// the share of the receiver moves from the developer rewards to the
+	// community pool proportion
+	RedistributeToCommunityPool WeightRedistribution = 1
+)
+
+var WeightRedistribution_name = map[int32]string{
+	0: "RedistributeToReceivers",
+	1: "RedistributeToCommunityPool",
+}
+
+var WeightRedistribution_value = map[string]int32{
+	"RedistributeToReceivers":     0,
+	"RedistributeToCommunityPool": 1,
+}
+
+func (x WeightRedistribution) String() string {
+	return proto.EnumName(WeightRedistribution_name, int32(x))
+}
+
+func (WeightRedistribution) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dc99ab6713fcf834, []int{3}
+}
+
+// VestingType defines how the developer rewards of a receiver vest.
+type VestingType int32
+
+const (
+	// rewards are withdrawable as soon as they accrue
+	VestingNone VestingType = 0
+	// locked rewards vest linearly until end_time
+	VestingContinuous VestingType = 1
+	// locked rewards vest at every period until end_time
+	VestingPeriodic VestingType = 2
+)
+
+var VestingType_name = map[int32]string{
+	0: "VestingNone",
+	1: "VestingContinuous",
+	2: "VestingPeriodic",
+}
+
+var VestingType_value = map[string]int32{
+	"VestingNone":       0,
+	"VestingContinuous": 1,
+	"VestingPeriodic":   2,
+}
+
+func (x VestingType) String() string {
+	return proto.EnumName(VestingType_name, int32(x))
+}
+
+func (VestingType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dc99ab6713fcf834, []int{4}
+}
+
 type Minter struct {
 	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions"`
 	Inflation        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
 	Phase            uint64                                 `protobuf:"varint,3,opt,name=phase,proto3" json:"phase,omitempty"`
+	// start time of the current phase
+	PhaseStartTime time.Time `protobuf:"bytes,4,opt,name=phase_start_time,json=phaseStartTime,proto3,stdtime" json:"phase_start_time" yaml:"phase_start_time"`
+	// block time of the last minting block, used to scale block provisions
+	// when phases advance by block time
+	LastBlockTime time.Time `protobuf:"bytes,5,opt,name=last_block_time,json=lastBlockTime,proto3,stdtime" json:"last_block_time" yaml:"last_block_time"`
+	// fraction of the block provisions truncated so far, carried into the
+	// provision of the next block
+	ProvisionRemainder github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=provision_remainder,json=provisionRemainder,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"provision_remainder" yaml:"provision_remainder"`
 }
 
 func (m *Minter) Reset()         { *m = Minter{} }
@@ -70,16 +232,107 @@ func (m *Minter) GetPhase() uint64 {
 	return 0
 }
 
+func (m *Minter) GetPhaseStartTime() time.Time {
+	if m != nil {
+		return m.PhaseStartTime
+	}
+	return time.Time{}
+}
+
+func (m *Minter) GetLastBlockTime() time.Time {
+	if m != nil {
+		return m.LastBlockTime
+	}
+	return time.Time{}
+}
+
+// Epoch defines the minting epoch in progress. Block provisions accumulate
+// during an epoch and are minted and distributed at its end.
+type Epoch struct {
+	Number uint64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// height of the first block of the epoch
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// block time the epoch started at
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// number of blocks provisions accumulated for
+	Blocks uint64 `protobuf:"varint,4,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// accumulated provisions of the mint denom not minted yet
+	Provision github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=provision,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"provision"`
+}
+
+func (m *Epoch) Reset()         { *m = Epoch{} }
+func (m *Epoch) String() string { return proto.CompactTextString(m) }
+func (*Epoch) ProtoMessage()    {}
+func (*Epoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc99ab6713fcf834, []int{1}
+}
+func (m *Epoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Epoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Epoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Epoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Epoch.Merge(m, src)
+}
+func (m *Epoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *Epoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_Epoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Epoch proto.InternalMessageInfo
+
+func (m *Epoch) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *Epoch) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *Epoch) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *Epoch) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
 type DevloperWeightedAddress struct {
 	Address string                                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Weight  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+	// vesting schedule applied to the rewards of the receiver
+	Vesting DeveloperVesting `protobuf:"bytes,3,opt,name=vesting,proto3" json:"vesting"`
 }
 
 func (m *DevloperWeightedAddress) Reset()         { *m = DevloperWeightedAddress{} }
 func (m *DevloperWeightedAddress) String() string { return proto.CompactTextString(m) }
 func (*DevloperWeightedAddress) ProtoMessage()    {}
 func (*DevloperWeightedAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc99ab6713fcf834, []int{1}
+	return fileDescriptor_dc99ab6713fcf834, []int{2}
 }
 func (m *DevloperWeightedAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -115,6 +368,87 @@ func (m *DevloperWeightedAddress) GetAddress() string {
 	return ""
 }
 
+func (m *DevloperWeightedAddress) GetVesting() DeveloperVesting {
+	if m != nil {
+		return m.Vesting
+	}
+	return DeveloperVesting{}
+}
+
+// DeveloperVesting defines the vesting schedule of a developer rewards
+// receiver. Rewards accrued before end_time are locked and vest until
+// end_time, rewards accrued afterwards are withdrawable right away.
+type DeveloperVesting struct {
+	Type VestingType `protobuf:"varint,1,opt,name=type,proto3,enum=galaxy.mint.VestingType" json:"type,omitempty"`
+	// time the locked rewards start vesting
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// time all locked rewards are vested
+	EndTime time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	// length of a vesting period, only used by periodic vesting
+	Period time.Duration `protobuf:"bytes,4,opt,name=period,proto3,stdduration" json:"period"`
+}
+
+func (m *DeveloperVesting) Reset()         { *m = DeveloperVesting{} }
+func (m *DeveloperVesting) String() string { return proto.CompactTextString(m) }
+func (*DeveloperVesting) ProtoMessage()    {}
+func (*DeveloperVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc99ab6713fcf834, []int{3}
+}
+func (m *DeveloperVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeveloperVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeveloperVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeveloperVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeveloperVesting.Merge(m, src)
+}
+func (m *DeveloperVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeveloperVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeveloperVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeveloperVesting proto.InternalMessageInfo
+
+func (m *DeveloperVesting) GetType() VestingType {
+	if m != nil {
+		return m.Type
+	}
+	return VestingNone
+}
+
+func (m *DeveloperVesting) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *DeveloperVesting) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *DeveloperVesting) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
 type DistributionProportions struct {
 	Staking             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=staking,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"staking"`
 	EcosystemIncentives github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=ecosystem_incentives,json=ecosystemIncentives,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ecosystem_incentives"`
@@ -126,7 +460,7 @@ func (m *DistributionProportions) Reset()         { *m = DistributionProportions
 func (m *DistributionProportions) String() string { return proto.CompactTextString(m) }
 func (*DistributionProportions) ProtoMessage()    {}
 func (*DistributionProportions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc99ab6713fcf834, []int{2}
+	return fileDescriptor_dc99ab6713fcf834, []int{4}
 }
 func (m *DistributionProportions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -155,133 +489,648 @@ func (m *DistributionProportions) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DistributionProportions proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Minter)(nil), "galaxy.mint.Minter")
-	proto.RegisterType((*DevloperWeightedAddress)(nil), "galaxy.mint.DevloperWeightedAddress")
-	proto.RegisterType((*DistributionProportions)(nil), "galaxy.mint.DistributionProportions")
+// InflationPhase defines the inflation rate applied during a single phase of
+// the inflation schedule.
+type InflationPhase struct {
+	// phase is the 1-based phase number this entry applies to.
+	Phase uint64 `protobuf:"varint,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	// inflation is the annual inflation rate applied to the total supply.
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+	// provision_cap caps the annual provisions of the phase. Zero means no cap.
+	ProvisionCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=provision_cap,json=provisionCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"provision_cap"`
 }
 
-func init() { proto.RegisterFile("galaxy/mint/mint.proto", fileDescriptor_dc99ab6713fcf834) }
-
-var fileDescriptor_dc99ab6713fcf834 = []byte{
-	// 403 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x41, 0xab, 0xd3, 0x40,
-	0x10, 0xc7, 0x93, 0xe7, 0xb3, 0x8f, 0xb7, 0xa2, 0xe8, 0x5a, 0x7c, 0xc1, 0x43, 0xde, 0xa3, 0x07,
-	0xe9, 0x41, 0x93, 0x83, 0x9f, 0xc0, 0x52, 0x8a, 0x82, 0x42, 0x09, 0x88, 0xa0, 0x87, 0xb0, 0x4d,
-	0xc6, 0x74, 0x69, 0xb2, 0x13, 0x76, 0x37, 0x69, 0x83, 0x5f, 0xc2, 0x8f, 0x55, 0x6f, 0x3d, 0x8a,
-	0x87, 0x22, 0xed, 0xb7, 0xf0, 0x24, 0xd9, 0x6d, 0xa3, 0xe7, 0x5c, 0x76, 0x67, 0x86, 0x99, 0x1f,
-	0x33, 0xc3, 0xfc, 0xc9, 0xb3, 0x8c, 0xe5, 0x6c, 0xd3, 0x84, 0x05, 0x17, 0xda, 0x3c, 0x41, 0x29,
-	0x51, 0x23, 0x7d, 0x60, 0xe3, 0x41, 0x1b, 0x7a, 0x3e, 0xcc, 0x30, 0x43, 0x13, 0x0f, 0x5b, 0xcb,
-	0xa6, 0x8c, 0x7e, 0xb8, 0x64, 0xf0, 0x81, 0x0b, 0x0d, 0x92, 0x7e, 0x21, 0x4f, 0x98, 0x10, 0x15,
-	0xcb, 0xe3, 0x52, 0x62, 0xcd, 0x15, 0x47, 0xa1, 0x3c, 0xf7, 0xce, 0x1d, 0x5f, 0x4f, 0x82, 0xed,
-	0xfe, 0xd6, 0xf9, 0xb5, 0xbf, 0x7d, 0x91, 0x71, 0xbd, 0xac, 0x16, 0x41, 0x82, 0x45, 0x98, 0xa0,
-	0x2a, 0x50, 0x9d, 0xbe, 0x57, 0x2a, 0x5d, 0x85, 0xba, 0x29, 0x41, 0x05, 0x53, 0x48, 0xa2, 0xc7,
-	0x16, 0x34, 0xef, 0x38, 0xf4, 0x3d, 0xb9, 0xe6, 0xe2, 0x6b, 0xce, 0x34, 0x47, 0xe1, 0x5d, 0xf4,
-	0x82, 0xfe, 0x03, 0xd0, 0x21, 0xb9, 0x5f, 0x2e, 0x99, 0x02, 0xef, 0xde, 0x9d, 0x3b, 0xbe, 0x8c,
-	0xac, 0x33, 0xfa, 0x46, 0x6e, 0xa6, 0x50, 0xe7, 0x58, 0x82, 0xfc, 0x04, 0x3c, 0x5b, 0x6a, 0x48,
-	0xdf, 0xa4, 0xa9, 0x04, 0xa5, 0xa8, 0x47, 0xae, 0x98, 0x35, 0xed, 0x44, 0xd1, 0xd9, 0xa5, 0x33,
-	0x32, 0x58, 0x9b, 0xe4, 0x9e, 0x5d, 0x9d, 0xaa, 0x47, 0x7f, 0x2e, 0xc8, 0xcd, 0x94, 0x2b, 0x2d,
-	0xf9, 0xa2, 0x6a, 0x7b, 0x9c, 0x4b, 0x2c, 0x51, 0x6a, 0x33, 0xfc, 0x5b, 0x72, 0xa5, 0x34, 0x5b,
-	0x71, 0x91, 0xf5, 0xdc, 0xe7, 0xb9, 0x9c, 0x32, 0x32, 0x84, 0x04, 0x55, 0xa3, 0x34, 0x14, 0x31,
-	0x17, 0x09, 0x08, 0xcd, 0x6b, 0x50, 0x3d, 0x7b, 0x7f, 0xda, 0xb1, 0xde, 0x75, 0xa8, 0xf6, 0x0c,
-	0x52, 0xa8, 0xc1, 0xac, 0x31, 0x96, 0xb0, 0x66, 0x32, 0x55, 0x66, 0xcf, 0x3d, 0xce, 0xa0, 0x03,
-	0x45, 0x96, 0x43, 0x3f, 0x92, 0x47, 0x09, 0x16, 0x45, 0x25, 0xb8, 0x6e, 0xe2, 0x12, 0x31, 0xf7,
-	0x2e, 0x7b, 0x91, 0x1f, 0x76, 0x94, 0x39, 0x62, 0x3e, 0x99, 0x6d, 0x0f, 0xbe, 0xbb, 0x3b, 0xf8,
-	0xee, 0xef, 0x83, 0xef, 0x7e, 0x3f, 0xfa, 0xce, 0xee, 0xe8, 0x3b, 0x3f, 0x8f, 0xbe, 0xf3, 0xf9,
-	0xe5, 0x7f, 0x40, 0xab, 0x06, 0x01, 0x7a, 0x8d, 0x72, 0x75, 0xf2, 0xc2, 0x8d, 0x55, 0x8d, 0x41,
-	0x2f, 0x06, 0x46, 0x14, 0xaf, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x55, 0x00, 0xf3, 0xdf, 0x51,
-	0x03, 0x00, 0x00,
+func (m *InflationPhase) Reset()         { *m = InflationPhase{} }
+func (m *InflationPhase) String() string { return proto.CompactTextString(m) }
+func (*InflationPhase) ProtoMessage()    {}
+func (*InflationPhase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc99ab6713fcf834, []int{5}
+}
+func (m *InflationPhase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationPhase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationPhase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationPhase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationPhase.Merge(m, src)
+}
+func (m *InflationPhase) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationPhase) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationPhase.DiscardUnknown(m)
 }
 
-func (m *Minter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_InflationPhase proto.InternalMessageInfo
+
+func (m *InflationPhase) GetPhase() uint64 {
+	if m != nil {
+		return m.Phase
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Minter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DeveloperRewards defines the developer rewards escrowed for a receiver.
+type DeveloperRewards struct {
+	// address of the developer rewards receiver
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// total rewards accrued to the receiver
+	Accrued github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=accrued,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"accrued"`
+	// total rewards withdrawn by the receiver
+	Withdrawn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=withdrawn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawn"`
+	// rewards not vested yet
+	Locked github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=locked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"locked"`
+	// total locked rewards clawed back to the community pool
+	ClawedBack github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=clawed_back,json=clawedBack,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"clawed_back"`
+	// last time the locked rewards were vested
+	LastVestingTime time.Time `protobuf:"bytes,6,opt,name=last_vesting_time,json=lastVestingTime,proto3,stdtime" json:"last_vesting_time" yaml:"last_vesting_time"`
 }
 
-func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Phase != 0 {
-		i = encodeVarintMint(dAtA, i, uint64(m.Phase))
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size := m.Inflation.Size()
-		i -= size
-		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *DeveloperRewards) Reset()         { *m = DeveloperRewards{} }
+func (m *DeveloperRewards) String() string { return proto.CompactTextString(m) }
+func (*DeveloperRewards) ProtoMessage()    {}
+func (*DeveloperRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc99ab6713fcf834, []int{6}
+}
+func (m *DeveloperRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeveloperRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeveloperRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.AnnualProvisions.Size()
-		i -= size
-		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
+}
+func (m *DeveloperRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeveloperRewards.Merge(m, src)
+}
+func (m *DeveloperRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeveloperRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeveloperRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeveloperRewards proto.InternalMessageInfo
+
+func (m *DeveloperRewards) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *DevloperWeightedAddress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DeveloperRewards) GetAccrued() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Accrued
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DevloperWeightedAddress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DeveloperRewards) GetWithdrawn() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Withdrawn
+	}
+	return nil
 }
 
-func (m *DevloperWeightedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Weight.Size()
-		i -= size
-		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *DeveloperRewards) GetLocked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Locked
+	}
+	return nil
+}
+
+func (m *DeveloperRewards) GetClawedBack() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClawedBack
+	}
+	return nil
+}
+
+func (m *DeveloperRewards) GetLastVestingTime() time.Time {
+	if m != nil {
+		return m.LastVestingTime
+	}
+	return time.Time{}
+}
+
+// DistributionRecord defines the cumulative minted coins sent to each
+// destination during a phase.
+type DistributionRecord struct {
+	// phase the coins were minted in, zero for the totals of all phases
+	Phase uint64 `protobuf:"varint,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	// total minted coins
+	Minted github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=minted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"minted"`
+	// coins sent to the fee collector for stakers
+	Staking github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=staking,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"staking"`
+	// coins sent to the ecosystem incentives pool
+	EcosystemIncentives github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=ecosystem_incentives,json=ecosystemIncentives,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"ecosystem_incentives"`
+	// coins funded to the community pool, including the developer rewards when
+	// there is no receiver
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=community_pool,json=communityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"community_pool"`
+	// coins accrued to each developer rewards receiver
+	DeveloperRewards []ReceiverDistribution `protobuf:"bytes,6,rep,name=developer_rewards,json=developerRewards,proto3" json:"developer_rewards"`
+}
+
+func (m *DistributionRecord) Reset()         { *m = DistributionRecord{} }
+func (m *DistributionRecord) String() string { return proto.CompactTextString(m) }
+func (*DistributionRecord) ProtoMessage()    {}
+func (*DistributionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc99ab6713fcf834, []int{7}
+}
+func (m *DistributionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *DistributionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionRecord.Merge(m, src)
+}
+func (m *DistributionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionRecord proto.InternalMessageInfo
+
+func (m *DistributionRecord) GetPhase() uint64 {
+	if m != nil {
+		return m.Phase
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DistributionProportions) Marshal() (dAtA []byte, err error) {
+func (m *DistributionRecord) GetMinted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Minted
+	}
+	return nil
+}
+
+func (m *DistributionRecord) GetStaking() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Staking
+	}
+	return nil
+}
+
+func (m *DistributionRecord) GetEcosystemIncentives() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EcosystemIncentives
+	}
+	return nil
+}
+
+func (m *DistributionRecord) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CommunityPool
+	}
+	return nil
+}
+
+func (m *DistributionRecord) GetDeveloperRewards() []ReceiverDistribution {
+	if m != nil {
+		return m.DeveloperRewards
+	}
+	return nil
+}
+
+// DistributionRemainder defines the fractions truncated from the share of
+// each destination of the minted coins. They are carried into the next
+// distribution so that every share is exact over time.
+type DistributionRemainder struct {
+	Staking             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=staking,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"staking"`
+	EcosystemIncentives github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=ecosystem_incentives,json=ecosystemIncentives,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ecosystem_incentives"`
+	CommunityPool       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool"`
+	// remainders of the developer rewards receivers, in receiver order. A
+	// receiver listed more than once has a remainder for each entry
+	DeveloperRewards []ReceiverRemainder `protobuf:"bytes,4,rep,name=developer_rewards,json=developerRewards,proto3" json:"developer_rewards"`
+	// minted coins of the mint denom held in the module account until the
+	// remainders add up to them
+	Undistributed github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=undistributed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"undistributed"`
+}
+
+func (m *DistributionRemainder) Reset()         { *m = DistributionRemainder{} }
+func (m *DistributionRemainder) String() string { return proto.CompactTextString(m) }
+func (*DistributionRemainder) ProtoMessage()    {}
+func (*DistributionRemainder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc99ab6713fcf834, []int{8}
+}
+func (m *DistributionRemainder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionRemainder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionRemainder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionRemainder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionRemainder.Merge(m, src)
+}
+func (m *DistributionRemainder) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionRemainder) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionRemainder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionRemainder proto.InternalMessageInfo
+
+func (m *DistributionRemainder) GetDeveloperRewards() []ReceiverRemainder {
+	if m != nil {
+		return m.DeveloperRewards
+	}
+	return nil
+}
+
+// ReceiverRemainder defines the remainder of a developer rewards receiver.
+type ReceiverRemainder struct {
+	Address   string                                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Remainder github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=remainder,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"remainder"`
+}
+
+func (m *ReceiverRemainder) Reset()         { *m = ReceiverRemainder{} }
+func (m *ReceiverRemainder) String() string { return proto.CompactTextString(m) }
+func (*ReceiverRemainder) ProtoMessage()    {}
+func (*ReceiverRemainder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc99ab6713fcf834, []int{9}
+}
+func (m *ReceiverRemainder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiverRemainder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReceiverRemainder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReceiverRemainder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiverRemainder.Merge(m, src)
+}
+func (m *ReceiverRemainder) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiverRemainder) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiverRemainder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiverRemainder proto.InternalMessageInfo
+
+func (m *ReceiverRemainder) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// ReceiverDistribution defines the coins accrued to a developer rewards
+// receiver.
+type ReceiverDistribution struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *ReceiverDistribution) Reset()         { *m = ReceiverDistribution{} }
+func (m *ReceiverDistribution) String() string { return proto.CompactTextString(m) }
+func (*ReceiverDistribution) ProtoMessage()    {}
+func (*ReceiverDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc99ab6713fcf834, []int{10}
+}
+func (m *ReceiverDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiverDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReceiverDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReceiverDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiverDistribution.Merge(m, src)
+}
+func (m *ReceiverDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiverDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiverDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiverDistribution proto.InternalMessageInfo
+
+func (m *ReceiverDistribution) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ReceiverDistribution) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MintPause defines the pause state of minting.
+type MintPause struct {
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	// height of the block minting was last paused at
+	PausedHeight int64 `protobuf:"varint,2,opt,name=paused_height,json=pausedHeight,proto3" json:"paused_height,omitempty"`
+	// time of the block minting was last paused at
+	PausedTime time.Time `protobuf:"bytes,3,opt,name=paused_time,json=pausedTime,proto3,stdtime" json:"paused_time"`
+	// number of blocks skipped in the current or last pause
+	SkippedBlocks uint64 `protobuf:"varint,4,opt,name=skipped_blocks,json=skippedBlocks,proto3" json:"skipped_blocks,omitempty"`
+	// number of skipped blocks whose provisions are still to be caught up
+	CatchUpBlocks uint64 `protobuf:"varint,5,opt,name=catch_up_blocks,json=catchUpBlocks,proto3" json:"catch_up_blocks,omitempty"`
+}
+
+func (m *MintPause) Reset()         { *m = MintPause{} }
+func (m *MintPause) String() string { return proto.CompactTextString(m) }
+func (*MintPause) ProtoMessage()    {}
+func (*MintPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc99ab6713fcf834, []int{11}
+}
+func (m *MintPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintPause.Merge(m, src)
+}
+func (m *MintPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintPause proto.InternalMessageInfo
+
+func (m *MintPause) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *MintPause) GetPausedHeight() int64 {
+	if m != nil {
+		return m.PausedHeight
+	}
+	return 0
+}
+
+func (m *MintPause) GetPausedTime() time.Time {
+	if m != nil {
+		return m.PausedTime
+	}
+	return time.Time{}
+}
+
+func (m *MintPause) GetSkippedBlocks() uint64 {
+	if m != nil {
+		return m.SkippedBlocks
+	}
+	return 0
+}
+
+func (m *MintPause) GetCatchUpBlocks() uint64 {
+	if m != nil {
+		return m.CatchUpBlocks
+	}
+	return 0
+}
+
+// FeeBurn defines the cumulative fees burned.
+type FeeBurn struct {
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	// number of blocks fees were burned in, used to project the burn rate
+	Blocks uint64 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *FeeBurn) Reset()         { *m = FeeBurn{} }
+func (m *FeeBurn) String() string { return proto.CompactTextString(m) }
+func (*FeeBurn) ProtoMessage()    {}
+func (*FeeBurn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc99ab6713fcf834, []int{12}
+}
+func (m *FeeBurn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeBurn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeBurn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeBurn.Merge(m, src)
+}
+func (m *FeeBurn) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeBurn) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeBurn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeBurn proto.InternalMessageInfo
+
+func (m *FeeBurn) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *FeeBurn) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("galaxy.mint.PhaseMode", PhaseMode_name, PhaseMode_value)
+	proto.RegisterEnum("galaxy.mint.InflationMode", InflationMode_name, InflationMode_value)
+	proto.RegisterEnum("galaxy.mint.PausePolicy", PausePolicy_name, PausePolicy_value)
+	proto.RegisterEnum("galaxy.mint.WeightRedistribution", WeightRedistribution_name, WeightRedistribution_value)
+	proto.RegisterEnum("galaxy.mint.VestingType", VestingType_name, VestingType_value)
+	proto.RegisterType((*Minter)(nil), "galaxy.mint.Minter")
+	proto.RegisterType((*Epoch)(nil), "galaxy.mint.Epoch")
+	proto.RegisterType((*DevloperWeightedAddress)(nil), "galaxy.mint.DevloperWeightedAddress")
+	proto.RegisterType((*DeveloperVesting)(nil), "galaxy.mint.DeveloperVesting")
+	proto.RegisterType((*DistributionProportions)(nil), "galaxy.mint.DistributionProportions")
+	proto.RegisterType((*InflationPhase)(nil), "galaxy.mint.InflationPhase")
+	proto.RegisterType((*DeveloperRewards)(nil), "galaxy.mint.DeveloperRewards")
+	proto.RegisterType((*DistributionRecord)(nil), "galaxy.mint.DistributionRecord")
+	proto.RegisterType((*DistributionRemainder)(nil), "galaxy.mint.DistributionRemainder")
+	proto.RegisterType((*ReceiverRemainder)(nil), "galaxy.mint.ReceiverRemainder")
+	proto.RegisterType((*ReceiverDistribution)(nil), "galaxy.mint.ReceiverDistribution")
+	proto.RegisterType((*MintPause)(nil), "galaxy.mint.MintPause")
+	proto.RegisterType((*FeeBurn)(nil), "galaxy.mint.FeeBurn")
+}
+
+func init() { proto.RegisterFile("galaxy/mint/mint.proto", fileDescriptor_dc99ab6713fcf834) }
+
+var fileDescriptor_dc99ab6713fcf834 = []byte{
+	// 1411 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xda, 0x8e, 0x53, 0x3f, 0xc7, 0x89, 0x33, 0x4d, 0x93, 0xfd, 0xa6, 0xaa, 0x9d, 0x6e,
+	0xbf, 0x54, 0x51, 0x55, 0x6c, 0x5a, 0x84, 0x90, 0x40, 0x1c, 0x70, 0xd2, 0xa8, 0x95, 0x5a, 0x64,
+	0xb6, 0x29, 0xa0, 0x72, 0xb0, 0xd6, 0xbb, 0x13, 0x7b, 0xe4, 0xf5, 0xce, 0x6a, 0x67, 0xd7, 0xa9,
+	0x85, 0xe0, 0xc0, 0x01, 0x71, 0xec, 0x05, 0x09, 0x89, 0x23, 0x37, 0xf8, 0x0f, 0xb8, 0xc0, 0x81,
+	0x43, 0xb9, 0xf5, 0x88, 0x38, 0xa4, 0xa8, 0xfd, 0x0f, 0x7a, 0xe4, 0x84, 0xe6, 0xc7, 0xae, 0xd7,
+	0xf9, 0xd1, 0x1f, 0x96, 0x73, 0xe0, 0x92, 0xcc, 0xbc, 0x7d, 0xef, 0xf3, 0x66, 0xdf, 0x7b, 0xfb,
+	0x79, 0xf3, 0x0c, 0xab, 0x5d, 0xcb, 0xb5, 0x1e, 0x8c, 0x1a, 0x03, 0xe2, 0x85, 0xe2, 0x4f, 0xdd,
+	0x0f, 0x68, 0x48, 0x51, 0x49, 0xca, 0xeb, 0x5c, 0xb4, 0xbe, 0xd2, 0xa5, 0x5d, 0x2a, 0xe4, 0x0d,
+	0xbe, 0x92, 0x2a, 0xeb, 0xb5, 0x2e, 0xa5, 0x5d, 0x17, 0x37, 0xc4, 0xae, 0x13, 0xed, 0x35, 0x42,
+	0x32, 0xc0, 0x2c, 0xb4, 0x06, 0xbe, 0x52, 0xa8, 0x1e, 0x56, 0x70, 0xa2, 0xc0, 0x0a, 0x09, 0xf5,
+	0xe2, 0xe7, 0x36, 0x65, 0x03, 0xca, 0x1a, 0x1d, 0x8b, 0xe1, 0xc6, 0xf0, 0x5a, 0x07, 0x87, 0xd6,
+	0xb5, 0x86, 0x4d, 0x89, 0x7a, 0x6e, 0xfc, 0x90, 0x87, 0xc2, 0x1d, 0xe2, 0x85, 0x38, 0x40, 0x9f,
+	0xc3, 0xb2, 0xe5, 0x79, 0x91, 0xe5, 0xb6, 0xfd, 0x80, 0x0e, 0x09, 0x23, 0xd4, 0x63, 0xba, 0xb6,
+	0xa1, 0x6d, 0x16, 0x9b, 0xf5, 0x47, 0x07, 0xb5, 0xcc, 0x5f, 0x07, 0xb5, 0xcb, 0x5d, 0x12, 0xf6,
+	0xa2, 0x4e, 0xdd, 0xa6, 0x83, 0x86, 0x02, 0x96, 0xff, 0xde, 0x64, 0x4e, 0xbf, 0x11, 0x8e, 0x7c,
+	0xcc, 0xea, 0xdb, 0xd8, 0x36, 0x2b, 0x12, 0xa8, 0x95, 0xe0, 0xa0, 0xdb, 0x50, 0x24, 0xde, 0x9e,
+	0x2b, 0x8e, 0xa6, 0x67, 0xa7, 0x02, 0x1d, 0x03, 0xa0, 0x15, 0x98, 0xf3, 0x7b, 0x16, 0xc3, 0x7a,
+	0x6e, 0x43, 0xdb, 0xcc, 0x9b, 0x72, 0x83, 0x08, 0x54, 0xc4, 0xa2, 0xcd, 0x42, 0x2b, 0x08, 0xdb,
+	0x3c, 0x54, 0x7a, 0x7e, 0x43, 0xdb, 0x2c, 0x5d, 0x5f, 0xaf, 0xcb, 0x30, 0xd5, 0xe3, 0x30, 0xd5,
+	0x77, 0xe3, 0x38, 0x36, 0x2f, 0xf1, 0x63, 0x3c, 0x3f, 0xa8, 0xad, 0x8d, 0xac, 0x81, 0xfb, 0x9e,
+	0x71, 0x18, 0xc1, 0x78, 0xf8, 0xa4, 0xa6, 0x99, 0x8b, 0x42, 0x7c, 0x97, 0x4b, 0xb9, 0x25, 0xda,
+	0x83, 0x25, 0xd7, 0x62, 0x61, 0xbb, 0xe3, 0x52, 0xbb, 0x2f, 0x3d, 0xcd, 0xbd, 0xd4, 0x93, 0xa1,
+	0x3c, 0xad, 0x4a, 0x4f, 0x87, 0x00, 0xa4, 0xa3, 0x32, 0x97, 0x36, 0xb9, 0x50, 0xf8, 0xf9, 0x12,
+	0xce, 0x26, 0xc9, 0x68, 0x07, 0x78, 0x60, 0x11, 0xcf, 0xc1, 0x81, 0x5e, 0x10, 0x01, 0xbc, 0xfd,
+	0x7a, 0x01, 0x7c, 0x7e, 0x50, 0x5b, 0x57, 0xef, 0x78, 0x14, 0xd2, 0x30, 0x51, 0x22, 0x35, 0x13,
+	0xe1, 0xd7, 0x59, 0x98, 0xbb, 0xe1, 0x53, 0xbb, 0x87, 0x56, 0xa1, 0xe0, 0x45, 0x83, 0x0e, 0x0e,
+	0x44, 0x45, 0xe4, 0x4d, 0xb5, 0x43, 0x17, 0x61, 0x41, 0xc6, 0xaa, 0x87, 0x49, 0xb7, 0x17, 0x8a,
+	0xd4, 0xe6, 0xcc, 0x92, 0x90, 0xdd, 0x14, 0x22, 0xf4, 0x19, 0x40, 0x2a, 0x21, 0xb9, 0x97, 0x86,
+	0xe9, 0x82, 0x0a, 0xd3, 0xb2, 0x3c, 0xec, 0xe1, 0x54, 0x14, 0x59, 0x92, 0x85, 0x55, 0x28, 0x88,
+	0xf8, 0x31, 0x91, 0xe6, 0xbc, 0xa9, 0x76, 0xbc, 0xd8, 0x92, 0x97, 0xd1, 0xe7, 0x5e, 0xbb, 0xd8,
+	0x6e, 0x79, 0xa1, 0x39, 0x06, 0x30, 0x7e, 0xd1, 0x60, 0x6d, 0x1b, 0x0f, 0x5d, 0xea, 0xe3, 0xe0,
+	0x53, 0xf1, 0x4a, 0xd8, 0xf9, 0xd0, 0x71, 0x02, 0xcc, 0x18, 0xd2, 0x61, 0xde, 0x92, 0x4b, 0xf9,
+	0xa5, 0x98, 0xf1, 0x16, 0xed, 0x40, 0x61, 0x7f, 0x1c, 0x92, 0xd7, 0xaf, 0x76, 0x65, 0x8d, 0x3e,
+	0x80, 0xf9, 0x21, 0x66, 0x21, 0xf1, 0xba, 0x2a, 0x74, 0x17, 0xea, 0x29, 0xda, 0xa8, 0x6f, 0xe3,
+	0x21, 0x16, 0x27, 0xfb, 0x44, 0x2a, 0x35, 0xf3, 0xdc, 0x8f, 0x19, 0xdb, 0x18, 0x3f, 0x67, 0xa1,
+	0x72, 0x58, 0x07, 0x5d, 0x85, 0x3c, 0x77, 0x24, 0x8e, 0xbc, 0x78, 0x5d, 0x9f, 0x00, 0x54, 0x3a,
+	0xbb, 0x23, 0x1f, 0x9b, 0x42, 0xeb, 0x50, 0xfe, 0xb2, 0x33, 0xcc, 0x9f, 0x09, 0x67, 0xb0, 0xe7,
+	0xbc, 0x6a, 0x5d, 0x9c, 0x57, 0xb8, 0x4b, 0x12, 0x37, 0xb6, 0x94, 0xa8, 0xf3, 0xd8, 0x73, 0x04,
+	0xe6, 0xfb, 0x50, 0xf0, 0x71, 0x40, 0xa8, 0xa3, 0x3e, 0xfd, 0xff, 0x1d, 0x41, 0xdc, 0x56, 0x0c,
+	0xd9, 0x3c, 0xc3, 0x01, 0xbf, 0xe7, 0xd6, 0xca, 0xc4, 0xf8, 0x27, 0x0b, 0x6b, 0xdb, 0x84, 0x85,
+	0x01, 0xe9, 0x44, 0x5c, 0xa5, 0x15, 0x50, 0x9f, 0x06, 0xa1, 0x60, 0xb0, 0x9b, 0x30, 0xcf, 0x42,
+	0xab, 0xcf, 0x13, 0x31, 0x1d, 0x29, 0xc6, 0xe6, 0xc8, 0x82, 0x15, 0x6c, 0x53, 0x36, 0x62, 0x21,
+	0x1e, 0xb4, 0x89, 0x67, 0x63, 0x2f, 0x24, 0x43, 0xcc, 0xa6, 0x2c, 0x94, 0xb3, 0x09, 0xd6, 0xad,
+	0x04, 0x8a, 0x73, 0xb9, 0x13, 0x67, 0xbd, 0x1d, 0xe0, 0x7d, 0x2b, 0x70, 0x98, 0x9e, 0x9b, 0x0a,
+	0xbf, 0x92, 0x00, 0x99, 0x12, 0x07, 0xdd, 0x83, 0x45, 0x9b, 0x0e, 0x06, 0x91, 0x47, 0xc2, 0x51,
+	0xdb, 0xa7, 0xd4, 0xd5, 0xf3, 0x53, 0x21, 0x97, 0x13, 0x94, 0x16, 0xa5, 0xae, 0xf1, 0x87, 0x06,
+	0x8b, 0xb7, 0x62, 0x8a, 0x6f, 0x09, 0x46, 0x4f, 0x78, 0x5e, 0x4b, 0xf3, 0xfc, 0x6c, 0x7b, 0xc9,
+	0x5d, 0x28, 0x8f, 0xf9, 0xd0, 0xb6, 0x7c, 0x3d, 0x37, 0x15, 0x61, 0x2c, 0x24, 0x20, 0x5b, 0x96,
+	0x6f, 0xfc, 0x9a, 0x4f, 0x7d, 0x76, 0x71, 0xdc, 0x4e, 0x26, 0x0b, 0x0c, 0xf3, 0x96, 0x6d, 0x07,
+	0x11, 0x76, 0xf4, 0xec, 0x46, 0x4e, 0x54, 0xad, 0x74, 0x52, 0xe7, 0x7d, 0xbb, 0xae, 0xfa, 0x76,
+	0x7d, 0x8b, 0x12, 0xaf, 0xf9, 0x16, 0x3f, 0xd8, 0x4f, 0x4f, 0x6a, 0x9b, 0xaf, 0x70, 0x30, 0x6e,
+	0xc0, 0xcc, 0x18, 0x1b, 0x11, 0x28, 0xee, 0x93, 0xb0, 0xe7, 0x04, 0xd6, 0xbe, 0xa7, 0xe7, 0x66,
+	0xef, 0x68, 0x8c, 0x8e, 0x6c, 0x28, 0x70, 0x2e, 0xc6, 0xfc, 0x33, 0x9c, 0xb9, 0x1f, 0x05, 0x8d,
+	0x5c, 0x28, 0xd9, 0xae, 0xb5, 0x8f, 0x9d, 0x76, 0xc7, 0xb2, 0xfb, 0xfa, 0xdc, 0xec, 0x3d, 0x81,
+	0xc4, 0x6f, 0x5a, 0x76, 0x1f, 0xb9, 0xb0, 0x2c, 0x5a, 0xb6, 0xa2, 0x56, 0x49, 0x5b, 0x85, 0x97,
+	0xd2, 0xd6, 0xff, 0x15, 0x6d, 0xe9, 0xa9, 0xae, 0x9f, 0x86, 0x90, 0xfc, 0x25, 0xae, 0x13, 0x31,
+	0xf7, 0x72, 0xe9, 0x6f, 0x79, 0x40, 0x69, 0x2a, 0x32, 0xb1, 0x4d, 0x03, 0xe7, 0x84, 0x2f, 0xc2,
+	0x86, 0x02, 0x27, 0xef, 0xd3, 0x29, 0x1f, 0x05, 0xcd, 0x8b, 0x34, 0x26, 0xc0, 0x53, 0xa8, 0x9d,
+	0x84, 0x1d, 0xbf, 0x3a, 0x81, 0x1d, 0x4f, 0xa1, 0x8e, 0x8e, 0xa5, 0xce, 0xe0, 0x08, 0xbb, 0x9d,
+	0x42, 0x5d, 0x4d, 0x52, 0x1f, 0xda, 0x3d, 0x8e, 0xae, 0x0b, 0xc2, 0xed, 0xc5, 0x89, 0xee, 0x6c,
+	0x62, 0x1b, 0x93, 0x21, 0x0e, 0xd2, 0x95, 0xa1, 0x5a, 0xfe, 0x11, 0x9e, 0x36, 0x7e, 0xcf, 0xc1,
+	0xb9, 0xc9, 0x12, 0x52, 0xf7, 0xba, 0xff, 0x56, 0x2f, 0x3b, 0xda, 0x6e, 0x72, 0x33, 0x68, 0x37,
+	0xe8, 0xe3, 0xe3, 0x62, 0x2e, 0x8b, 0xac, 0x7a, 0x6c, 0xcc, 0x93, 0xf0, 0x9d, 0x14, 0x70, 0xb4,
+	0x0b, 0xe5, 0xc8, 0x73, 0xe2, 0x88, 0x63, 0x67, 0xca, 0xbb, 0xe7, 0x24, 0x88, 0xf1, 0x05, 0x2c,
+	0x1f, 0x39, 0xc2, 0x0b, 0x7a, 0xc9, 0x6d, 0x28, 0x8e, 0x07, 0x85, 0x29, 0xbb, 0xe3, 0x78, 0x2c,
+	0xf8, 0x4e, 0x83, 0x95, 0xe3, 0x8a, 0xee, 0x05, 0x07, 0xb0, 0xa1, 0x60, 0x0d, 0x68, 0xe4, 0x85,
+	0xa7, 0x42, 0x46, 0x12, 0xda, 0x38, 0xd0, 0xa0, 0xc8, 0xe7, 0xd6, 0x96, 0x15, 0x31, 0x31, 0x08,
+	0xf8, 0x7c, 0xe1, 0x88, 0xb3, 0x9c, 0x31, 0xd5, 0x0e, 0x5d, 0x82, 0xb2, 0x5c, 0x4d, 0x8e, 0x27,
+	0x0b, 0x52, 0xa8, 0xe6, 0x93, 0x1b, 0x50, 0x52, 0x4a, 0xaf, 0x78, 0x11, 0x15, 0xf7, 0x46, 0xc1,
+	0xda, 0x20, 0x0d, 0xf9, 0x23, 0xf4, 0x06, 0x2c, 0xb2, 0x3e, 0xf1, 0x7d, 0xde, 0x8d, 0xd2, 0x43,
+	0x49, 0x59, 0x49, 0xc5, 0x50, 0xc7, 0xd0, 0x65, 0x58, 0xb2, 0xad, 0xd0, 0xee, 0xb5, 0x23, 0x3f,
+	0xd6, 0x9b, 0x93, 0x7a, 0x42, 0x7c, 0xcf, 0x97, 0x7a, 0xc6, 0x37, 0x1a, 0xcc, 0xef, 0x60, 0xdc,
+	0x8c, 0x02, 0xd1, 0x4c, 0x3b, 0x51, 0xe0, 0x89, 0xd7, 0x9b, 0x7d, 0x44, 0x25, 0x74, 0x6a, 0x98,
+	0xca, 0xa6, 0x87, 0xa9, 0x2b, 0xef, 0x40, 0x51, 0x5c, 0xc6, 0xee, 0x50, 0x07, 0xa3, 0x65, 0x28,
+	0x8b, 0x4d, 0x73, 0x24, 0x83, 0x57, 0xc9, 0xa0, 0x25, 0x28, 0x29, 0x11, 0x0f, 0x43, 0x45, 0x5b,
+	0xcf, 0x7f, 0xfb, 0x63, 0x35, 0x73, 0x65, 0x0b, 0xca, 0xc9, 0x65, 0x4e, 0x98, 0xa2, 0xd4, 0xed,
+	0x6e, 0x87, 0x3c, 0xc0, 0x4e, 0x25, 0x83, 0x74, 0x58, 0x49, 0x64, 0x4d, 0xea, 0x39, 0xd8, 0x31,
+	0xf9, 0x3a, 0x01, 0x79, 0x17, 0x4a, 0x22, 0xc1, 0x2d, 0xea, 0x12, 0x7b, 0x84, 0x2a, 0xb0, 0x20,
+	0xb6, 0x3b, 0x34, 0xd8, 0xc3, 0x84, 0x3b, 0x8f, 0x25, 0x5b, 0x32, 0x76, 0x89, 0xe1, 0x7d, 0x58,
+	0x91, 0xa3, 0x9a, 0x89, 0x9d, 0x74, 0xd5, 0x9e, 0x87, 0xb5, 0x94, 0x04, 0xef, 0xd2, 0xb8, 0xb6,
+	0x59, 0x25, 0x83, 0x6a, 0x70, 0x7e, 0xf2, 0xe1, 0x56, 0x9a, 0x30, 0x12, 0xec, 0x16, 0x94, 0x52,
+	0x43, 0x12, 0x5a, 0x4a, 0xb6, 0x1f, 0x51, 0x0f, 0x57, 0x32, 0xe8, 0x1c, 0x2c, 0x2b, 0xc1, 0x16,
+	0xf5, 0x42, 0xe2, 0x45, 0x34, 0x62, 0x15, 0x0d, 0x9d, 0x85, 0x25, 0x25, 0x6e, 0x89, 0x61, 0x83,
+	0xd8, 0x95, 0xac, 0x44, 0x6c, 0xee, 0x3c, 0x7a, 0x5a, 0xd5, 0x1e, 0x3f, 0xad, 0x6a, 0x7f, 0x3f,
+	0xad, 0x6a, 0x0f, 0x9f, 0x55, 0x33, 0x8f, 0x9f, 0x55, 0x33, 0x7f, 0x3e, 0xab, 0x66, 0xee, 0x5f,
+	0x4d, 0xa5, 0x51, 0x72, 0x92, 0x87, 0xc3, 0x7d, 0x1a, 0xf4, 0xd5, 0xae, 0xf1, 0x40, 0xfe, 0xaa,
+	0x24, 0x12, 0xda, 0x29, 0x88, 0x62, 0x7d, 0xfb, 0xdf, 0x01, 0x00, 0x77, 0xa3, 0x90, 0xb3, 0x71,
+	0x12, 0x00, 0x00,
+}
+
+func (m *Minter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -291,12 +1140,244 @@ func (m *DistributionProportions) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DistributionProportions) MarshalTo(dAtA []byte) (int, error) {
+func (m *Minter) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DistributionProportions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ProvisionRemainder.Size()
+		i -= size
+		if _, err := m.ProvisionRemainder.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastBlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastBlockTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMint(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PhaseStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PhaseStartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMint(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.Phase != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Epoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Epoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Epoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Provision.Size()
+		i -= size
+		if _, err := m.Provision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Blocks != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintMint(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Number != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DevloperWeightedAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DevloperWeightedAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DevloperWeightedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Vesting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeveloperVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeveloperVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeveloperVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintMint(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintMint(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintMint(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if m.Type != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionProportions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionProportions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionProportions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -337,79 +1418,2362 @@ func (m *DistributionProportions) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		if _, err := m.Staking.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMint(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Minter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.AnnualProvisions.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.Inflation.Size()
-	n += 1 + l + sovMint(uint64(l))
-	if m.Phase != 0 {
-		n += 1 + sovMint(uint64(m.Phase))
-	}
-	return n
-}
-
-func (m *DevloperWeightedAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *InflationPhase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationPhase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationPhase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ProvisionCap.Size()
+		i -= size
+		if _, err := m.ProvisionCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Phase != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeveloperRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeveloperRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeveloperRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastVestingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastVestingTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintMint(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x32
+	if len(m.ClawedBack) > 0 {
+		for iNdEx := len(m.ClawedBack) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClawedBack[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Locked) > 0 {
+		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Withdrawn) > 0 {
+		for iNdEx := len(m.Withdrawn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Accrued) > 0 {
+		for iNdEx := len(m.Accrued) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accrued[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeveloperRewards) > 0 {
+		for iNdEx := len(m.DeveloperRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeveloperRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for iNdEx := len(m.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.EcosystemIncentives) > 0 {
+		for iNdEx := len(m.EcosystemIncentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EcosystemIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Staking) > 0 {
+		for iNdEx := len(m.Staking) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Staking[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Minted) > 0 {
+		for iNdEx := len(m.Minted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Phase != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionRemainder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionRemainder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionRemainder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Undistributed.Size()
+		i -= size
+		if _, err := m.Undistributed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.DeveloperRewards) > 0 {
+		for iNdEx := len(m.DeveloperRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeveloperRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.EcosystemIncentives.Size()
+		i -= size
+		if _, err := m.EcosystemIncentives.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Staking.Size()
+		i -= size
+		if _, err := m.Staking.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiverRemainder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiverRemainder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiverRemainder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Remainder.Size()
+		i -= size
+		if _, err := m.Remainder.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiverDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiverDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiverDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CatchUpBlocks != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.CatchUpBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SkippedBlocks != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.SkippedBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PausedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PausedTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintMint(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	if m.PausedHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.PausedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMint(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Minter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.Phase != 0 {
+		n += 1 + sovMint(uint64(m.Phase))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PhaseStartTime)
+	n += 1 + l + sovMint(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastBlockTime)
+	n += 1 + l + sovMint(uint64(l))
+	l = m.ProvisionRemainder.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *Epoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovMint(uint64(m.Number))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovMint(uint64(l))
+	if m.Blocks != 0 {
+		n += 1 + sovMint(uint64(m.Blocks))
+	}
+	l = m.Provision.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *DevloperWeightedAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Vesting.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *DeveloperVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovMint(uint64(m.Type))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovMint(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovMint(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *DistributionProportions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Staking.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.EcosystemIncentives.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.DeveloperRewards.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *InflationPhase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Phase != 0 {
+		n += 1 + sovMint(uint64(m.Phase))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.ProvisionCap.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *DeveloperRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.Accrued) > 0 {
+		for _, e := range m.Accrued {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if len(m.Withdrawn) > 0 {
+		for _, e := range m.Withdrawn {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if len(m.Locked) > 0 {
+		for _, e := range m.Locked {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if len(m.ClawedBack) > 0 {
+		for _, e := range m.ClawedBack {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastVestingTime)
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *DistributionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Phase != 0 {
+		n += 1 + sovMint(uint64(m.Phase))
+	}
+	if len(m.Minted) > 0 {
+		for _, e := range m.Minted {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if len(m.Staking) > 0 {
+		for _, e := range m.Staking {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if len(m.EcosystemIncentives) > 0 {
+		for _, e := range m.EcosystemIncentives {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for _, e := range m.CommunityPool {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if len(m.DeveloperRewards) > 0 {
+		for _, e := range m.DeveloperRewards {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DistributionRemainder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Staking.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.EcosystemIncentives.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.DeveloperRewards) > 0 {
+		for _, e := range m.DeveloperRewards {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	l = m.Undistributed.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *ReceiverRemainder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Remainder.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *ReceiverDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MintPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	if m.PausedHeight != 0 {
+		n += 1 + sovMint(uint64(m.PausedHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PausedTime)
+	n += 1 + l + sovMint(uint64(l))
+	if m.SkippedBlocks != 0 {
+		n += 1 + sovMint(uint64(m.SkippedBlocks))
+	}
+	if m.CatchUpBlocks != 0 {
+		n += 1 + sovMint(uint64(m.CatchUpBlocks))
+	}
+	return n
+}
+
+func (m *FeeBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovMint(uint64(m.Blocks))
+	}
+	return n
+}
+
+func sovMint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMint(x uint64) (n int) {
+	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Minter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhaseStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PhaseStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastBlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionRemainder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProvisionRemainder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Epoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Epoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Epoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Provision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DevloperWeightedAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DevloperWeightedAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DevloperWeightedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeveloperVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeveloperVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeveloperVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= VestingType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionProportions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionProportions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staking", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Staking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemIncentives", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EcosystemIncentives.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeveloperRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationPhase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationPhase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationPhase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProvisionCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeveloperRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeveloperRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeveloperRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accrued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accrued = append(m.Accrued, types1.Coin{})
+			if err := m.Accrued[len(m.Accrued)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawn = append(m.Withdrawn, types1.Coin{})
+			if err := m.Withdrawn[len(m.Withdrawn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locked = append(m.Locked, types1.Coin{})
+			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawedBack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawedBack = append(m.ClawedBack, types1.Coin{})
+			if err := m.ClawedBack[len(m.ClawedBack)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastVestingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastVestingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minted = append(m.Minted, types1.Coin{})
+			if err := m.Minted[len(m.Minted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staking", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staking = append(m.Staking, types1.Coin{})
+			if err := m.Staking[len(m.Staking)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemIncentives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EcosystemIncentives = append(m.EcosystemIncentives, types1.Coin{})
+			if err := m.EcosystemIncentives[len(m.EcosystemIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPool = append(m.CommunityPool, types1.Coin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeveloperRewards = append(m.DeveloperRewards, ReceiverDistribution{})
+			if err := m.DeveloperRewards[len(m.DeveloperRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Weight.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
-}
 
-func (m *DistributionProportions) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Staking.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.EcosystemIncentives.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.DeveloperRewards.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.CommunityPool.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
-}
-
-func sovMint(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMint(x uint64) (n int) {
-	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Minter) Unmarshal(dAtA []byte) error {
+func (m *DistributionRemainder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -432,15 +3796,83 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+			return fmt.Errorf("proto: DistributionRemainder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DistributionRemainder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staking", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Staking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemIncentives", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EcosystemIncentives.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -468,15 +3900,15 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperRewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -486,31 +3918,31 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DeveloperRewards = append(m.DeveloperRewards, ReceiverRemainder{})
+			if err := m.DeveloperRewards[len(m.DeveloperRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Undistributed", wireType)
 			}
-			m.Phase = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -520,11 +3952,26 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Phase |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Undistributed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -546,7 +3993,7 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DevloperWeightedAddress) Unmarshal(dAtA []byte) error {
+func (m *ReceiverRemainder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -569,10 +4016,10 @@ func (m *DevloperWeightedAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DevloperWeightedAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReceiverRemainder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DevloperWeightedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReceiverRemainder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -609,7 +4056,7 @@ func (m *DevloperWeightedAddress) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -637,7 +4084,7 @@ func (m *DevloperWeightedAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Remainder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -662,7 +4109,7 @@ func (m *DevloperWeightedAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
+func (m *ReceiverDistribution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -685,15 +4132,15 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DistributionProportions: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReceiverDistribution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DistributionProportions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReceiverDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staking", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -721,15 +4168,13 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Staking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemIncentives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -739,31 +4184,120 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EcosystemIncentives.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintPause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintPause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintPause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedHeight", wireType)
+			}
+			m.PausedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PausedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -773,31 +4307,118 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DeveloperRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PausedTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedBlocks", wireType)
+			}
+			m.SkippedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkippedBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchUpBlocks", wireType)
+			}
+			m.CatchUpBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CatchUpBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -807,26 +4428,45 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Burned = append(m.Burned, types1.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
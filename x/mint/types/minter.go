@@ -3,15 +3,17 @@ package types
 import (
 	"fmt"
 	"math"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func NewMinter(inflation, annualProvisions sdk.Dec, phase uint64) Minter {
 	return Minter{
-		AnnualProvisions: annualProvisions,
-		Phase:            phase,
-		Inflation:        inflation,
+		AnnualProvisions:   annualProvisions,
+		Phase:              phase,
+		Inflation:          inflation,
+		ProvisionRemainder: sdk.ZeroDec(),
 	}
 }
 
@@ -34,10 +36,23 @@ func ValidateMinter(minter Minter) error {
 		return fmt.Errorf("mint parameter Inflation should be positive, is %s",
 			minter.Inflation.String())
 	}
+	if !minter.ProvisionRemainder.IsNil() && minter.ProvisionRemainder.IsNegative() {
+		return fmt.Errorf("mint parameter ProvisionRemainder should be positive, is %s",
+			minter.ProvisionRemainder.String())
+	}
 	return nil
 }
 
 func (m Minter) PhaseInflationRate(phase uint64, param Params) sdk.Dec {
+	// explicit inflation schedule, phases beyond the table end inflation
+	if len(param.InflationSchedule) > 0 {
+		entry, found := param.GetInflationPhase(phase)
+		if !found {
+			return sdk.ZeroDec()
+		}
+		return entry.Inflation
+	}
+
 	if param.ThresholdPhase >= phase {
 		return sdk.NewDecWithPrec(50, 2).MulInt64(int64(phase))
 	} else {
@@ -45,6 +60,68 @@ func (m Minter) PhaseInflationRate(phase uint64, param Params) sdk.Dec {
 	}
 }
 
+// NextInflationRate returns the inflation rate of the next block in the bonded
+// ratio inflation mode. As in the SDK mint module, the rate moves toward the
+// goal bonded ratio by up to InflationRateChange a year, but it stays between
+// InflationMin and the phase inflation rate, which acts as a ceiling. Inflation
+// ends when the ceiling is zero.
+func (m Minter) NextInflationRate(params Params, bondedRatio sdk.Dec, blockTime time.Time) sdk.Dec {
+	ceiling := m.PhaseInflationRate(m.Phase, params)
+	if !ceiling.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	floor := sdk.MinDec(params.InflationMin, ceiling)
+
+	// (1 - bondedRatio/goalBonded) * inflationRateChange a year
+	changePerYear := sdk.OneDec().Sub(bondedRatio.Quo(params.GoalBonded)).Mul(params.InflationRateChange)
+
+	change := changePerYear.QuoInt64(int64(params.BlocksPerYear))
+	if params.PhaseMode == PhaseByTime {
+		change = sdk.ZeroDec()
+		if !m.LastBlockTime.IsZero() && blockTime.After(m.LastBlockTime) {
+			change = changePerYear.MulInt64(int64(blockTime.Sub(m.LastBlockTime))).QuoInt64(int64(params.PhaseDuration))
+		}
+	}
+
+	inflation := m.Inflation.Add(change)
+	if inflation.GT(ceiling) {
+		return ceiling
+	}
+	if inflation.LT(floor) {
+		return floor
+	}
+	return inflation
+}
+
+// PhaseStartInflationRate returns the inflation rate of the minter as its
+// phase starts. In the bonded ratio inflation mode the current rate carries
+// over, clamped between InflationMin and the ceiling of the new phase, instead
+// of jumping to the ceiling.
+func (m Minter) PhaseStartInflationRate(params Params) sdk.Dec {
+	ceiling := m.PhaseInflationRate(m.Phase, params)
+	if params.InflationMode != InflationBondedRatio || !ceiling.IsPositive() {
+		return ceiling
+	}
+	floor := sdk.MinDec(params.InflationMin, ceiling)
+
+	switch {
+	case m.Inflation.IsNil() || m.Inflation.GT(ceiling):
+		return ceiling
+	case m.Inflation.LT(floor):
+		return floor
+	}
+	return m.Inflation
+}
+
+// PhaseMinter returns the minter moved to the given phase, with the inflation
+// rate the phase starts at and the annual provisions for the total supply.
+func (m Minter) PhaseMinter(params Params, phase uint64, totalSupply sdk.Int) Minter {
+	m.Phase = phase
+	m.Inflation = m.PhaseStartInflationRate(params)
+	m.AnnualProvisions = m.NextAnnualProvisions(params, totalSupply)
+	return m
+}
+
 func (m Minter) CurrentPhase(params Params, currentBlock int64) int64 {
 	v := int64(math.Ceil(float64(currentBlock) / float64(params.BlocksPerYear)))
 	if v == 0 {
@@ -53,11 +130,74 @@ func (m Minter) CurrentPhase(params Params, currentBlock int64) int64 {
 	return v
 }
 
-func (m Minter) NextAnnualProvisions(_ Params, totalSupply sdk.Int) sdk.Dec {
-	return m.Inflation.MulInt(totalSupply)
+// PhaseHeights returns the heights of the first and the last block of the
+// phase when phases advance every BlocksPerYear blocks.
+func (m Minter) PhaseHeights(params Params, phase uint64) (int64, int64) {
+	return int64((phase-1)*params.BlocksPerYear) + 1, int64(phase * params.BlocksPerYear)
+}
+
+// CurrentPhaseByTime returns the phase and its start time at the given block time
+// when phases advance every PhaseDuration. Phase start times stay aligned to
+// the recorded start time, so skipped phases are counted as well.
+func (m Minter) CurrentPhaseByTime(params Params, blockTime time.Time) (uint64, time.Time) {
+	if m.Phase == 0 {
+		return 1, m.PhaseStartTime
+	}
+
+	elapsed := blockTime.Sub(m.PhaseStartTime)
+	if elapsed < params.PhaseDuration {
+		return m.Phase, m.PhaseStartTime
+	}
+
+	passed := uint64(elapsed / params.PhaseDuration)
+	return m.Phase + passed, m.PhaseStartTime.Add(time.Duration(passed) * params.PhaseDuration)
+}
+
+func (m Minter) NextAnnualProvisions(params Params, totalSupply sdk.Int) sdk.Dec {
+	provisions := m.Inflation.MulInt(totalSupply)
+
+	entry, found := params.GetInflationPhase(m.Phase)
+	if found && !entry.ProvisionCap.IsNil() && entry.ProvisionCap.IsPositive() {
+		if cap := entry.ProvisionCap.ToDec(); provisions.GT(cap) {
+			return cap
+		}
+	}
+	return provisions
 }
 
 func (m Minter) BlockProvision(params Params) sdk.Coin {
-	provisionAmt := m.AnnualProvisions.QuoInt(sdk.NewInt(int64(params.BlocksPerYear)))
-	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
+	return sdk.NewCoin(params.MintDenom, m.ExactBlockProvision(params).TruncateInt())
+}
+
+// ExactBlockProvision returns the provisions for a block before truncation.
+func (m Minter) ExactBlockProvision(params Params) sdk.Dec {
+	return m.AnnualProvisions.QuoInt(sdk.NewInt(int64(params.BlocksPerYear)))
+}
+
+// BlockProvisionByTime returns the provisions for a block, scaled by the block
+// time elapsed since the last minting block relative to the phase duration.
+func (m Minter) BlockProvisionByTime(params Params, blockTime time.Time) sdk.Coin {
+	return sdk.NewCoin(params.MintDenom, m.ExactBlockProvisionByTime(params, blockTime).TruncateInt())
+}
+
+// ExactBlockProvisionByTime returns the provisions for a block before
+// truncation when phases advance by block time.
+func (m Minter) ExactBlockProvisionByTime(params Params, blockTime time.Time) sdk.Dec {
+	if m.LastBlockTime.IsZero() || !blockTime.After(m.LastBlockTime) {
+		return sdk.ZeroDec()
+	}
+
+	elapsed := blockTime.Sub(m.LastBlockTime)
+	return m.AnnualProvisions.MulInt64(int64(elapsed)).QuoInt64(int64(params.PhaseDuration))
+}
+
+// CarryProvision adds the fraction carried from the previous blocks to the
+// provision and returns the amount to mint along with the fraction truncated
+// from it, so that the minted amounts add up to the provisions to the unit.
+func (m Minter) CarryProvision(provision sdk.Dec) (sdk.Int, sdk.Dec) {
+	if !m.ProvisionRemainder.IsNil() {
+		provision = provision.Add(m.ProvisionRemainder)
+	}
+	amount := provision.TruncateInt()
+	return amount, provision.Sub(amount.ToDec())
 }
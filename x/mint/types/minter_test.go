@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -227,3 +228,220 @@ func TestBlockProvision(t *testing.T) {
 	}
 
 }
+
+func TestPhaseInflationSchedule(t *testing.T) {
+	minter := DefaultInitialMinter()
+	params := DefaultParams()
+	params.InflationSchedule = []InflationPhase{
+		{Phase: 1, Inflation: sdk.NewDecWithPrec(30, 2), ProvisionCap: sdk.ZeroInt()},
+		{Phase: 2, Inflation: sdk.NewDecWithPrec(20, 2), ProvisionCap: sdk.NewInt(1_000)},
+		{Phase: 3, Inflation: sdk.NewDecWithPrec(10, 2), ProvisionCap: sdk.ZeroInt()},
+	}
+	require.NoError(t, params.Validate())
+
+	tests := []struct {
+		Phase      uint64
+		Inflation  sdk.Dec
+		Provisions sdk.Dec
+	}{
+		{Phase: 0, Inflation: sdk.ZeroDec(), Provisions: sdk.ZeroDec()},
+		{Phase: 1, Inflation: sdk.NewDecWithPrec(30, 2), Provisions: sdk.NewDec(30_000)},
+		// capped by the phase provision cap
+		{Phase: 2, Inflation: sdk.NewDecWithPrec(20, 2), Provisions: sdk.NewDec(1_000)},
+		{Phase: 3, Inflation: sdk.NewDecWithPrec(10, 2), Provisions: sdk.NewDec(10_000)},
+		// beyond the table inflation ends
+		{Phase: 4, Inflation: sdk.ZeroDec(), Provisions: sdk.ZeroDec()},
+		{Phase: params.StopInflationPhase, Inflation: sdk.ZeroDec(), Provisions: sdk.ZeroDec()},
+	}
+	for _, test := range tests {
+		minter.Phase = test.Phase
+		minter.Inflation = minter.PhaseInflationRate(test.Phase, params)
+		require.True(t, test.Inflation.Equal(minter.Inflation), "phase %d", test.Phase)
+		require.True(t, test.Provisions.Equal(minter.NextAnnualProvisions(params, sdk.NewInt(100_000))), "phase %d", test.Phase)
+	}
+}
+
+func TestCurrentPhaseByTime(t *testing.T) {
+	params := DefaultParams()
+	params.PhaseMode = PhaseByTime
+	genesisTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	duration := params.PhaseDuration
+
+	tests := []struct {
+		Phase      uint64
+		BlockTime  time.Time
+		ExpPhase   uint64
+		ExpStarted time.Time
+	}{
+		{Phase: 0, BlockTime: genesisTime.Add(time.Second), ExpPhase: 1, ExpStarted: genesisTime},
+		{Phase: 1, BlockTime: genesisTime.Add(duration - time.Nanosecond), ExpPhase: 1, ExpStarted: genesisTime},
+		{Phase: 1, BlockTime: genesisTime.Add(duration), ExpPhase: 2, ExpStarted: genesisTime.Add(duration)},
+		{Phase: 1, BlockTime: genesisTime.Add(duration + time.Hour), ExpPhase: 2, ExpStarted: genesisTime.Add(duration)},
+		// skipped phases while the chain was halted
+		{Phase: 1, BlockTime: genesisTime.Add(duration*3 + time.Hour), ExpPhase: 4, ExpStarted: genesisTime.Add(duration * 3)},
+	}
+	for _, test := range tests {
+		minter := DefaultInitialMinter()
+		minter.Phase = test.Phase
+		minter.PhaseStartTime = genesisTime
+
+		phase, started := minter.CurrentPhaseByTime(params, test.BlockTime)
+		require.Equal(t, test.ExpPhase, phase)
+		require.True(t, test.ExpStarted.Equal(started))
+	}
+}
+
+func TestBlockProvisionByTime(t *testing.T) {
+	minter := DefaultInitialMinter()
+	params := DefaultParams()
+	params.PhaseMode = PhaseByTime
+	lastBlockTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	minter.AnnualProvisions = sdk.NewDec(int64(params.PhaseDuration / time.Second))
+
+	tests := []struct {
+		elapsed       time.Duration
+		lastBlockTime time.Time
+		expProvisions int64
+	}{
+		{time.Second * 5, lastBlockTime, 5},
+		{time.Second * 7, lastBlockTime, 7},
+		{time.Millisecond * 500, lastBlockTime, 0},
+		{0, lastBlockTime, 0},
+		// nothing is minted before the first recorded block time
+		{time.Second * 5, time.Time{}, 0},
+	}
+	for _, tc := range tests {
+		minter.LastBlockTime = tc.lastBlockTime
+		provisions := minter.BlockProvisionByTime(params, lastBlockTime.Add(tc.elapsed))
+
+		expProvisions := sdk.NewCoin(params.MintDenom, sdk.NewInt(tc.expProvisions))
+		require.True(t, expProvisions.IsEqual(provisions), "elapsed %s: %s", tc.elapsed, provisions)
+	}
+}
+
+func TestNextInflationRate(t *testing.T) {
+	params := DefaultParams()
+	params.InflationMode = InflationBondedRatio
+	params.BlocksPerYear = 100
+
+	// phase 3 of the default curve has a 10% ceiling
+	minter := NewMinter(sdk.NewDecWithPrec(8, 2), sdk.ZeroDec(), 3)
+
+	tests := []struct {
+		name        string
+		inflation   sdk.Dec
+		bondedRatio sdk.Dec
+		expected    sdk.Dec
+	}{
+		// (1 - 0.335/0.67) * 0.13 / 100 = 0.00065 a block
+		{"below goal raises the rate", sdk.NewDecWithPrec(8, 2), sdk.NewDecWithPrec(335, 3), sdk.NewDecWithPrec(8065, 5)},
+		{"above goal lowers the rate", sdk.NewDecWithPrec(8, 2), sdk.OneDec(), sdk.MustNewDecFromStr("0.079359701492537314")},
+		{"at goal keeps the rate", sdk.NewDecWithPrec(8, 2), sdk.NewDecWithPrec(67, 2), sdk.NewDecWithPrec(8, 2)},
+		{"capped by the phase rate", sdk.NewDecWithPrec(10, 2), sdk.ZeroDec(), sdk.NewDecWithPrec(10, 2)},
+		{"floored by the min rate", sdk.NewDecWithPrec(7, 2), sdk.OneDec(), sdk.NewDecWithPrec(7, 2)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			minter.Inflation = tc.inflation
+			require.Equal(t, tc.expected.String(), minter.NextInflationRate(params, tc.bondedRatio, time.Time{}).String())
+		})
+	}
+
+	// the phase rate is used when below the min rate
+	minter = NewMinter(sdk.NewDecWithPrec(2, 2), sdk.ZeroDec(), 11)
+	require.Equal(t, sdk.NewDecWithPrec(2, 2).String(), minter.NextInflationRate(params, sdk.OneDec(), time.Time{}).String())
+
+	// inflation ends with the phase table
+	minter = NewMinter(sdk.NewDecWithPrec(7, 2), sdk.ZeroDec(), params.StopInflationPhase)
+	require.True(t, minter.NextInflationRate(params, sdk.ZeroDec(), time.Time{}).IsZero())
+
+	// the change scales with the elapsed time when phases advance by time
+	params.PhaseMode = PhaseByTime
+	lastBlockTime := time.Unix(1_000_000, 0).UTC()
+	minter = NewMinter(sdk.NewDecWithPrec(8, 2), sdk.ZeroDec(), 3)
+	minter.LastBlockTime = lastBlockTime
+	rate := minter.NextInflationRate(params, sdk.ZeroDec(), lastBlockTime.Add(params.PhaseDuration/10))
+	require.Equal(t, sdk.NewDecWithPrec(93, 3).String(), rate.String())
+}
+
+func TestPhaseStartInflationRate(t *testing.T) {
+	params := DefaultParams()
+	params.InflationSchedule = []InflationPhase{
+		{Phase: 1, Inflation: sdk.NewDecWithPrec(10, 2), ProvisionCap: sdk.ZeroInt()},
+		{Phase: 2, Inflation: sdk.NewDecWithPrec(3, 2), ProvisionCap: sdk.ZeroInt()},
+	}
+	params.InflationMin = sdk.NewDecWithPrec(5, 2)
+
+	// the fixed mode starts every phase at its rate
+	minter := NewMinter(sdk.NewDecWithPrec(8, 2), sdk.ZeroDec(), 1)
+	require.Equal(t, sdk.NewDecWithPrec(10, 2).String(), minter.PhaseStartInflationRate(params).String())
+
+	params.InflationMode = InflationBondedRatio
+	tests := []struct {
+		name      string
+		phase     uint64
+		inflation sdk.Dec
+		expected  sdk.Dec
+	}{
+		{"within the bounds keeps the rate", 1, sdk.NewDecWithPrec(8, 2), sdk.NewDecWithPrec(8, 2)},
+		{"above the ceiling is capped", 1, sdk.NewDecWithPrec(12, 2), sdk.NewDecWithPrec(10, 2)},
+		{"below the min rate is floored", 1, sdk.NewDecWithPrec(4, 2), sdk.NewDecWithPrec(5, 2)},
+		{"a ceiling below the min rate caps", 2, sdk.NewDecWithPrec(8, 2), sdk.NewDecWithPrec(3, 2)},
+		{"a zero ceiling ends inflation", 3, sdk.NewDecWithPrec(8, 2), sdk.ZeroDec()},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			minter := NewMinter(tc.inflation, sdk.ZeroDec(), tc.phase)
+			require.Equal(t, tc.expected.String(), minter.PhaseStartInflationRate(params).String())
+		})
+	}
+}
+
+func TestCarryProvision(t *testing.T) {
+	params := DefaultParams()
+	params.BlocksPerYear = 7919
+
+	for _, annualProvisions := range []sdk.Dec{
+		sdk.NewDec(100_000_000_000_000),
+		sdk.MustNewDecFromStr("123456789.75"),
+		sdk.MustNewDecFromStr("0.0737").MulInt64(1_000_000_000_000_003),
+		sdk.NewDec(7918),
+	} {
+		minter := NewMinter(sdk.NewDecWithPrec(10, 2), annualProvisions, 1)
+
+		// emissions of a phase add up to the annual provisions, short of at
+		// most the unit lost to the truncated block provision decimals
+		total := sdk.ZeroInt()
+		for i := uint64(0); i < params.BlocksPerYear; i++ {
+			var amount sdk.Int
+			amount, minter.ProvisionRemainder = minter.CarryProvision(minter.ExactBlockProvision(params))
+			total = total.Add(amount)
+			require.True(t, minter.ProvisionRemainder.LT(sdk.OneDec()))
+		}
+		shortfall := annualProvisions.TruncateInt().Sub(total)
+		require.True(t, !shortfall.IsNegative() && shortfall.LTE(sdk.OneInt()), "%s: %s", annualProvisions, total)
+	}
+
+	// same by block time, with uneven block times
+	params.PhaseDuration = 1000 * time.Second
+	for _, annualProvisions := range []sdk.Dec{sdk.NewDec(1_000_000_007), sdk.MustNewDecFromStr("999.25")} {
+		minter := NewMinter(sdk.NewDecWithPrec(10, 2), annualProvisions, 1)
+		minter.LastBlockTime = time.Unix(1_000_000, 0).UTC()
+
+		total := sdk.ZeroInt()
+		for i := 0; i < 200; i++ {
+			blockTime := minter.LastBlockTime.Add(3 * time.Second)
+			if i%2 == 1 {
+				blockTime = minter.LastBlockTime.Add(7 * time.Second)
+			}
+			var amount sdk.Int
+			amount, minter.ProvisionRemainder = minter.CarryProvision(minter.ExactBlockProvisionByTime(params, blockTime))
+			minter.LastBlockTime = blockTime
+			total = total.Add(amount)
+		}
+		shortfall := annualProvisions.TruncateInt().Sub(total)
+		require.True(t, !shortfall.IsNegative() && shortfall.LTE(sdk.OneInt()), "%s: %s", annualProvisions, total)
+	}
+}
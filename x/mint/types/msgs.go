@@ -0,0 +1,281 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgUpdateParams              = "update_params"
+	TypeMsgWithdrawDeveloperRewards  = "withdraw_developer_rewards"
+	TypeMsgClawbackDeveloperRewards  = "clawback_developer_rewards"
+	TypeMsgAddDeveloperReceiver      = "add_developer_receiver"
+	TypeMsgRemoveDeveloperReceiver   = "remove_developer_receiver"
+	TypeMsgReplaceDeveloperReceiver  = "replace_developer_receiver"
+	TypeMsgReweightDeveloperReceiver = "reweight_developer_receiver"
+	TypeMsgSetPaused                 = "set_paused"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgWithdrawDeveloperRewards{}
+	_ sdk.Msg = &MsgClawbackDeveloperRewards{}
+	_ sdk.Msg = &MsgAddDeveloperReceiver{}
+	_ sdk.Msg = &MsgRemoveDeveloperReceiver{}
+	_ sdk.Msg = &MsgReplaceDeveloperReceiver{}
+	_ sdk.Msg = &MsgReweightDeveloperReceiver{}
+	_ sdk.Msg = &MsgSetPaused{}
+)
+
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	return msg.Params.Validate()
+}
+
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(Amino.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func NewMsgWithdrawDeveloperRewards(receiver sdk.AccAddress) *MsgWithdrawDeveloperRewards {
+	return &MsgWithdrawDeveloperRewards{
+		Receiver: receiver.String(),
+	}
+}
+
+func (msg MsgWithdrawDeveloperRewards) Route() string { return RouterKey }
+
+func (msg MsgWithdrawDeveloperRewards) Type() string { return TypeMsgWithdrawDeveloperRewards }
+
+func (msg MsgWithdrawDeveloperRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address: %s", err)
+	}
+	return nil
+}
+
+func (msg MsgWithdrawDeveloperRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(Amino.MustMarshalJSON(&msg))
+}
+
+func (msg MsgWithdrawDeveloperRewards) GetSigners() []sdk.AccAddress {
+	receiver, _ := sdk.AccAddressFromBech32(msg.Receiver)
+	return []sdk.AccAddress{receiver}
+}
+
+func NewMsgClawbackDeveloperRewards(authority string, receiver string) *MsgClawbackDeveloperRewards {
+	return &MsgClawbackDeveloperRewards{
+		Authority: authority,
+		Receiver:  receiver,
+	}
+}
+
+func (msg MsgClawbackDeveloperRewards) Route() string { return RouterKey }
+
+func (msg MsgClawbackDeveloperRewards) Type() string { return TypeMsgClawbackDeveloperRewards }
+
+func (msg MsgClawbackDeveloperRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address: %s", err)
+	}
+	return nil
+}
+
+func (msg MsgClawbackDeveloperRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(Amino.MustMarshalJSON(&msg))
+}
+
+func (msg MsgClawbackDeveloperRewards) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func NewMsgAddDeveloperReceiver(authority string, receiver DevloperWeightedAddress) *MsgAddDeveloperReceiver {
+	return &MsgAddDeveloperReceiver{
+		Authority: authority,
+		Receiver:  receiver,
+	}
+}
+
+func (msg MsgAddDeveloperReceiver) Route() string { return RouterKey }
+
+func (msg MsgAddDeveloperReceiver) Type() string { return TypeMsgAddDeveloperReceiver }
+
+func (msg MsgAddDeveloperReceiver) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address: %s", err)
+	}
+	if err := validateReceiverWeight(msg.Receiver.Weight); err != nil {
+		return err
+	}
+	return msg.Receiver.Vesting.Validate()
+}
+
+func (msg MsgAddDeveloperReceiver) GetSignBytes() []byte {
+	return sdk.MustSortJSON(Amino.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAddDeveloperReceiver) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func NewMsgRemoveDeveloperReceiver(authority string, address string, redistribution WeightRedistribution) *MsgRemoveDeveloperReceiver {
+	return &MsgRemoveDeveloperReceiver{
+		Authority:      authority,
+		Address:        address,
+		Redistribution: redistribution,
+	}
+}
+
+func (msg MsgRemoveDeveloperReceiver) Route() string { return RouterKey }
+
+func (msg MsgRemoveDeveloperReceiver) Type() string { return TypeMsgRemoveDeveloperReceiver }
+
+func (msg MsgRemoveDeveloperReceiver) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address: %s", err)
+	}
+	if _, ok := WeightRedistribution_name[int32(msg.Redistribution)]; !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid weight redistribution: %s", msg.Redistribution)
+	}
+	return nil
+}
+
+func (msg MsgRemoveDeveloperReceiver) GetSignBytes() []byte {
+	return sdk.MustSortJSON(Amino.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRemoveDeveloperReceiver) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func NewMsgReplaceDeveloperReceiver(authority string, address string, newAddress string) *MsgReplaceDeveloperReceiver {
+	return &MsgReplaceDeveloperReceiver{
+		Authority:  authority,
+		Address:    address,
+		NewAddress: newAddress,
+	}
+}
+
+func (msg MsgReplaceDeveloperReceiver) Route() string { return RouterKey }
+
+func (msg MsgReplaceDeveloperReceiver) Type() string { return TypeMsgReplaceDeveloperReceiver }
+
+func (msg MsgReplaceDeveloperReceiver) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new receiver address: %s", err)
+	}
+	if msg.Address == msg.NewAddress {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "new receiver address is the same as the receiver address")
+	}
+	return nil
+}
+
+func (msg MsgReplaceDeveloperReceiver) GetSignBytes() []byte {
+	return sdk.MustSortJSON(Amino.MustMarshalJSON(&msg))
+}
+
+func (msg MsgReplaceDeveloperReceiver) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func NewMsgReweightDeveloperReceiver(authority string, address string, weight sdk.Dec) *MsgReweightDeveloperReceiver {
+	return &MsgReweightDeveloperReceiver{
+		Authority: authority,
+		Address:   address,
+		Weight:    weight,
+	}
+}
+
+func (msg MsgReweightDeveloperReceiver) Route() string { return RouterKey }
+
+func (msg MsgReweightDeveloperReceiver) Type() string { return TypeMsgReweightDeveloperReceiver }
+
+func (msg MsgReweightDeveloperReceiver) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address: %s", err)
+	}
+	return validateReceiverWeight(msg.Weight)
+}
+
+func (msg MsgReweightDeveloperReceiver) GetSignBytes() []byte {
+	return sdk.MustSortJSON(Amino.MustMarshalJSON(&msg))
+}
+
+func (msg MsgReweightDeveloperReceiver) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func NewMsgSetPaused(authority string, paused bool) *MsgSetPaused {
+	return &MsgSetPaused{
+		Authority: authority,
+		Paused:    paused,
+	}
+}
+
+func (msg MsgSetPaused) Route() string { return RouterKey }
+
+func (msg MsgSetPaused) Type() string { return TypeMsgSetPaused }
+
+func (msg MsgSetPaused) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	return nil
+}
+
+func (msg MsgSetPaused) GetSignBytes() []byte {
+	return sdk.MustSortJSON(Amino.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetPaused) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// validateReceiverWeight checks that a receiver weight is in (0, 1].
+func validateReceiverWeight(weight sdk.Dec) error {
+	if weight.IsNil() || !weight.IsPositive() || weight.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "receiver weight must be in (0, 1]: %s", weight)
+	}
+	return nil
+}
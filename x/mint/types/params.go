@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -19,6 +20,19 @@ var (
 	KeyDistributionProportions          = []byte("DistributionProportions")
 	KeyWeightedDeveloperRewardsReceiver = []byte("WeightedDeveloperRewardsReceiver")
 	KeyBlocksPerYear                    = []byte("BlocksPerYear")
+	KeyInflationSchedule                = []byte("InflationSchedule")
+	KeyPhaseMode                        = []byte("PhaseMode")
+	KeyPhaseDuration                    = []byte("PhaseDuration")
+	KeyMaxSupply                        = []byte("MaxSupply")
+	KeyInflationMode                    = []byte("InflationMode")
+	KeyGoalBonded                       = []byte("GoalBonded")
+	KeyInflationRateChange              = []byte("InflationRateChange")
+	KeyInflationMin                     = []byte("InflationMin")
+	KeyEpochBlocks                      = []byte("EpochBlocks")
+	KeyEpochDuration                    = []byte("EpochDuration")
+	KeyGuardian                         = []byte("Guardian")
+	KeyPausePolicy                      = []byte("PausePolicy")
+	KeyFeeBurnRatio                     = []byte("FeeBurnRatio")
 )
 
 func ParamKeyTable() paramtypes.KeyTable {
@@ -32,6 +46,19 @@ func NewParams(
 	distrProportions DistributionProportions,
 	weightedDevRewardsReceivers []DevloperWeightedAddress,
 	blocksPerYear uint64,
+	inflationSchedule []InflationPhase,
+	phaseMode PhaseMode,
+	phaseDuration time.Duration,
+	maxSupply sdk.Int,
+	inflationMode InflationMode,
+	goalBonded sdk.Dec,
+	inflationRateChange sdk.Dec,
+	inflationMin sdk.Dec,
+	epochBlocks uint64,
+	epochDuration time.Duration,
+	guardian string,
+	pausePolicy PausePolicy,
+	feeBurnRatio sdk.Dec,
 ) Params {
 	return Params{
 		MintDenom:                         mintDenom,
@@ -40,6 +67,19 @@ func NewParams(
 		DistributionProportions:           distrProportions,
 		WeightedDeveloperRewardsReceivers: weightedDevRewardsReceivers,
 		BlocksPerYear:                     blocksPerYear,
+		InflationSchedule:                 inflationSchedule,
+		PhaseMode:                         phaseMode,
+		PhaseDuration:                     phaseDuration,
+		MaxSupply:                         maxSupply,
+		InflationMode:                     inflationMode,
+		GoalBonded:                        goalBonded,
+		InflationRateChange:               inflationRateChange,
+		InflationMin:                      inflationMin,
+		EpochBlocks:                       epochBlocks,
+		EpochDuration:                     epochDuration,
+		Guardian:                          guardian,
+		PausePolicy:                       pausePolicy,
+		FeeBurnRatio:                      feeBurnRatio,
 	}
 }
 
@@ -56,6 +96,19 @@ func DefaultParams() Params {
 		},
 		[]DevloperWeightedAddress{},
 		uint64(60*60*8766/5),
+		[]InflationPhase{},
+		PhaseByHeight,
+		time.Hour*8766,
+		sdk.ZeroInt(),
+		InflationFixed,
+		sdk.NewDecWithPrec(67, 2),
+		sdk.NewDecWithPrec(13, 2),
+		sdk.NewDecWithPrec(7, 2),
+		uint64(1),
+		time.Duration(0),
+		"",
+		PauseForfeit,
+		sdk.ZeroDec(),
 	)
 }
 
@@ -68,6 +121,19 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDistributionProportions, &p.DistributionProportions, validateDistributionProportions),
 		paramtypes.NewParamSetPair(KeyWeightedDeveloperRewardsReceiver, &p.WeightedDeveloperRewardsReceivers, validateWeightedDeveloperRewardsReceivers),
 		paramtypes.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validateBlocksPerYear),
+		paramtypes.NewParamSetPair(KeyInflationSchedule, &p.InflationSchedule, validateInflationSchedule),
+		paramtypes.NewParamSetPair(KeyPhaseMode, &p.PhaseMode, validatePhaseMode),
+		paramtypes.NewParamSetPair(KeyPhaseDuration, &p.PhaseDuration, validatePhaseDuration),
+		paramtypes.NewParamSetPair(KeyMaxSupply, &p.MaxSupply, validateMaxSupply),
+		paramtypes.NewParamSetPair(KeyInflationMode, &p.InflationMode, validateInflationMode),
+		paramtypes.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateGoalBonded),
+		paramtypes.NewParamSetPair(KeyInflationRateChange, &p.InflationRateChange, validateInflationRateChange),
+		paramtypes.NewParamSetPair(KeyInflationMin, &p.InflationMin, validateInflationMin),
+		paramtypes.NewParamSetPair(KeyEpochBlocks, &p.EpochBlocks, validateEpochBlocks),
+		paramtypes.NewParamSetPair(KeyEpochDuration, &p.EpochDuration, validateEpochDuration),
+		paramtypes.NewParamSetPair(KeyGuardian, &p.Guardian, validateGuardian),
+		paramtypes.NewParamSetPair(KeyPausePolicy, &p.PausePolicy, validatePausePolicy),
+		paramtypes.NewParamSetPair(KeyFeeBurnRatio, &p.FeeBurnRatio, validateFeeBurnRatio),
 	}
 }
 
@@ -97,12 +163,107 @@ func (p Params) Validate() error {
 	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
 		return err
 	}
+	if err := validateInflationSchedule(p.InflationSchedule); err != nil {
+		return err
+	}
+	if err := validatePhaseMode(p.PhaseMode); err != nil {
+		return err
+	}
+	if err := validatePhaseDuration(p.PhaseDuration); err != nil {
+		return err
+	}
+	if err := validateMaxSupply(p.MaxSupply); err != nil {
+		return err
+	}
+	if err := validateInflationMode(p.InflationMode); err != nil {
+		return err
+	}
+	if err := validateGoalBonded(p.GoalBonded); err != nil {
+		return err
+	}
+	if err := validateInflationRateChange(p.InflationRateChange); err != nil {
+		return err
+	}
+	if err := validateInflationMin(p.InflationMin); err != nil {
+		return err
+	}
+	if err := validateEpochBlocks(p.EpochBlocks); err != nil {
+		return err
+	}
+	if err := validateEpochDuration(p.EpochDuration); err != nil {
+		return err
+	}
+	if err := validateGuardian(p.Guardian); err != nil {
+		return err
+	}
+	if err := validatePausePolicy(p.PausePolicy); err != nil {
+		return err
+	}
+	if err := validateFeeBurnRatio(p.FeeBurnRatio); err != nil {
+		return err
+	}
 	if p.ThresholdPhase >= p.StopInflationPhase {
 		return fmt.Errorf("threshold phase must be smaller than stop inflation phase")
 	}
+	if n := len(p.InflationSchedule); n > 0 && p.InflationSchedule[n-1].Phase >= p.StopInflationPhase {
+		return fmt.Errorf("inflation schedule must end before stop inflation phase")
+	}
 	return nil
 }
 
+// GetDeveloperVesting returns the vesting schedule of a developer rewards
+// receiver, false if the address is not a receiver.
+func (p Params) GetDeveloperVesting(address string) (DeveloperVesting, bool) {
+	for _, receiver := range p.WeightedDeveloperRewardsReceivers {
+		if receiver.Address == address {
+			return receiver.Vesting, true
+		}
+	}
+	return DeveloperVesting{}, false
+}
+
+// HasMaxSupply returns true if the total supply of the mint denom is capped.
+func (p Params) HasMaxSupply() bool {
+	return !p.MaxSupply.IsNil() && p.MaxSupply.IsPositive()
+}
+
+// CapProvision caps the provision so that the total supply does not exceed the
+// max supply. It returns true when the max supply is reached.
+func (p Params) CapProvision(provision sdk.Coin, totalSupply sdk.Int) (sdk.Coin, bool) {
+	if !p.HasMaxSupply() {
+		return provision, false
+	}
+
+	remaining := p.MaxSupply.Sub(totalSupply)
+	if provision.Amount.LT(remaining) {
+		return provision, false
+	}
+	return sdk.NewCoin(provision.Denom, sdk.MaxInt(remaining, sdk.ZeroInt())), true
+}
+
+// HasEpochs returns true if coins are minted once per epoch instead of every
+// block.
+func (p Params) HasEpochs() bool {
+	return p.EpochDuration > 0 || p.EpochBlocks > 1
+}
+
+// IsEpochEnd returns true if the epoch ends with the block at the given time.
+func (p Params) IsEpochEnd(epoch Epoch, blockTime time.Time) bool {
+	if p.EpochDuration > 0 {
+		return !blockTime.Before(epoch.StartTime.Add(p.EpochDuration))
+	}
+	return epoch.Blocks >= p.EpochBlocks
+}
+
+// GetInflationPhase returns the inflation schedule entry of the given phase.
+// It returns false when the schedule is empty or does not cover the phase.
+func (p Params) GetInflationPhase(phase uint64) (InflationPhase, bool) {
+	if phase == 0 || phase > uint64(len(p.InflationSchedule)) {
+		return InflationPhase{}, false
+	}
+	return p.InflationSchedule[phase-1], true
+}
+
 func validateMintDenom(i interface{}) error {
 	v, ok := i.(string)
 	if !ok {
@@ -193,6 +354,9 @@ func validateWeightedDeveloperRewardsReceivers(i interface{}) error {
 		if w.Weight.GT(sdk.NewDec(1)) {
 			return fmt.Errorf("more than 1 weight at %dth", i)
 		}
+		if err := w.Vesting.Validate(); err != nil {
+			return fmt.Errorf("invalid vesting at %dth: %w", i, err)
+		}
 		weightSum = weightSum.Add(w.Weight)
 	}
 
@@ -215,3 +379,216 @@ func validateBlocksPerYear(i interface{}) error {
 
 	return nil
 }
+
+func validateInflationSchedule(i interface{}) error {
+	v, ok := i.([]InflationPhase)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// use the default inflation curve when schedule is empty
+	for i, entry := range v {
+		if i == 0 && entry.Phase != 1 {
+			return fmt.Errorf("inflation schedule must start at phase 1, got %d", entry.Phase)
+		}
+		if i > 0 {
+			prev := v[i-1].Phase
+			if entry.Phase <= prev {
+				return fmt.Errorf("inflation schedule phases must be ascending: %d after %d", entry.Phase, prev)
+			}
+			if entry.Phase != prev+1 {
+				return fmt.Errorf("gap in inflation schedule between phase %d and %d", prev, entry.Phase)
+			}
+		}
+		if entry.Inflation.IsNil() || entry.Inflation.IsNegative() {
+			return fmt.Errorf("negative inflation at phase %d", entry.Phase)
+		}
+		// a zero rate ends inflation for good, only the last phase may have it
+		if entry.Inflation.IsZero() && i < len(v)-1 {
+			return fmt.Errorf("zero inflation at phase %d before the last phase", entry.Phase)
+		}
+		if !entry.ProvisionCap.IsNil() && entry.ProvisionCap.IsNegative() {
+			return fmt.Errorf("negative provision cap at phase %d", entry.Phase)
+		}
+	}
+
+	return nil
+}
+
+func validatePhaseMode(i interface{}) error {
+	v, ok := i.(PhaseMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := PhaseMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid phase mode: %d", v)
+	}
+
+	return nil
+}
+
+func validatePhaseDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("phase duration must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxSupply(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// no cap when max supply is not set
+	if v.IsNil() {
+		return nil
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("max supply cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateInflationMode(i interface{}) error {
+	v, ok := i.(InflationMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := InflationMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid inflation mode: %d", v)
+	}
+
+	return nil
+}
+
+func validateGoalBonded(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("goal bonded must be positive: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("goal bonded too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateInflationRateChange(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("inflation rate change cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("inflation rate change too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateInflationMin(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// a zero rate would be taken for the end of inflation
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("min inflation must be positive: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("min inflation too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateEpochBlocks(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateEpochDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// epochs follow epoch blocks when zero
+	if v < 0 {
+		return fmt.Errorf("epoch duration cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateGuardian(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// only the authority can pause when empty
+	if v == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid guardian address: %w", err)
+	}
+
+	return nil
+}
+
+func validatePausePolicy(i interface{}) error {
+	v, ok := i.(PausePolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := PausePolicy_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid pause policy: %d", v)
+	}
+
+	return nil
+}
+
+func validateFeeBurnRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("fee burn ratio cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("fee burn ratio cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("fee burn ratio too large: %s", v)
+	}
+
+	return nil
+}
@@ -5,17 +5,22 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -32,6 +37,39 @@ type Params struct {
 	DistributionProportions           DistributionProportions   `protobuf:"bytes,4,opt,name=distribution_proportions,json=distributionProportions,proto3" json:"distribution_proportions"`
 	WeightedDeveloperRewardsReceivers []DevloperWeightedAddress `protobuf:"bytes,5,rep,name=weighted_developer_rewards_receivers,json=weightedDeveloperRewardsReceivers,proto3" json:"weighted_developer_rewards_receivers"`
 	BlocksPerYear                     uint64                    `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	//explicit inflation rate per phase, the default curve is used when empty.
+	//only the last phase may have a zero rate, which ends inflation
+	InflationSchedule []InflationPhase `protobuf:"bytes,7,rep,name=inflation_schedule,json=inflationSchedule,proto3" json:"inflation_schedule"`
+	//how phases advance, by block height or by block time
+	PhaseMode PhaseMode `protobuf:"varint,8,opt,name=phase_mode,json=phaseMode,proto3,enum=galaxy.mint.PhaseMode" json:"phase_mode,omitempty"`
+	//length of a phase when phases advance by block time
+	PhaseDuration time.Duration `protobuf:"bytes,9,opt,name=phase_duration,json=phaseDuration,proto3,stdduration" json:"phase_duration"`
+	//hard cap of the mint denom total supply, zero means no cap
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply"`
+	//how the inflation rate is derived within a phase
+	InflationMode InflationMode `protobuf:"varint,11,opt,name=inflation_mode,json=inflationMode,proto3,enum=galaxy.mint.InflationMode" json:"inflation_mode,omitempty"`
+	//bonded ratio targeted by the bonded ratio inflation mode
+	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded"`
+	//maximum annual change of the inflation rate in the bonded ratio inflation mode
+	InflationRateChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=inflation_rate_change,json=inflationRateChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_rate_change"`
+	//minimum inflation rate in the bonded ratio inflation mode, the phase rate
+	//is used when it is lower
+	InflationMin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=inflation_min,json=inflationMin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_min"`
+	//number of blocks of a minting epoch, coins are minted every block when
+	//zero or one
+	EpochBlocks uint64 `protobuf:"varint,15,opt,name=epoch_blocks,json=epochBlocks,proto3" json:"epoch_blocks,omitempty"`
+	//length of a minting epoch in block time, takes precedence over
+	//epoch_blocks when positive
+	EpochDuration time.Duration `protobuf:"bytes,16,opt,name=epoch_duration,json=epochDuration,proto3,stdduration" json:"epoch_duration"`
+	//address allowed to pause minting and clairdrop claims besides the
+	//authority, none when empty
+	Guardian string `protobuf:"bytes,17,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	//what happens to the provisions of the blocks skipped while minting is
+	//paused
+	PausePolicy PausePolicy `protobuf:"varint,18,opt,name=pause_policy,json=pausePolicy,proto3,enum=galaxy.mint.PausePolicy" json:"pause_policy,omitempty"`
+	//ratio of the mint denom fees in the fee collector burned every block
+	//before distribution
+	FeeBurnRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,opt,name=fee_burn_ratio,json=feeBurnRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_burn_ratio"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -108,6 +146,62 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetInflationSchedule() []InflationPhase {
+	if m != nil {
+		return m.InflationSchedule
+	}
+	return nil
+}
+
+func (m *Params) GetPhaseMode() PhaseMode {
+	if m != nil {
+		return m.PhaseMode
+	}
+	return PhaseByHeight
+}
+
+func (m *Params) GetPhaseDuration() time.Duration {
+	if m != nil {
+		return m.PhaseDuration
+	}
+	return 0
+}
+
+func (m *Params) GetInflationMode() InflationMode {
+	if m != nil {
+		return m.InflationMode
+	}
+	return InflationFixed
+}
+
+func (m *Params) GetEpochBlocks() uint64 {
+	if m != nil {
+		return m.EpochBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetEpochDuration() time.Duration {
+	if m != nil {
+		return m.EpochDuration
+	}
+	return 0
+}
+
+func (m *Params) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *Params) GetPausePolicy() PausePolicy {
+	if m != nil {
+		return m.PausePolicy
+	}
+	return PauseForfeit
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "galaxy.mint.Params")
 }
@@ -115,31 +209,53 @@ func init() {
 func init() { proto.RegisterFile("galaxy/mint/params.proto", fileDescriptor_f6f9c86fd892794e) }
 
 var fileDescriptor_f6f9c86fd892794e = []byte{
-	// 383 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x0a, 0xd3, 0x40,
-	0x10, 0xc6, 0x13, 0x1b, 0x0b, 0xdd, 0xa2, 0x85, 0x50, 0x34, 0x14, 0x4c, 0xa3, 0x14, 0xed, 0x41,
-	0x12, 0xd1, 0x9b, 0x37, 0x4b, 0x11, 0xbc, 0x85, 0x5c, 0x44, 0x2f, 0x61, 0xd3, 0x1d, 0x93, 0xa5,
-	0x49, 0x36, 0xcc, 0x6e, 0xff, 0xe1, 0x4b, 0x78, 0xf0, 0xe0, 0xd1, 0xc7, 0xe9, 0xb1, 0x47, 0x4f,
-	0x22, 0xed, 0x8b, 0xc8, 0x66, 0x1b, 0x2d, 0xe8, 0x25, 0x4c, 0xbe, 0xef, 0x37, 0xdf, 0xec, 0xee,
-	0x10, 0x2f, 0xa7, 0x25, 0xdd, 0x1f, 0xa2, 0x8a, 0xd7, 0x2a, 0x6a, 0x28, 0xd2, 0x4a, 0x86, 0x0d,
-	0x0a, 0x25, 0xdc, 0xa1, 0x71, 0x42, 0xed, 0x4c, 0xc6, 0xb9, 0xc8, 0x45, 0xab, 0x47, 0xba, 0x32,
-	0xc8, 0xe4, 0xc1, 0x6d, 0xb3, 0xfe, 0x18, 0xfd, 0xc9, 0xd7, 0x1e, 0xe9, 0xc7, 0x6d, 0x96, 0xfb,
-	0x88, 0x10, 0x6d, 0xa4, 0x0c, 0x6a, 0x51, 0x79, 0x76, 0x60, 0xcf, 0x07, 0xc9, 0x40, 0x2b, 0x4b,
-	0x2d, 0xb8, 0xcf, 0xc8, 0x48, 0x15, 0x08, 0xb2, 0x10, 0x25, 0x4b, 0x9b, 0x82, 0x4a, 0xf0, 0xee,
-	0x04, 0xf6, 0xdc, 0x49, 0xee, 0xff, 0x91, 0x63, 0xad, 0xba, 0x2f, 0xc8, 0x58, 0x2a, 0xd1, 0xa4,
-	0xbc, 0xfe, 0x54, 0x52, 0xc5, 0x45, 0x7d, 0xa5, 0x7b, 0x2d, 0xed, 0x6a, 0xef, 0x5d, 0x67, 0x99,
-	0x0e, 0x20, 0x1e, 0xe3, 0x52, 0x21, 0xcf, 0x36, 0x86, 0x47, 0xd1, 0x08, 0xd4, 0xa5, 0xf4, 0x9c,
-	0xc0, 0x9e, 0x0f, 0x5f, 0xce, 0xc2, 0x9b, 0x2b, 0x86, 0xcb, 0x1b, 0x38, 0xfe, 0xcb, 0x2e, 0x9c,
-	0xe3, 0xcf, 0xa9, 0x95, 0x3c, 0x64, 0xff, 0xb7, 0xdd, 0xcf, 0x64, 0xb6, 0x03, 0x9e, 0x17, 0x0a,
-	0x58, 0xca, 0x60, 0x0b, 0xa5, 0x68, 0x00, 0x53, 0x84, 0x1d, 0x45, 0x26, 0x53, 0x84, 0x15, 0xf0,
-	0x2d, 0xa0, 0xf4, 0xee, 0x06, 0xbd, 0x7f, 0x47, 0xc2, 0xb6, 0xc5, 0xdf, 0x5f, 0x03, 0xde, 0x30,
-	0x86, 0x20, 0xbb, 0x91, 0x8f, 0xbb, 0xdc, 0x65, 0x17, 0x9b, 0x98, 0xd4, 0xa4, 0x0b, 0x75, 0x9f,
-	0x92, 0x51, 0x56, 0x8a, 0xd5, 0x5a, 0xa6, 0x7a, 0xe8, 0x01, 0x28, 0x7a, 0xfd, 0xf6, 0x41, 0xee,
-	0x19, 0x39, 0x06, 0xfc, 0x00, 0x14, 0x5f, 0x3b, 0xdf, 0xbe, 0x4f, 0xad, 0xc5, 0xdb, 0xe3, 0xd9,
-	0xb7, 0x4f, 0x67, 0xdf, 0xfe, 0x75, 0xf6, 0xed, 0x2f, 0x17, 0xdf, 0x3a, 0x5d, 0x7c, 0xeb, 0xc7,
-	0xc5, 0xb7, 0x3e, 0x3e, 0xcf, 0xb9, 0x2a, 0x36, 0x59, 0xb8, 0x12, 0x55, 0x64, 0x0e, 0x58, 0x83,
-	0xda, 0x09, 0x5c, 0x5f, 0xff, 0xa2, 0xbd, 0xd9, 0xb1, 0x3a, 0x34, 0x20, 0xb3, 0x7e, 0xbb, 0xe5,
-	0x57, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x0e, 0xf0, 0xd4, 0xac, 0x3c, 0x02, 0x00, 0x00,
+	// 726 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6f, 0xe3, 0x36,
+	0x10, 0xb5, 0x1a, 0x37, 0x8d, 0xe9, 0x8f, 0x34, 0x4c, 0x9a, 0xb2, 0x2e, 0x6a, 0x3b, 0x45, 0x90,
+	0xfa, 0xd0, 0x4a, 0x45, 0x8a, 0x5e, 0xda, 0x53, 0x5c, 0xa3, 0x40, 0x0a, 0x04, 0x35, 0x94, 0x02,
+	0xc5, 0xee, 0x45, 0xa0, 0xc4, 0xb1, 0x24, 0x44, 0x12, 0x05, 0x52, 0x4a, 0x6c, 0xec, 0x9f, 0xd8,
+	0x63, 0x8e, 0x0b, 0xec, 0x9f, 0xc9, 0x31, 0xc7, 0xc5, 0x1e, 0xb2, 0x8b, 0xe4, 0x8f, 0x2c, 0x48,
+	0x49, 0xfe, 0xc0, 0xe6, 0x92, 0x5c, 0x6c, 0xce, 0xcc, 0x9b, 0x37, 0xc3, 0xc7, 0x07, 0x21, 0xe2,
+	0xd3, 0x88, 0xce, 0xe6, 0x56, 0x1c, 0x26, 0x99, 0x95, 0x52, 0x41, 0x63, 0x69, 0xa6, 0x82, 0x67,
+	0x1c, 0x37, 0x8b, 0x8a, 0xa9, 0x2a, 0xdd, 0x3d, 0x9f, 0xfb, 0x5c, 0xe7, 0x2d, 0x75, 0x2a, 0x20,
+	0xdd, 0xfd, 0xd5, 0x66, 0xf5, 0x53, 0xe6, 0x7b, 0x3e, 0xe7, 0x7e, 0x04, 0x96, 0x8e, 0xdc, 0x7c,
+	0x6a, 0xb1, 0x5c, 0xd0, 0x2c, 0xe4, 0x49, 0x51, 0xff, 0xf1, 0x2d, 0x42, 0x9b, 0x13, 0x3d, 0x0b,
+	0xff, 0x80, 0x90, 0x6a, 0x74, 0x18, 0x24, 0x3c, 0x26, 0xc6, 0xc0, 0x18, 0x36, 0xec, 0x86, 0xca,
+	0x8c, 0x55, 0x02, 0xff, 0x84, 0xb6, 0xb3, 0x40, 0x80, 0x0c, 0x78, 0xc4, 0x9c, 0x34, 0xa0, 0x12,
+	0xc8, 0x17, 0x03, 0x63, 0x58, 0xb7, 0x3b, 0x8b, 0xf4, 0x44, 0x65, 0xf1, 0xaf, 0x68, 0x4f, 0x66,
+	0x3c, 0x75, 0xc2, 0x64, 0x1a, 0xe9, 0x51, 0x25, 0x7a, 0x43, 0xa3, 0xb1, 0xaa, 0x9d, 0x56, 0xa5,
+	0xa2, 0x03, 0x10, 0x61, 0xa1, 0xcc, 0x44, 0xe8, 0xe6, 0x05, 0x5e, 0xf0, 0x94, 0x0b, 0x75, 0x94,
+	0xa4, 0x3e, 0x30, 0x86, 0xcd, 0xe3, 0x43, 0x73, 0x45, 0x02, 0x73, 0xbc, 0x02, 0x9e, 0x2c, 0xb1,
+	0xa3, 0xfa, 0xcd, 0x5d, 0xbf, 0x66, 0x7f, 0xcb, 0x1e, 0x2f, 0xe3, 0x57, 0xe8, 0xf0, 0x0a, 0x42,
+	0x3f, 0xc8, 0x80, 0x39, 0x0c, 0x2e, 0x21, 0xe2, 0x29, 0x08, 0x47, 0xc0, 0x15, 0x15, 0x4c, 0x3a,
+	0x02, 0x3c, 0x08, 0x2f, 0x41, 0x48, 0xf2, 0xe5, 0x60, 0xe3, 0xf3, 0x91, 0x70, 0xa9, 0xe1, 0xff,
+	0x97, 0x04, 0x27, 0x8c, 0x09, 0x90, 0xd5, 0xc8, 0x83, 0x8a, 0x77, 0x5c, 0xd1, 0xda, 0x05, 0xab,
+	0x5d, 0x91, 0xe2, 0x23, 0xb4, 0xed, 0x46, 0xdc, 0xbb, 0x90, 0x8e, 0x1a, 0x3a, 0x07, 0x2a, 0xc8,
+	0xa6, 0x16, 0xa4, 0x5d, 0xa4, 0x27, 0x20, 0x5e, 0x00, 0x15, 0x78, 0x82, 0xf0, 0x52, 0x38, 0xe9,
+	0x05, 0xc0, 0xf2, 0x08, 0xc8, 0x57, 0x7a, 0xa5, 0xef, 0xd7, 0x56, 0x5a, 0x17, 0xb1, 0xdc, 0x64,
+	0x67, 0xd1, 0x7c, 0x5e, 0xf6, 0xe2, 0xdf, 0x11, 0xd2, 0x0f, 0xe0, 0xc4, 0x9c, 0x01, 0xd9, 0x1a,
+	0x18, 0xc3, 0xce, 0xf1, 0xfe, 0x1a, 0x93, 0x26, 0x38, 0xe3, 0x0c, 0xec, 0x46, 0x5a, 0x1d, 0xf1,
+	0x3f, 0xa8, 0x53, 0xb4, 0x55, 0x8e, 0x21, 0x0d, 0xfd, 0x14, 0xdf, 0x99, 0x85, 0xa5, 0xcc, 0xca,
+	0x52, 0xe6, 0xb8, 0x04, 0x8c, 0xb6, 0xd4, 0x0a, 0xd7, 0x1f, 0xfa, 0x86, 0xdd, 0xd6, 0xad, 0x55,
+	0x01, 0x9f, 0x21, 0x14, 0xd3, 0x99, 0x23, 0xf3, 0x34, 0x8d, 0xe6, 0x04, 0x29, 0x6b, 0x8d, 0x4c,
+	0x05, 0x7e, 0x7f, 0xd7, 0x3f, 0xf2, 0xc3, 0x2c, 0xc8, 0x5d, 0xd3, 0xe3, 0xb1, 0xe5, 0x71, 0x19,
+	0x73, 0x59, 0xfe, 0xfd, 0x22, 0xd9, 0x85, 0x95, 0xcd, 0x53, 0x90, 0xe6, 0x69, 0x92, 0xd9, 0x8d,
+	0x98, 0xce, 0xce, 0x35, 0x01, 0x3e, 0x41, 0x9d, 0xa5, 0x46, 0xfa, 0x56, 0x4d, 0x7d, 0xab, 0xee,
+	0xe3, 0xfa, 0xe8, 0x9b, 0xb5, 0xc3, 0xd5, 0x10, 0xff, 0x8b, 0x9a, 0x3e, 0xa7, 0x91, 0xe3, 0xf2,
+	0x84, 0x01, 0x23, 0xad, 0x27, 0xaf, 0x34, 0x06, 0xcf, 0x46, 0x8a, 0x62, 0xa4, 0x19, 0xb0, 0x8b,
+	0xbe, 0x59, 0xee, 0x24, 0x68, 0x06, 0x8e, 0x17, 0xd0, 0xc4, 0x07, 0xd2, 0x7e, 0x16, 0xf5, 0xee,
+	0x82, 0xcc, 0xa6, 0x19, 0xfc, 0xa5, 0xa9, 0xf0, 0x39, 0x6a, 0xaf, 0xdc, 0x3b, 0x4c, 0x48, 0xe7,
+	0x59, 0xdc, 0xad, 0xa5, 0x14, 0x61, 0x82, 0x0f, 0x50, 0x0b, 0x52, 0xee, 0x05, 0x4e, 0xe1, 0x43,
+	0xb2, 0xad, 0x5d, 0xd9, 0xd4, 0xb9, 0x91, 0x4e, 0x29, 0x2b, 0x14, 0x90, 0x85, 0x15, 0xbe, 0x7e,
+	0x82, 0x15, 0x74, 0xeb, 0xc2, 0x0a, 0x5d, 0xb4, 0xe5, 0xe7, 0x54, 0xb0, 0x90, 0x26, 0x64, 0x47,
+	0x7f, 0x63, 0x16, 0x31, 0xfe, 0x13, 0xb5, 0x52, 0x9a, 0x4b, 0x70, 0x52, 0x1e, 0x85, 0xde, 0x9c,
+	0x60, 0xfd, 0xaa, 0x64, 0xdd, 0xab, 0x0a, 0x30, 0xd1, 0x75, 0xbb, 0x99, 0x2e, 0x03, 0xfc, 0x1f,
+	0xea, 0x4c, 0x01, 0x1c, 0x37, 0x17, 0x5a, 0xff, 0x90, 0x93, 0xdd, 0xe7, 0xa9, 0x33, 0x05, 0x18,
+	0xe5, 0x42, 0xe9, 0x1e, 0xf2, 0x3f, 0xea, 0xd7, 0x6f, 0xfa, 0xb5, 0xd1, 0xdf, 0x37, 0xf7, 0x3d,
+	0xe3, 0xf6, 0xbe, 0x67, 0x7c, 0xbc, 0xef, 0x19, 0xaf, 0x1f, 0x7a, 0xb5, 0xdb, 0x87, 0x5e, 0xed,
+	0xdd, 0x43, 0xaf, 0xf6, 0xf2, 0xe7, 0x15, 0xd6, 0x62, 0xcd, 0x04, 0xb2, 0x2b, 0x2e, 0x2e, 0xca,
+	0xc8, 0x9a, 0x15, 0x9f, 0x64, 0xcd, 0xef, 0x6e, 0x6a, 0xa1, 0x7e, 0xfb, 0x34, 0x00, 0x17, 0xd9,
+	0xfa, 0xde, 0xeb, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -162,6 +278,121 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.FeeBurnRatio.Size()
+		i -= size
+		if _, err := m.FeeBurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if m.PausePolicy != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PausePolicy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.EpochDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.EpochDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.EpochBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EpochBlocks))
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size := m.InflationMin.Size()
+		i -= size
+		if _, err := m.InflationMin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.InflationRateChange.Size()
+		i -= size
+		if _, err := m.InflationRateChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.GoalBonded.Size()
+		i -= size
+		if _, err := m.GoalBonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.InflationMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.InflationMode))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.PhaseDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.PhaseDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x4a
+	if m.PhaseMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PhaseMode))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.InflationSchedule) > 0 {
+		for iNdEx := len(m.InflationSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InflationSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -249,6 +480,42 @@ func (m *Params) Size() (n int) {
 	if m.BlocksPerYear != 0 {
 		n += 1 + sovParams(uint64(m.BlocksPerYear))
 	}
+	if len(m.InflationSchedule) > 0 {
+		for _, e := range m.InflationSchedule {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.PhaseMode != 0 {
+		n += 1 + sovParams(uint64(m.PhaseMode))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.PhaseDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.InflationMode != 0 {
+		n += 1 + sovParams(uint64(m.InflationMode))
+	}
+	l = m.GoalBonded.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.InflationRateChange.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.InflationMin.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.EpochBlocks != 0 {
+		n += 1 + sovParams(uint64(m.EpochBlocks))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.EpochDuration)
+	n += 2 + l + sovParams(uint64(l))
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if m.PausePolicy != 0 {
+		n += 2 + sovParams(uint64(m.PausePolicy))
+	}
+	l = m.FeeBurnRatio.Size()
+	n += 2 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -443,6 +710,384 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationSchedule = append(m.InflationSchedule, InflationPhase{})
+			if err := m.InflationSchedule[len(m.InflationSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhaseMode", wireType)
+			}
+			m.PhaseMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PhaseMode |= PhaseMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhaseDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.PhaseDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMode", wireType)
+			}
+			m.InflationMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflationMode |= InflationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBlocks", wireType)
+			}
+			m.EpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.EpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausePolicy", wireType)
+			}
+			m.PausePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PausePolicy |= PausePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,169 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateInflationSchedule(t *testing.T) {
+	phase := func(p uint64, inflation sdk.Dec) InflationPhase {
+		return InflationPhase{Phase: p, Inflation: inflation, ProvisionCap: sdk.ZeroInt()}
+	}
+	rate := sdk.NewDecWithPrec(10, 2)
+
+	tests := []struct {
+		name      string
+		schedule  []InflationPhase
+		expectErr bool
+	}{
+		{"empty schedule", []InflationPhase{}, false},
+		{"valid schedule", []InflationPhase{phase(1, rate), phase(2, rate), phase(3, sdk.ZeroDec())}, false},
+		{"not starting at phase 1", []InflationPhase{phase(2, rate)}, true},
+		{"gap between phases", []InflationPhase{phase(1, rate), phase(3, rate)}, true},
+		{"unordered phases", []InflationPhase{phase(1, rate), phase(2, rate), phase(2, rate)}, true},
+		{"zero rate before the last phase", []InflationPhase{phase(1, rate), phase(2, sdk.ZeroDec()), phase(3, rate)}, true},
+		{"negative rate", []InflationPhase{phase(1, rate), phase(2, sdk.NewDecWithPrec(-1, 2))}, true},
+		{"negative provision cap", []InflationPhase{{Phase: 1, Inflation: rate, ProvisionCap: sdk.NewInt(-1)}}, true},
+		{"reaching stop inflation phase", []InflationPhase{
+			phase(1, rate), phase(2, rate), phase(3, rate), phase(4, rate), phase(5, rate), phase(6, rate), phase(7, rate),
+			phase(8, rate), phase(9, rate), phase(10, rate), phase(11, rate), phase(12, rate), phase(13, rate),
+		}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.InflationSchedule = tc.schedule
+			err := params.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidatePhaseMode(t *testing.T) {
+	params := DefaultParams()
+	params.PhaseMode = PhaseByTime
+	require.NoError(t, params.Validate())
+
+	params.PhaseMode = PhaseMode(2)
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.PhaseDuration = 0
+	require.Error(t, params.Validate())
+}
+
+func TestValidateFeeBurnRatio(t *testing.T) {
+	tests := []struct {
+		name      string
+		ratio     sdk.Dec
+		expectErr bool
+	}{
+		{"no burn", sdk.ZeroDec(), false},
+		{"partial burn", sdk.NewDecWithPrec(3, 1), false},
+		{"full burn", sdk.OneDec(), false},
+		{"negative ratio", sdk.NewDecWithPrec(-1, 1), true},
+		{"above one", sdk.NewDecWithPrec(11, 1), true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.FeeBurnRatio = tc.ratio
+			err := params.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCapProvision(t *testing.T) {
+	params := DefaultParams()
+	provision := sdk.NewCoin(params.MintDenom, sdk.NewInt(100))
+
+	// no cap
+	capped, reached := params.CapProvision(provision, sdk.NewInt(1_000))
+	require.False(t, reached)
+	require.Equal(t, provision, capped)
+
+	params.MaxSupply = sdk.NewInt(1_050)
+	require.NoError(t, params.Validate())
+
+	capped, reached = params.CapProvision(provision, sdk.NewInt(900))
+	require.False(t, reached)
+	require.Equal(t, provision, capped)
+
+	capped, reached = params.CapProvision(provision, sdk.NewInt(1_000))
+	require.True(t, reached)
+	require.Equal(t, sdk.NewInt(50), capped.Amount)
+
+	capped, reached = params.CapProvision(provision, sdk.NewInt(1_100))
+	require.True(t, reached)
+	require.True(t, capped.IsZero())
+
+	params.MaxSupply = sdk.NewInt(-1)
+	require.Error(t, params.Validate())
+}
+
+func TestValidateInflationMode(t *testing.T) {
+	tests := []struct {
+		name      string
+		malleate  func(params *Params)
+		expectErr bool
+	}{
+		{"bonded ratio mode", func(params *Params) { params.InflationMode = InflationBondedRatio }, false},
+		{"unknown mode", func(params *Params) { params.InflationMode = InflationMode(2) }, true},
+		{"zero goal bonded", func(params *Params) { params.GoalBonded = sdk.ZeroDec() }, true},
+		{"goal bonded above one", func(params *Params) { params.GoalBonded = sdk.NewDecWithPrec(11, 1) }, true},
+		{"negative rate change", func(params *Params) { params.InflationRateChange = sdk.NewDecWithPrec(-1, 2) }, true},
+		{"zero rate change", func(params *Params) { params.InflationRateChange = sdk.ZeroDec() }, false},
+		{"zero min inflation", func(params *Params) { params.InflationMin = sdk.ZeroDec() }, true},
+		{"min inflation above one", func(params *Params) { params.InflationMin = sdk.NewDecWithPrec(11, 1) }, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			tc.malleate(&params)
+			err := params.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIsEpochEnd(t *testing.T) {
+	startTime := time.Unix(1_000_000, 0).UTC()
+	epoch := NewEpoch(1, 1, startTime)
+
+	params := DefaultParams()
+	require.False(t, params.HasEpochs())
+	require.True(t, params.IsEpochEnd(epoch.Accumulate(sdk.OneInt()), startTime))
+
+	params.EpochBlocks = 3
+	require.True(t, params.HasEpochs())
+	require.False(t, params.IsEpochEnd(epoch.Accumulate(sdk.OneInt()).Accumulate(sdk.OneInt()), startTime))
+	require.True(t, params.IsEpochEnd(epoch.Accumulate(sdk.OneInt()).Accumulate(sdk.OneInt()).Accumulate(sdk.OneInt()), startTime))
+
+	// epoch duration takes precedence over epoch blocks
+	params.EpochDuration = time.Hour
+	require.False(t, params.IsEpochEnd(epoch.Accumulate(sdk.OneInt()).Accumulate(sdk.OneInt()).Accumulate(sdk.OneInt()), startTime))
+	require.True(t, params.IsEpochEnd(epoch.Accumulate(sdk.OneInt()), startTime.Add(time.Hour)))
+
+	params.EpochDuration = -time.Hour
+	require.Error(t, params.Validate())
+}
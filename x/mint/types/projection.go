@@ -0,0 +1,123 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProjectEmissions projects the emissions of the current and every remaining
+// phase until StopInflationPhase or the max supply is reached, starting from the given minter state and
+// total supply. It runs the same math BeginBlocker applies at each phase
+// change, so the projection matches the chain as long as params don't change.
+// In the bonded ratio inflation mode, future phases are projected at their
+// phase inflation rate, the ceiling of the dynamic rate. Fees are projected to
+// be burned at the given amount per block, which the net inflation and the
+// total supply account for.
+func (m Minter) ProjectEmissions(params Params, totalSupply sdk.Int, blockHeight int64, blockTime time.Time, burnPerBlock sdk.Dec) []PhaseProjection {
+	projections := []PhaseProjection{}
+	if m.Inflation.IsZero() {
+		return projections
+	}
+
+	minter := m
+	supply := totalSupply
+
+	start := m.Phase
+	if start == 0 {
+		start = 1
+	}
+
+	for phase := start; phase < params.StopInflationPhase; phase++ {
+		// the current phase keeps the provisions already applied by the chain
+		current := phase == m.Phase
+		if !current {
+			minter.Phase = phase
+			minter.Inflation = minter.PhaseInflationRate(phase, params)
+			minter.AnnualProvisions = minter.NextAnnualProvisions(params, supply)
+		}
+
+		// inflation end
+		if minter.Inflation.IsZero() {
+			break
+		}
+
+		blockProvision := minter.BlockProvision(params)
+		// the truncated fraction is carried into the next phase
+		var amount sdk.Int
+		amount, minter.ProvisionRemainder = minter.CarryProvision(minter.phaseEmission(params, current, blockHeight, blockTime))
+		emission, maxSupplyReached := params.CapProvision(sdk.NewCoin(params.MintDenom, amount), supply)
+		burned := sdk.MinInt(burnPerBlock.Mul(params.phaseBlocks(minter, current, blockHeight, blockTime)).TruncateInt(), supply)
+		netInflation := minter.Inflation
+		if supply.IsPositive() {
+			netInflation = netInflation.Sub(burnPerBlock.MulInt64(int64(params.BlocksPerYear)).QuoInt(supply))
+		}
+		supply = supply.Add(emission.Amount).Sub(burned)
+
+		projections = append(projections, PhaseProjection{
+			Phase:            phase,
+			Inflation:        minter.Inflation,
+			AnnualProvisions: minter.AnnualProvisions,
+			BlockProvision:   blockProvision,
+			EndTotalSupply:   supply,
+			Burned:           burned,
+			NetInflation:     netInflation,
+		})
+
+		// inflation ends once the max supply is reached
+		if maxSupplyReached {
+			break
+		}
+	}
+
+	return projections
+}
+
+// phaseEmission returns the provisions from the given height or time until
+// the end of the minter's phase, before truncation. Future phases are emitted
+// in full.
+func (m Minter) phaseEmission(params Params, current bool, blockHeight int64, blockTime time.Time) sdk.Dec {
+	if params.PhaseMode == PhaseByTime {
+		remaining := params.PhaseDuration
+		if current {
+			remaining = m.PhaseStartTime.Add(params.PhaseDuration).Sub(blockTime)
+		}
+		if remaining <= 0 {
+			return sdk.ZeroDec()
+		}
+		return m.AnnualProvisions.MulInt64(int64(remaining)).QuoInt64(int64(params.PhaseDuration))
+	}
+
+	remainingBlocks := int64(params.BlocksPerYear)
+	if current {
+		remainingBlocks = int64(m.Phase*params.BlocksPerYear) - blockHeight
+	}
+	if remainingBlocks <= 0 {
+		return sdk.ZeroDec()
+	}
+	return m.ExactBlockProvision(params).MulInt64(remainingBlocks)
+}
+
+// phaseBlocks returns the number of blocks from the given height or time until
+// the end of the minter's phase. Phases by time are estimated to last
+// BlocksPerYear blocks.
+func (p Params) phaseBlocks(m Minter, current bool, blockHeight int64, blockTime time.Time) sdk.Dec {
+	blocks := sdk.NewDec(int64(p.BlocksPerYear))
+	if !current {
+		return blocks
+	}
+
+	if p.PhaseMode == PhaseByTime {
+		remaining := m.PhaseStartTime.Add(p.PhaseDuration).Sub(blockTime)
+		if remaining <= 0 {
+			return sdk.ZeroDec()
+		}
+		return blocks.MulInt64(int64(remaining)).QuoInt64(int64(p.PhaseDuration))
+	}
+
+	remainingBlocks := int64(m.Phase*p.BlocksPerYear) - blockHeight
+	if remainingBlocks <= 0 {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDec(remainingBlocks)
+}
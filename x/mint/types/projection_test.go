@@ -0,0 +1,97 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// simulate runs the BeginBlocker phase math block by block and returns the
+// total supply at the end of each phase.
+func simulate(minter Minter, params Params, supply sdk.Int, fromHeight int64) map[uint64]sdk.Int {
+	endSupply := map[uint64]sdk.Int{}
+	for height := fromHeight; height <= int64(params.BlocksPerYear*params.StopInflationPhase); height++ {
+		if minter.Inflation.IsZero() {
+			break
+		}
+		currentPhase := uint64(minter.CurrentPhase(params, height))
+		if minter.Phase != currentPhase {
+			minter.Phase = currentPhase
+			minter.Inflation = minter.PhaseInflationRate(currentPhase, params)
+			minter.AnnualProvisions = minter.NextAnnualProvisions(params, supply)
+		}
+		if minter.Inflation.IsZero() {
+			break
+		}
+		var amount sdk.Int
+		amount, minter.ProvisionRemainder = minter.CarryProvision(minter.ExactBlockProvision(params))
+		supply = supply.Add(amount)
+		endSupply[minter.Phase] = supply
+	}
+	return endSupply
+}
+
+func TestProjectEmissions(t *testing.T) {
+	params := DefaultParams()
+	params.BlocksPerYear = 100
+	genesisSupply := sdk.NewInt(1_000_000_000)
+
+	// from genesis
+	minter := DefaultInitialMinter()
+	projections := minter.ProjectEmissions(params, genesisSupply, 0, time.Time{}, sdk.ZeroDec())
+	expected := simulate(minter, params, genesisSupply, 1)
+
+	require.Len(t, projections, int(params.StopInflationPhase)-1)
+	for _, projection := range projections {
+		require.Equal(t, expected[projection.Phase], projection.EndTotalSupply, "phase %d", projection.Phase)
+	}
+
+	// from the middle of the third phase
+	minter.Phase = 3
+	minter.Inflation = minter.PhaseInflationRate(3, params)
+	minter.AnnualProvisions = minter.NextAnnualProvisions(params, genesisSupply)
+	height := int64(params.BlocksPerYear*2 + 40)
+
+	projections = minter.ProjectEmissions(params, genesisSupply, height, time.Time{}, sdk.ZeroDec())
+	expected = simulate(minter, params, genesisSupply, height+1)
+
+	require.Equal(t, uint64(3), projections[0].Phase)
+	require.Len(t, projections, int(params.StopInflationPhase)-3)
+	for _, projection := range projections {
+		require.Equal(t, expected[projection.Phase], projection.EndTotalSupply, "phase %d", projection.Phase)
+	}
+
+	// inflation end
+	minter.Inflation = sdk.ZeroDec()
+	require.Empty(t, minter.ProjectEmissions(params, genesisSupply, height, time.Time{}, sdk.ZeroDec()))
+}
+
+func TestProjectEmissionsFeeBurn(t *testing.T) {
+	params := DefaultParams()
+	params.BlocksPerYear = 100
+	genesisSupply := sdk.NewInt(1_000_000_000)
+	minter := DefaultInitialMinter()
+	gross := minter.ProjectEmissions(params, genesisSupply, 0, time.Time{}, sdk.ZeroDec())
+
+	// burning 1% of the genesis supply a year
+	burnPerBlock := sdk.NewDec(100_000)
+	projections := minter.ProjectEmissions(params, genesisSupply, 0, time.Time{}, burnPerBlock)
+	require.Len(t, projections, len(gross))
+
+	first := projections[0]
+	require.Equal(t, sdk.NewInt(10_000_000), first.Burned)
+	require.Equal(t, first.Inflation.Sub(sdk.NewDecWithPrec(1, 2)), first.NetInflation)
+	require.Equal(t, gross[0].EndTotalSupply.Sub(first.Burned), first.EndTotalSupply)
+	for i, projection := range projections {
+		require.True(t, projection.NetInflation.LT(projection.Inflation), "phase %d", projection.Phase)
+		require.True(t, projection.EndTotalSupply.LT(gross[i].EndTotalSupply), "phase %d", projection.Phase)
+	}
+
+	// burning more than minted deflates the supply
+	projections = minter.ProjectEmissions(params, genesisSupply, 0, time.Time{}, sdk.NewDec(10_000_000))
+	require.True(t, projections[0].NetInflation.IsNegative())
+	require.True(t, projections[0].EndTotalSupply.LT(genesisSupply))
+}
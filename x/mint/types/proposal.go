@@ -0,0 +1,291 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeEcosystemPoolSpend defines the type for an EcosystemPoolSpendProposal
+	ProposalTypeEcosystemPoolSpend = "EcosystemPoolSpend"
+	// ProposalTypeUpdateMintParams defines the type for an UpdateMintParamsProposal
+	ProposalTypeUpdateMintParams = "UpdateMintParams"
+	// ProposalTypeClawbackDeveloperRewards defines the type for a ClawbackDeveloperRewardsProposal
+	ProposalTypeClawbackDeveloperRewards = "ClawbackDeveloperRewards"
+	// ProposalTypeAddDeveloperReceiver defines the type for an AddDeveloperReceiverProposal
+	ProposalTypeAddDeveloperReceiver = "AddDeveloperReceiver"
+	// ProposalTypeRemoveDeveloperReceiver defines the type for a RemoveDeveloperReceiverProposal
+	ProposalTypeRemoveDeveloperReceiver = "RemoveDeveloperReceiver"
+	// ProposalTypeReplaceDeveloperReceiver defines the type for a ReplaceDeveloperReceiverProposal
+	ProposalTypeReplaceDeveloperReceiver = "ReplaceDeveloperReceiver"
+	// ProposalTypeReweightDeveloperReceiver defines the type for a ReweightDeveloperReceiverProposal
+	ProposalTypeReweightDeveloperReceiver = "ReweightDeveloperReceiver"
+	// ProposalTypeSetMintPaused defines the type for a SetMintPausedProposal
+	ProposalTypeSetMintPaused = "SetMintPaused"
+)
+
+// Assert the proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &EcosystemPoolSpendProposal{}
+	_ govtypes.Content = &UpdateMintParamsProposal{}
+	_ govtypes.Content = &ClawbackDeveloperRewardsProposal{}
+	_ govtypes.Content = &AddDeveloperReceiverProposal{}
+	_ govtypes.Content = &RemoveDeveloperReceiverProposal{}
+	_ govtypes.Content = &ReplaceDeveloperReceiverProposal{}
+	_ govtypes.Content = &ReweightDeveloperReceiverProposal{}
+	_ govtypes.Content = &SetMintPausedProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeEcosystemPoolSpend)
+	govtypes.RegisterProposalTypeCodec(&EcosystemPoolSpendProposal{}, "galaxy/mint/EcosystemPoolSpendProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdateMintParams)
+	govtypes.RegisterProposalTypeCodec(&UpdateMintParamsProposal{}, "galaxy/mint/UpdateMintParamsProposal")
+	govtypes.RegisterProposalType(ProposalTypeClawbackDeveloperRewards)
+	govtypes.RegisterProposalTypeCodec(&ClawbackDeveloperRewardsProposal{}, "galaxy/mint/ClawbackDeveloperRewardsProposal")
+	govtypes.RegisterProposalType(ProposalTypeAddDeveloperReceiver)
+	govtypes.RegisterProposalTypeCodec(&AddDeveloperReceiverProposal{}, "galaxy/mint/AddDeveloperReceiverProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemoveDeveloperReceiver)
+	govtypes.RegisterProposalTypeCodec(&RemoveDeveloperReceiverProposal{}, "galaxy/mint/RemoveDeveloperReceiverProposal")
+	govtypes.RegisterProposalType(ProposalTypeReplaceDeveloperReceiver)
+	govtypes.RegisterProposalTypeCodec(&ReplaceDeveloperReceiverProposal{}, "galaxy/mint/ReplaceDeveloperReceiverProposal")
+	govtypes.RegisterProposalType(ProposalTypeReweightDeveloperReceiver)
+	govtypes.RegisterProposalTypeCodec(&ReweightDeveloperReceiverProposal{}, "galaxy/mint/ReweightDeveloperReceiverProposal")
+	govtypes.RegisterProposalType(ProposalTypeSetMintPaused)
+	govtypes.RegisterProposalTypeCodec(&SetMintPausedProposal{}, "galaxy/mint/SetMintPausedProposal")
+}
+
+// NewEcosystemPoolSpendProposal creates a new ecosystem pool spend proposal.
+func NewEcosystemPoolSpendProposal(title, description string, recipient sdk.AccAddress, amount sdk.Coins) *EcosystemPoolSpendProposal {
+	return &EcosystemPoolSpendProposal{title, description, recipient.String(), amount}
+}
+
+// GetTitle returns the title of an ecosystem pool spend proposal.
+func (esp *EcosystemPoolSpendProposal) GetTitle() string { return esp.Title }
+
+// GetDescription returns the description of an ecosystem pool spend proposal.
+func (esp *EcosystemPoolSpendProposal) GetDescription() string { return esp.Description }
+
+// ProposalRoute returns the routing key of an ecosystem pool spend proposal.
+func (esp *EcosystemPoolSpendProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an ecosystem pool spend proposal.
+func (esp *EcosystemPoolSpendProposal) ProposalType() string { return ProposalTypeEcosystemPoolSpend }
+
+// ValidateBasic runs basic stateless validity checks
+func (esp *EcosystemPoolSpendProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(esp)
+	if err != nil {
+		return err
+	}
+	if !esp.Amount.IsValid() || esp.Amount.IsZero() {
+		return ErrInvalidProposalAmount
+	}
+	if esp.Recipient == "" {
+		return ErrEmptyProposalRecipient
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (esp EcosystemPoolSpendProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Ecosystem Pool Spend Proposal:
+  Title:       %s
+  Description: %s
+  Recipient:   %s
+  Amount:      %s
+`, esp.Title, esp.Description, esp.Recipient, esp.Amount))
+	return b.String()
+}
+
+// NewUpdateMintParamsProposal creates a new proposal replacing the mint
+// params.
+func NewUpdateMintParamsProposal(title, description string, params Params) *UpdateMintParamsProposal {
+	return &UpdateMintParamsProposal{title, description, params}
+}
+
+func (p *UpdateMintParamsProposal) GetTitle() string { return p.Title }
+
+func (p *UpdateMintParamsProposal) GetDescription() string { return p.Description }
+
+func (p *UpdateMintParamsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateMintParamsProposal) ProposalType() string { return ProposalTypeUpdateMintParams }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *UpdateMintParamsProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return p.Params.Validate()
+}
+
+// NewClawbackDeveloperRewardsProposal creates a new proposal clawing back the
+// locked rewards of a developer rewards receiver.
+func NewClawbackDeveloperRewardsProposal(title, description, receiver string) *ClawbackDeveloperRewardsProposal {
+	return &ClawbackDeveloperRewardsProposal{title, description, receiver}
+}
+
+func (p *ClawbackDeveloperRewardsProposal) GetTitle() string { return p.Title }
+
+func (p *ClawbackDeveloperRewardsProposal) GetDescription() string { return p.Description }
+
+func (p *ClawbackDeveloperRewardsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *ClawbackDeveloperRewardsProposal) ProposalType() string {
+	return ProposalTypeClawbackDeveloperRewards
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *ClawbackDeveloperRewardsProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Receiver); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address: %s", err)
+	}
+	return nil
+}
+
+// NewAddDeveloperReceiverProposal creates a new proposal adding a developer
+// rewards receiver.
+func NewAddDeveloperReceiverProposal(title, description string, receiver DevloperWeightedAddress) *AddDeveloperReceiverProposal {
+	return &AddDeveloperReceiverProposal{title, description, receiver}
+}
+
+func (p *AddDeveloperReceiverProposal) GetTitle() string { return p.Title }
+
+func (p *AddDeveloperReceiverProposal) GetDescription() string { return p.Description }
+
+func (p *AddDeveloperReceiverProposal) ProposalRoute() string { return RouterKey }
+
+func (p *AddDeveloperReceiverProposal) ProposalType() string { return ProposalTypeAddDeveloperReceiver }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *AddDeveloperReceiverProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Receiver.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address: %s", err)
+	}
+	if err := validateReceiverWeight(p.Receiver.Weight); err != nil {
+		return err
+	}
+	return p.Receiver.Vesting.Validate()
+}
+
+// NewRemoveDeveloperReceiverProposal creates a new proposal removing a
+// developer rewards receiver.
+func NewRemoveDeveloperReceiverProposal(title, description, address string, redistribution WeightRedistribution) *RemoveDeveloperReceiverProposal {
+	return &RemoveDeveloperReceiverProposal{title, description, address, redistribution}
+}
+
+func (p *RemoveDeveloperReceiverProposal) GetTitle() string { return p.Title }
+
+func (p *RemoveDeveloperReceiverProposal) GetDescription() string { return p.Description }
+
+func (p *RemoveDeveloperReceiverProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemoveDeveloperReceiverProposal) ProposalType() string {
+	return ProposalTypeRemoveDeveloperReceiver
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *RemoveDeveloperReceiverProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address: %s", err)
+	}
+	if _, ok := WeightRedistribution_name[int32(p.Redistribution)]; !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid weight redistribution: %s", p.Redistribution)
+	}
+	return nil
+}
+
+// NewReplaceDeveloperReceiverProposal creates a new proposal handing the
+// weight and vesting of a developer rewards receiver over to a new address.
+func NewReplaceDeveloperReceiverProposal(title, description, address, newAddress string) *ReplaceDeveloperReceiverProposal {
+	return &ReplaceDeveloperReceiverProposal{title, description, address, newAddress}
+}
+
+func (p *ReplaceDeveloperReceiverProposal) GetTitle() string { return p.Title }
+
+func (p *ReplaceDeveloperReceiverProposal) GetDescription() string { return p.Description }
+
+func (p *ReplaceDeveloperReceiverProposal) ProposalRoute() string { return RouterKey }
+
+func (p *ReplaceDeveloperReceiverProposal) ProposalType() string {
+	return ProposalTypeReplaceDeveloperReceiver
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *ReplaceDeveloperReceiverProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(p.NewAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new receiver address: %s", err)
+	}
+	if p.Address == p.NewAddress {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "new receiver address is the same as the receiver address")
+	}
+	return nil
+}
+
+// NewReweightDeveloperReceiverProposal creates a new proposal changing the
+// weight of a developer rewards receiver.
+func NewReweightDeveloperReceiverProposal(title, description, address string, weight sdk.Dec) *ReweightDeveloperReceiverProposal {
+	return &ReweightDeveloperReceiverProposal{title, description, address, weight}
+}
+
+func (p *ReweightDeveloperReceiverProposal) GetTitle() string { return p.Title }
+
+func (p *ReweightDeveloperReceiverProposal) GetDescription() string { return p.Description }
+
+func (p *ReweightDeveloperReceiverProposal) ProposalRoute() string { return RouterKey }
+
+func (p *ReweightDeveloperReceiverProposal) ProposalType() string {
+	return ProposalTypeReweightDeveloperReceiver
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *ReweightDeveloperReceiverProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address: %s", err)
+	}
+	return validateReceiverWeight(p.Weight)
+}
+
+// NewSetMintPausedProposal creates a new proposal pausing or resuming
+// minting.
+func NewSetMintPausedProposal(title, description string, paused bool) *SetMintPausedProposal {
+	return &SetMintPausedProposal{title, description, paused}
+}
+
+func (p *SetMintPausedProposal) GetTitle() string { return p.Title }
+
+func (p *SetMintPausedProposal) GetDescription() string { return p.Description }
+
+func (p *SetMintPausedProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetMintPausedProposal) ProposalType() string { return ProposalTypeSetMintPaused }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *SetMintPausedProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(p)
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestEcosystemPoolSpendProposalValidateBasic(t *testing.T) {
+	recipient := sdk.AccAddress([]byte("recipient---"))
+	amount := sdk.NewCoins(sdk.NewInt64Coin(DefaultMintDenom, 1_000))
+
+	tests := []struct {
+		name      string
+		proposal  *EcosystemPoolSpendProposal
+		expectErr bool
+	}{
+		{"valid proposal", NewEcosystemPoolSpendProposal("title", "description", recipient, amount), false},
+		{"empty title", NewEcosystemPoolSpendProposal("", "description", recipient, amount), true},
+		{"empty amount", NewEcosystemPoolSpendProposal("title", "description", recipient, sdk.NewCoins()), true},
+		{"invalid amount", NewEcosystemPoolSpendProposal("title", "description", recipient, sdk.Coins{{Denom: DefaultMintDenom, Amount: sdk.NewInt(-1)}}), true},
+		{"empty recipient", &EcosystemPoolSpendProposal{Title: "title", Description: "description", Amount: amount}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -6,10 +6,14 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -17,12 +21,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -190,327 +196,6648 @@ func (m *QueryMinterResponse) GetMinter() Minter {
 	return Minter{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "galaxy.mint.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "galaxy.mint.QueryParamsResponse")
-	proto.RegisterType((*QueryMinterRequest)(nil), "galaxy.mint.QueryMinterRequest")
-	proto.RegisterType((*QueryMinterResponse)(nil), "galaxy.mint.QueryMinterResponse")
+// QueryInflationRequest is the request type for the Query/Inflation RPC method.
+type QueryInflationRequest struct {
 }
 
-func init() { proto.RegisterFile("galaxy/mint/query.proto", fileDescriptor_9213eebd005a4574) }
-
-var fileDescriptor_9213eebd005a4574 = []byte{
-	// 343 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4e, 0xc2, 0x40,
-	0x10, 0x86, 0x5b, 0xa3, 0x1c, 0xca, 0xad, 0xa0, 0x12, 0x34, 0x85, 0x70, 0x32, 0xc6, 0x74, 0x03,
-	0xbe, 0x01, 0x07, 0xe3, 0xc5, 0x44, 0x39, 0x7a, 0xdb, 0x92, 0xcd, 0xb2, 0x91, 0xee, 0x2c, 0xdd,
-	0x45, 0xe1, 0xea, 0x13, 0x98, 0xf8, 0x52, 0x1c, 0x49, 0xbc, 0x98, 0x98, 0x18, 0x03, 0x3e, 0x88,
-	0xe9, 0x4e, 0xab, 0x54, 0xca, 0xa5, 0x69, 0x66, 0xbe, 0xf9, 0x66, 0xfe, 0xd6, 0x3b, 0xe6, 0x74,
-	0x4c, 0x67, 0x73, 0x12, 0x0b, 0x69, 0xc8, 0x64, 0xca, 0x92, 0x79, 0xa8, 0x12, 0x30, 0xe0, 0x57,
-	0xb1, 0x11, 0xa6, 0x8d, 0x66, 0x9d, 0x03, 0x07, 0x5b, 0x27, 0xe9, 0x1b, 0x22, 0xcd, 0x53, 0x0e,
-	0xc0, 0xc7, 0x8c, 0x50, 0x25, 0x08, 0x95, 0x12, 0x0c, 0x35, 0x02, 0xa4, 0xce, 0xba, 0xe7, 0x43,
-	0xd0, 0x31, 0x68, 0x12, 0x51, 0xcd, 0xd0, 0x4c, 0x1e, 0xbb, 0x11, 0x33, 0xb4, 0x4b, 0x14, 0xe5,
-	0x42, 0x5a, 0x38, 0x63, 0x1b, 0x9b, 0x57, 0x28, 0x9a, 0xd0, 0x38, 0xb7, 0x1c, 0x6d, 0x76, 0xd2,
-	0x07, 0xd6, 0x3b, 0x75, 0xcf, 0xbf, 0x4b, 0x9d, 0xb7, 0x16, 0x1e, 0xb0, 0xc9, 0x94, 0x69, 0xd3,
-	0xb9, 0xf6, 0x6a, 0x85, 0xaa, 0x56, 0x20, 0x35, 0xf3, 0xbb, 0x5e, 0x05, 0xa5, 0x0d, 0xb7, 0xed,
-	0x9e, 0x55, 0x7b, 0xb5, 0x70, 0x23, 0x5c, 0x88, 0x70, 0x7f, 0x7f, 0xf1, 0xd9, 0x72, 0x06, 0x19,
-	0xf8, 0xeb, 0xbf, 0x11, 0xd2, 0xb0, 0xe4, 0xbf, 0x3f, 0xaf, 0xfe, 0xf9, 0x63, 0x5b, 0x29, 0xf5,
-	0x23, 0x9c, 0xfb, 0x11, 0xec, 0x7d, 0xb8, 0xde, 0x81, 0x55, 0xf9, 0x23, 0xaf, 0x82, 0x17, 0xf8,
-	0xad, 0xc2, 0xd8, 0x76, 0xbc, 0x66, 0x7b, 0x37, 0x80, 0x97, 0x74, 0x4e, 0x9e, 0xdf, 0xbe, 0x5f,
-	0xf7, 0x0e, 0xfd, 0x1a, 0xd9, 0xfe, 0xa2, 0xe9, 0x26, 0xbc, 0xa5, 0x6c, 0x53, 0x21, 0x68, 0xd9,
-	0xa6, 0x62, 0xe6, 0x1d, 0x9b, 0x30, 0x5d, 0xff, 0x6a, 0xb1, 0x0a, 0xdc, 0xe5, 0x2a, 0x70, 0xbf,
-	0x56, 0x81, 0xfb, 0xb2, 0x0e, 0x9c, 0xe5, 0x3a, 0x70, 0xde, 0xd7, 0x81, 0x73, 0x7f, 0xc1, 0x85,
-	0x19, 0x4d, 0xa3, 0x70, 0x08, 0x71, 0x36, 0x28, 0x99, 0x79, 0x82, 0xe4, 0x21, 0xd7, 0xcc, 0x50,
-	0x64, 0xe6, 0x8a, 0xe9, 0xa8, 0x62, 0x7f, 0xf6, 0xe5, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x35,
-	0x5a, 0x99, 0x8a, 0xa6, 0x02, 0x00, 0x00,
+func (m *QueryInflationRequest) Reset()         { *m = QueryInflationRequest{} }
+func (m *QueryInflationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationRequest) ProtoMessage()    {}
+func (*QueryInflationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{4}
+}
+func (m *QueryInflationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationRequest.Merge(m, src)
+}
+func (m *QueryInflationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryInflationRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	Minter(ctx context.Context, in *QueryMinterRequest, opts ...grpc.CallOption) (*QueryMinterResponse, error)
+// QueryInflationResponse is the response type for the Query/Inflation RPC
+// method, in the same shape as the SDK mint module.
+type QueryInflationResponse struct {
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryInflationResponse) Reset()         { *m = QueryInflationResponse{} }
+func (m *QueryInflationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationResponse) ProtoMessage()    {}
+func (*QueryInflationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{5}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryInflationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/galaxy.mint.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryInflationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) Minter(ctx context.Context, in *QueryMinterRequest, opts ...grpc.CallOption) (*QueryMinterResponse, error) {
-	out := new(QueryMinterResponse)
-	err := c.cc.Invoke(ctx, "/galaxy.mint.Query/Minter", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryInflationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationResponse.Merge(m, src)
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	Minter(context.Context, *QueryMinterRequest) (*QueryMinterResponse, error)
+func (m *QueryInflationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationResponse.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryInflationResponse proto.InternalMessageInfo
+
+// QueryAnnualProvisionsRequest is the request type for the
+// Query/AnnualProvisions RPC method.
+type QueryAnnualProvisionsRequest struct {
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAnnualProvisionsRequest) Reset()         { *m = QueryAnnualProvisionsRequest{} }
+func (m *QueryAnnualProvisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAnnualProvisionsRequest) ProtoMessage()    {}
+func (*QueryAnnualProvisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{6}
 }
-func (*UnimplementedQueryServer) Minter(ctx context.Context, req *QueryMinterRequest) (*QueryMinterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Minter not implemented")
+func (m *QueryAnnualProvisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAnnualProvisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAnnualProvisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAnnualProvisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnnualProvisionsRequest.Merge(m, src)
+}
+func (m *QueryAnnualProvisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAnnualProvisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnnualProvisionsRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryAnnualProvisionsRequest proto.InternalMessageInfo
+
+// QueryAnnualProvisionsResponse is the response type for the
+// Query/AnnualProvisions RPC method, in the same shape as the SDK mint module.
+type QueryAnnualProvisionsResponse struct {
+	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/galaxy.mint.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryAnnualProvisionsResponse) Reset()         { *m = QueryAnnualProvisionsResponse{} }
+func (m *QueryAnnualProvisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAnnualProvisionsResponse) ProtoMessage()    {}
+func (*QueryAnnualProvisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{7}
+}
+func (m *QueryAnnualProvisionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAnnualProvisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAnnualProvisionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAnnualProvisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnnualProvisionsResponse.Merge(m, src)
+}
+func (m *QueryAnnualProvisionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnnualProvisionsResponse.DiscardUnknown(m)
 }
 
-func _Query_Minter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMinterRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Minter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/galaxy.mint.Query/Minter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Minter(ctx, req.(*QueryMinterRequest))
+var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
+
+type QueryPhaseRequest struct {
+}
+
+func (m *QueryPhaseRequest) Reset()         { *m = QueryPhaseRequest{} }
+func (m *QueryPhaseRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPhaseRequest) ProtoMessage()    {}
+func (*QueryPhaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{8}
+}
+func (m *QueryPhaseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPhaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPhaseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPhaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPhaseRequest.Merge(m, src)
+}
+func (m *QueryPhaseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPhaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPhaseRequest.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "galaxy.mint.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Minter",
-			Handler:    _Query_Minter_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "galaxy/mint/query.proto",
+var xxx_messageInfo_QueryPhaseRequest proto.InternalMessageInfo
+
+type QueryPhaseResponse struct {
+	Phase uint64 `protobuf:"varint,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	// height of the first block of the phase, zero when phases advance by block time
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// height of the last block of the phase, zero when phases advance by block time
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// block time the phase started at
+	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// block time the phase ends at, unset when phases advance by block height
+	EndTime *time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPhaseResponse) Reset()         { *m = QueryPhaseResponse{} }
+func (m *QueryPhaseResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPhaseResponse) ProtoMessage()    {}
+func (*QueryPhaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{9}
+}
+func (m *QueryPhaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPhaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPhaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPhaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPhaseResponse.Merge(m, src)
+}
+func (m *QueryPhaseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPhaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPhaseResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryPhaseResponse proto.InternalMessageInfo
+
+func (m *QueryPhaseResponse) GetPhase() uint64 {
+	if m != nil {
+		return m.Phase
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPhaseResponse) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPhaseResponse) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryPhaseResponse) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *QueryMinterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPhaseResponse) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMinterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryBlockProvisionRequest struct {
 }
 
-func (m *QueryMinterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryBlockProvisionRequest) Reset()         { *m = QueryBlockProvisionRequest{} }
+func (m *QueryBlockProvisionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockProvisionRequest) ProtoMessage()    {}
+func (*QueryBlockProvisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{10}
+}
+func (m *QueryBlockProvisionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockProvisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockProvisionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockProvisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockProvisionRequest.Merge(m, src)
+}
+func (m *QueryBlockProvisionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockProvisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockProvisionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryMinterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBlockProvisionRequest proto.InternalMessageInfo
+
+type QueryBlockProvisionResponse struct {
+	// coins minted for the next block
+	BlockProvision types1.Coin `protobuf:"bytes,1,opt,name=block_provision,json=blockProvision,proto3" json:"block_provision"`
+	// share of each destination of the block provision
+	Distribution DistributionRecord `protobuf:"bytes,2,opt,name=distribution,proto3" json:"distribution"`
+}
+
+func (m *QueryBlockProvisionResponse) Reset()         { *m = QueryBlockProvisionResponse{} }
+func (m *QueryBlockProvisionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockProvisionResponse) ProtoMessage()    {}
+func (*QueryBlockProvisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{11}
+}
+func (m *QueryBlockProvisionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockProvisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockProvisionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryBlockProvisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockProvisionResponse.Merge(m, src)
+}
+func (m *QueryBlockProvisionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockProvisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockProvisionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMinterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryBlockProvisionResponse proto.InternalMessageInfo
+
+func (m *QueryBlockProvisionResponse) GetBlockProvision() types1.Coin {
+	if m != nil {
+		return m.BlockProvision
+	}
+	return types1.Coin{}
 }
 
-func (m *QueryMinterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Minter.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryBlockProvisionResponse) GetDistribution() DistributionRecord {
+	if m != nil {
+		return m.Distribution
+	}
+	return DistributionRecord{}
+}
+
+type QueryBlocksRemainingRequest struct {
+}
+
+func (m *QueryBlocksRemainingRequest) Reset()         { *m = QueryBlocksRemainingRequest{} }
+func (m *QueryBlocksRemainingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlocksRemainingRequest) ProtoMessage()    {}
+func (*QueryBlocksRemainingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{12}
+}
+func (m *QueryBlocksRemainingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlocksRemainingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlocksRemainingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryBlocksRemainingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlocksRemainingRequest.Merge(m, src)
+}
+func (m *QueryBlocksRemainingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlocksRemainingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlocksRemainingRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryBlocksRemainingRequest proto.InternalMessageInfo
+
+type QueryBlocksRemainingResponse struct {
+	// blocks remaining until the next phase, zero when phases advance by block time
+	BlocksRemaining int64 `protobuf:"varint,1,opt,name=blocks_remaining,json=blocksRemaining,proto3" json:"blocks_remaining,omitempty"`
+	// block time remaining until the next phase, unset when phases advance by
+	// block height
+	TimeRemaining *time.Duration `protobuf:"bytes,2,opt,name=time_remaining,json=timeRemaining,proto3,stdduration" json:"time_remaining,omitempty"`
+}
+
+func (m *QueryBlocksRemainingResponse) Reset()         { *m = QueryBlocksRemainingResponse{} }
+func (m *QueryBlocksRemainingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlocksRemainingResponse) ProtoMessage()    {}
+func (*QueryBlocksRemainingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{13}
+}
+func (m *QueryBlocksRemainingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlocksRemainingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlocksRemainingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBlocksRemainingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlocksRemainingResponse.Merge(m, src)
+}
+func (m *QueryBlocksRemainingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlocksRemainingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlocksRemainingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlocksRemainingResponse proto.InternalMessageInfo
+
+func (m *QueryBlocksRemainingResponse) GetBlocksRemaining() int64 {
+	if m != nil {
+		return m.BlocksRemaining
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBlocksRemainingResponse) GetTimeRemaining() *time.Duration {
+	if m != nil {
+		return m.TimeRemaining
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryMinterRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryDeveloperRewardsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryDeveloperRewardsRequest) Reset()         { *m = QueryDeveloperRewardsRequest{} }
+func (m *QueryDeveloperRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeveloperRewardsRequest) ProtoMessage()    {}
+func (*QueryDeveloperRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{14}
+}
+func (m *QueryDeveloperRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeveloperRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeveloperRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeveloperRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeveloperRewardsRequest.Merge(m, src)
+}
+func (m *QueryDeveloperRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeveloperRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeveloperRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeveloperRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryDeveloperRewardsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryDeveloperRewardsResponse struct {
+	DeveloperRewards DeveloperRewards `protobuf:"bytes,1,opt,name=developer_rewards,json=developerRewards,proto3" json:"developer_rewards"`
+	// rewards vested but not withdrawn yet
+	Withdrawable github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=withdrawable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawable"`
+}
+
+func (m *QueryDeveloperRewardsResponse) Reset()         { *m = QueryDeveloperRewardsResponse{} }
+func (m *QueryDeveloperRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeveloperRewardsResponse) ProtoMessage()    {}
+func (*QueryDeveloperRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{15}
+}
+func (m *QueryDeveloperRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeveloperRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeveloperRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeveloperRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeveloperRewardsResponse.Merge(m, src)
+}
+func (m *QueryDeveloperRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeveloperRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeveloperRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeveloperRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryDeveloperRewardsResponse) GetDeveloperRewards() DeveloperRewards {
+	if m != nil {
+		return m.DeveloperRewards
+	}
+	return DeveloperRewards{}
+}
+
+func (m *QueryDeveloperRewardsResponse) GetWithdrawable() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Withdrawable
+	}
+	return nil
+}
+
+type QueryAllDeveloperRewardsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllDeveloperRewardsRequest) Reset()         { *m = QueryAllDeveloperRewardsRequest{} }
+func (m *QueryAllDeveloperRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDeveloperRewardsRequest) ProtoMessage()    {}
+func (*QueryAllDeveloperRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{16}
+}
+func (m *QueryAllDeveloperRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDeveloperRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDeveloperRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllDeveloperRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDeveloperRewardsRequest.Merge(m, src)
+}
+func (m *QueryAllDeveloperRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDeveloperRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDeveloperRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllDeveloperRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryAllDeveloperRewardsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllDeveloperRewardsResponse struct {
+	DeveloperRewards []DeveloperRewards  `protobuf:"bytes,1,rep,name=developer_rewards,json=developerRewards,proto3" json:"developer_rewards"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllDeveloperRewardsResponse) Reset()         { *m = QueryAllDeveloperRewardsResponse{} }
+func (m *QueryAllDeveloperRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDeveloperRewardsResponse) ProtoMessage()    {}
+func (*QueryAllDeveloperRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{17}
+}
+func (m *QueryAllDeveloperRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDeveloperRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDeveloperRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllDeveloperRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDeveloperRewardsResponse.Merge(m, src)
+}
+func (m *QueryAllDeveloperRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDeveloperRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDeveloperRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllDeveloperRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryAllDeveloperRewardsResponse) GetDeveloperRewards() []DeveloperRewards {
+	if m != nil {
+		return m.DeveloperRewards
+	}
+	return nil
+}
+
+func (m *QueryAllDeveloperRewardsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryDeveloperVestingRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryDeveloperVestingRequest) Reset()         { *m = QueryDeveloperVestingRequest{} }
+func (m *QueryDeveloperVestingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeveloperVestingRequest) ProtoMessage()    {}
+func (*QueryDeveloperVestingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{18}
+}
+func (m *QueryDeveloperVestingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeveloperVestingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeveloperVestingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeveloperVestingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeveloperVestingRequest.Merge(m, src)
+}
+func (m *QueryDeveloperVestingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeveloperVestingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeveloperVestingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeveloperVestingRequest proto.InternalMessageInfo
+
+func (m *QueryDeveloperVestingRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryDeveloperVestingResponse struct {
+	// vesting schedule of the receiver
+	Vesting DeveloperVesting `protobuf:"bytes,1,opt,name=vesting,proto3" json:"vesting"`
+	// total rewards vested so far, including withdrawn rewards
+	Vested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=vested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vested"`
+	// rewards not vested yet
+	Locked github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=locked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"locked"`
+}
+
+func (m *QueryDeveloperVestingResponse) Reset()         { *m = QueryDeveloperVestingResponse{} }
+func (m *QueryDeveloperVestingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeveloperVestingResponse) ProtoMessage()    {}
+func (*QueryDeveloperVestingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{19}
+}
+func (m *QueryDeveloperVestingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeveloperVestingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeveloperVestingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeveloperVestingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeveloperVestingResponse.Merge(m, src)
+}
+func (m *QueryDeveloperVestingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeveloperVestingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeveloperVestingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeveloperVestingResponse proto.InternalMessageInfo
+
+func (m *QueryDeveloperVestingResponse) GetVesting() DeveloperVesting {
+	if m != nil {
+		return m.Vesting
+	}
+	return DeveloperVesting{}
+}
+
+func (m *QueryDeveloperVestingResponse) GetVested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Vested
+	}
+	return nil
+}
+
+func (m *QueryDeveloperVestingResponse) GetLocked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Locked
+	}
+	return nil
+}
+
+type QueryDistributionTotalsRequest struct {
+}
+
+func (m *QueryDistributionTotalsRequest) Reset()         { *m = QueryDistributionTotalsRequest{} }
+func (m *QueryDistributionTotalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionTotalsRequest) ProtoMessage()    {}
+func (*QueryDistributionTotalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{20}
+}
+func (m *QueryDistributionTotalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionTotalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionTotalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributionTotalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionTotalsRequest.Merge(m, src)
+}
+func (m *QueryDistributionTotalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionTotalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionTotalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDistributionTotalsRequest proto.InternalMessageInfo
+
+type QueryDistributionTotalsResponse struct {
+	// totals of all phases
+	Total DistributionRecord `protobuf:"bytes,1,opt,name=total,proto3" json:"total"`
+	// totals of every phase with minted coins
+	Phases []DistributionRecord `protobuf:"bytes,2,rep,name=phases,proto3" json:"phases"`
+}
+
+func (m *QueryDistributionTotalsResponse) Reset()         { *m = QueryDistributionTotalsResponse{} }
+func (m *QueryDistributionTotalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionTotalsResponse) ProtoMessage()    {}
+func (*QueryDistributionTotalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{21}
+}
+func (m *QueryDistributionTotalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionTotalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionTotalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributionTotalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionTotalsResponse.Merge(m, src)
+}
+func (m *QueryDistributionTotalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionTotalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionTotalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDistributionTotalsResponse proto.InternalMessageInfo
+
+func (m *QueryDistributionTotalsResponse) GetTotal() DistributionRecord {
+	if m != nil {
+		return m.Total
+	}
+	return DistributionRecord{}
+}
+
+func (m *QueryDistributionTotalsResponse) GetPhases() []DistributionRecord {
+	if m != nil {
+		return m.Phases
+	}
+	return nil
+}
+
+type QueryDistributionRecordRequest struct {
+	Phase uint64 `protobuf:"varint,1,opt,name=phase,proto3" json:"phase,omitempty"`
+}
+
+func (m *QueryDistributionRecordRequest) Reset()         { *m = QueryDistributionRecordRequest{} }
+func (m *QueryDistributionRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionRecordRequest) ProtoMessage()    {}
+func (*QueryDistributionRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{22}
+}
+func (m *QueryDistributionRecordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionRecordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributionRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionRecordRequest.Merge(m, src)
+}
+func (m *QueryDistributionRecordRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionRecordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDistributionRecordRequest proto.InternalMessageInfo
+
+func (m *QueryDistributionRecordRequest) GetPhase() uint64 {
+	if m != nil {
+		return m.Phase
+	}
+	return 0
+}
+
+type QueryDistributionRecordResponse struct {
+	DistributionRecord DistributionRecord `protobuf:"bytes,1,opt,name=distribution_record,json=distributionRecord,proto3" json:"distribution_record"`
+}
+
+func (m *QueryDistributionRecordResponse) Reset()         { *m = QueryDistributionRecordResponse{} }
+func (m *QueryDistributionRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionRecordResponse) ProtoMessage()    {}
+func (*QueryDistributionRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{23}
+}
+func (m *QueryDistributionRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributionRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionRecordResponse.Merge(m, src)
+}
+func (m *QueryDistributionRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionRecordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDistributionRecordResponse proto.InternalMessageInfo
+
+func (m *QueryDistributionRecordResponse) GetDistributionRecord() DistributionRecord {
+	if m != nil {
+		return m.DistributionRecord
+	}
+	return DistributionRecord{}
+}
+
+type QueryEpochRequest struct {
+}
+
+func (m *QueryEpochRequest) Reset()         { *m = QueryEpochRequest{} }
+func (m *QueryEpochRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochRequest) ProtoMessage()    {}
+func (*QueryEpochRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{24}
+}
+func (m *QueryEpochRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochRequest.Merge(m, src)
+}
+func (m *QueryEpochRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochRequest proto.InternalMessageInfo
+
+type QueryEpochResponse struct {
+	Epoch Epoch `protobuf:"bytes,1,opt,name=epoch,proto3" json:"epoch"`
+	// height of the last block of the epoch, zero when the epoch ends by block time
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// block time the epoch ends at, unset when the epoch ends by block height
+	EndTime *time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+}
+
+func (m *QueryEpochResponse) Reset()         { *m = QueryEpochResponse{} }
+func (m *QueryEpochResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochResponse) ProtoMessage()    {}
+func (*QueryEpochResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{25}
+}
+func (m *QueryEpochResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochResponse.Merge(m, src)
+}
+func (m *QueryEpochResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochResponse proto.InternalMessageInfo
+
+func (m *QueryEpochResponse) GetEpoch() Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return Epoch{}
+}
+
+func (m *QueryEpochResponse) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *QueryEpochResponse) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+// QueryEcosystemPoolRequest is the request type for the Query/EcosystemPool
+// RPC method.
+type QueryEcosystemPoolRequest struct {
+}
+
+func (m *QueryEcosystemPoolRequest) Reset()         { *m = QueryEcosystemPoolRequest{} }
+func (m *QueryEcosystemPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEcosystemPoolRequest) ProtoMessage()    {}
+func (*QueryEcosystemPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{26}
+}
+func (m *QueryEcosystemPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEcosystemPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEcosystemPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEcosystemPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEcosystemPoolRequest.Merge(m, src)
+}
+func (m *QueryEcosystemPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEcosystemPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEcosystemPoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEcosystemPoolRequest proto.InternalMessageInfo
+
+// QueryEcosystemPoolResponse is the response type for the Query/EcosystemPool
+// RPC method.
+type QueryEcosystemPoolResponse struct {
+	Pool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=pool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool"`
+}
+
+func (m *QueryEcosystemPoolResponse) Reset()         { *m = QueryEcosystemPoolResponse{} }
+func (m *QueryEcosystemPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEcosystemPoolResponse) ProtoMessage()    {}
+func (*QueryEcosystemPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{27}
+}
+func (m *QueryEcosystemPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEcosystemPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEcosystemPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEcosystemPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEcosystemPoolResponse.Merge(m, src)
+}
+func (m *QueryEcosystemPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEcosystemPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEcosystemPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEcosystemPoolResponse proto.InternalMessageInfo
+
+func (m *QueryEcosystemPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Pool
+	}
+	return nil
+}
+
+// QueryPauseRequest is the request type for the Query/Pause RPC method.
+type QueryPauseRequest struct {
+}
+
+func (m *QueryPauseRequest) Reset()         { *m = QueryPauseRequest{} }
+func (m *QueryPauseRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseRequest) ProtoMessage()    {}
+func (*QueryPauseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{28}
+}
+func (m *QueryPauseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPauseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseRequest.Merge(m, src)
+}
+func (m *QueryPauseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPauseRequest proto.InternalMessageInfo
+
+// QueryPauseResponse is the response type for the Query/Pause RPC method.
+type QueryPauseResponse struct {
+	Pause MintPause `protobuf:"bytes,1,opt,name=pause,proto3" json:"pause"`
+	// policy applied to the provisions of the skipped blocks
+	PausePolicy PausePolicy `protobuf:"varint,2,opt,name=pause_policy,json=pausePolicy,proto3,enum=galaxy.mint.PausePolicy" json:"pause_policy,omitempty"`
+}
+
+func (m *QueryPauseResponse) Reset()         { *m = QueryPauseResponse{} }
+func (m *QueryPauseResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseResponse) ProtoMessage()    {}
+func (*QueryPauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{29}
+}
+func (m *QueryPauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseResponse.Merge(m, src)
+}
+func (m *QueryPauseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPauseResponse proto.InternalMessageInfo
+
+func (m *QueryPauseResponse) GetPause() MintPause {
+	if m != nil {
+		return m.Pause
+	}
+	return MintPause{}
+}
+
+func (m *QueryPauseResponse) GetPausePolicy() PausePolicy {
+	if m != nil {
+		return m.PausePolicy
+	}
+	return PauseForfeit
+}
+
+// QueryFeeBurnRequest is the request type for the Query/FeeBurn RPC method.
+type QueryFeeBurnRequest struct {
+}
+
+func (m *QueryFeeBurnRequest) Reset()         { *m = QueryFeeBurnRequest{} }
+func (m *QueryFeeBurnRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeBurnRequest) ProtoMessage()    {}
+func (*QueryFeeBurnRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{30}
+}
+func (m *QueryFeeBurnRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeBurnRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeBurnRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeBurnRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeBurnRequest.Merge(m, src)
+}
+func (m *QueryFeeBurnRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeBurnRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeBurnRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeBurnRequest proto.InternalMessageInfo
+
+// QueryFeeBurnResponse is the response type for the Query/FeeBurn RPC method.
+type QueryFeeBurnResponse struct {
+	FeeBurn FeeBurn `protobuf:"bytes,1,opt,name=fee_burn,json=feeBurn,proto3" json:"fee_burn"`
+	// ratio of the fees burned every block
+	FeeBurnRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=fee_burn_ratio,json=feeBurnRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_burn_ratio"`
+}
+
+func (m *QueryFeeBurnResponse) Reset()         { *m = QueryFeeBurnResponse{} }
+func (m *QueryFeeBurnResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeBurnResponse) ProtoMessage()    {}
+func (*QueryFeeBurnResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{31}
+}
+func (m *QueryFeeBurnResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeBurnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeBurnResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeBurnResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeBurnResponse.Merge(m, src)
+}
+func (m *QueryFeeBurnResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeBurnResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeBurnResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeBurnResponse proto.InternalMessageInfo
+
+func (m *QueryFeeBurnResponse) GetFeeBurn() FeeBurn {
+	if m != nil {
+		return m.FeeBurn
+	}
+	return FeeBurn{}
+}
+
+type QueryProjectionRequest struct {
+}
+
+func (m *QueryProjectionRequest) Reset()         { *m = QueryProjectionRequest{} }
+func (m *QueryProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectionRequest) ProtoMessage()    {}
+func (*QueryProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{32}
+}
+func (m *QueryProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectionRequest.Merge(m, src)
+}
+func (m *QueryProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectionRequest proto.InternalMessageInfo
+
+type QueryProjectionResponse struct {
+	Projections []PhaseProjection `protobuf:"bytes,1,rep,name=projections,proto3" json:"projections"`
+}
+
+func (m *QueryProjectionResponse) Reset()         { *m = QueryProjectionResponse{} }
+func (m *QueryProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectionResponse) ProtoMessage()    {}
+func (*QueryProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{33}
+}
+func (m *QueryProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectionResponse.Merge(m, src)
+}
+func (m *QueryProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectionResponse proto.InternalMessageInfo
+
+func (m *QueryProjectionResponse) GetProjections() []PhaseProjection {
+	if m != nil {
+		return m.Projections
+	}
+	return nil
+}
+
+// PhaseProjection defines the projected emissions of a single phase.
+type PhaseProjection struct {
+	Phase            uint64                                 `protobuf:"varint,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	Inflation        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions"`
+	BlockProvision   types1.Coin                            `protobuf:"bytes,4,opt,name=block_provision,json=blockProvision,proto3" json:"block_provision"`
+	// total supply of the mint denom at the end of the phase
+	EndTotalSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=end_total_supply,json=endTotalSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"end_total_supply"`
+	// fees projected to be burned in the phase at the average burn rate so far
+	Burned github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=burned,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"burned"`
+	// inflation rate net of the projected fee burn, negative when more is
+	// burned than minted
+	NetInflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=net_inflation,json=netInflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"net_inflation"`
+}
+
+func (m *PhaseProjection) Reset()         { *m = PhaseProjection{} }
+func (m *PhaseProjection) String() string { return proto.CompactTextString(m) }
+func (*PhaseProjection) ProtoMessage()    {}
+func (*PhaseProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9213eebd005a4574, []int{34}
+}
+func (m *PhaseProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PhaseProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PhaseProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PhaseProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PhaseProjection.Merge(m, src)
+}
+func (m *PhaseProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *PhaseProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_PhaseProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PhaseProjection proto.InternalMessageInfo
+
+func (m *PhaseProjection) GetPhase() uint64 {
+	if m != nil {
+		return m.Phase
+	}
+	return 0
+}
+
+func (m *PhaseProjection) GetBlockProvision() types1.Coin {
+	if m != nil {
+		return m.BlockProvision
+	}
+	return types1.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "galaxy.mint.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "galaxy.mint.QueryParamsResponse")
+	proto.RegisterType((*QueryMinterRequest)(nil), "galaxy.mint.QueryMinterRequest")
+	proto.RegisterType((*QueryMinterResponse)(nil), "galaxy.mint.QueryMinterResponse")
+	proto.RegisterType((*QueryInflationRequest)(nil), "galaxy.mint.QueryInflationRequest")
+	proto.RegisterType((*QueryInflationResponse)(nil), "galaxy.mint.QueryInflationResponse")
+	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "galaxy.mint.QueryAnnualProvisionsRequest")
+	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "galaxy.mint.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QueryPhaseRequest)(nil), "galaxy.mint.QueryPhaseRequest")
+	proto.RegisterType((*QueryPhaseResponse)(nil), "galaxy.mint.QueryPhaseResponse")
+	proto.RegisterType((*QueryBlockProvisionRequest)(nil), "galaxy.mint.QueryBlockProvisionRequest")
+	proto.RegisterType((*QueryBlockProvisionResponse)(nil), "galaxy.mint.QueryBlockProvisionResponse")
+	proto.RegisterType((*QueryBlocksRemainingRequest)(nil), "galaxy.mint.QueryBlocksRemainingRequest")
+	proto.RegisterType((*QueryBlocksRemainingResponse)(nil), "galaxy.mint.QueryBlocksRemainingResponse")
+	proto.RegisterType((*QueryDeveloperRewardsRequest)(nil), "galaxy.mint.QueryDeveloperRewardsRequest")
+	proto.RegisterType((*QueryDeveloperRewardsResponse)(nil), "galaxy.mint.QueryDeveloperRewardsResponse")
+	proto.RegisterType((*QueryAllDeveloperRewardsRequest)(nil), "galaxy.mint.QueryAllDeveloperRewardsRequest")
+	proto.RegisterType((*QueryAllDeveloperRewardsResponse)(nil), "galaxy.mint.QueryAllDeveloperRewardsResponse")
+	proto.RegisterType((*QueryDeveloperVestingRequest)(nil), "galaxy.mint.QueryDeveloperVestingRequest")
+	proto.RegisterType((*QueryDeveloperVestingResponse)(nil), "galaxy.mint.QueryDeveloperVestingResponse")
+	proto.RegisterType((*QueryDistributionTotalsRequest)(nil), "galaxy.mint.QueryDistributionTotalsRequest")
+	proto.RegisterType((*QueryDistributionTotalsResponse)(nil), "galaxy.mint.QueryDistributionTotalsResponse")
+	proto.RegisterType((*QueryDistributionRecordRequest)(nil), "galaxy.mint.QueryDistributionRecordRequest")
+	proto.RegisterType((*QueryDistributionRecordResponse)(nil), "galaxy.mint.QueryDistributionRecordResponse")
+	proto.RegisterType((*QueryEpochRequest)(nil), "galaxy.mint.QueryEpochRequest")
+	proto.RegisterType((*QueryEpochResponse)(nil), "galaxy.mint.QueryEpochResponse")
+	proto.RegisterType((*QueryEcosystemPoolRequest)(nil), "galaxy.mint.QueryEcosystemPoolRequest")
+	proto.RegisterType((*QueryEcosystemPoolResponse)(nil), "galaxy.mint.QueryEcosystemPoolResponse")
+	proto.RegisterType((*QueryPauseRequest)(nil), "galaxy.mint.QueryPauseRequest")
+	proto.RegisterType((*QueryPauseResponse)(nil), "galaxy.mint.QueryPauseResponse")
+	proto.RegisterType((*QueryFeeBurnRequest)(nil), "galaxy.mint.QueryFeeBurnRequest")
+	proto.RegisterType((*QueryFeeBurnResponse)(nil), "galaxy.mint.QueryFeeBurnResponse")
+	proto.RegisterType((*QueryProjectionRequest)(nil), "galaxy.mint.QueryProjectionRequest")
+	proto.RegisterType((*QueryProjectionResponse)(nil), "galaxy.mint.QueryProjectionResponse")
+	proto.RegisterType((*PhaseProjection)(nil), "galaxy.mint.PhaseProjection")
+}
+
+func init() { proto.RegisterFile("galaxy/mint/query.proto", fileDescriptor_9213eebd005a4574) }
+
+var fileDescriptor_9213eebd005a4574 = []byte{
+	// 1779 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcb, 0x6f, 0x13, 0x57,
+	0x17, 0xcf, 0xc4, 0x76, 0x42, 0x4e, 0x42, 0x1e, 0x37, 0x2f, 0x33, 0x89, 0x1f, 0x0c, 0x7c, 0x21,
+	0x09, 0xc1, 0x26, 0xf9, 0xf4, 0x7d, 0xfa, 0x24, 0x84, 0x3e, 0x35, 0xa4, 0x29, 0x48, 0xad, 0x94,
+	0x1a, 0x84, 0xaa, 0x76, 0x61, 0x8d, 0x3d, 0x37, 0xce, 0x14, 0x7b, 0x66, 0x98, 0x19, 0x27, 0x44,
+	0x94, 0x4d, 0x25, 0x16, 0x5d, 0x54, 0x02, 0xb1, 0xe9, 0x43, 0xdd, 0xb4, 0x5d, 0x54, 0x5d, 0x76,
+	0xdf, 0xae, 0x59, 0x22, 0x75, 0x53, 0x75, 0x01, 0x55, 0xe8, 0xba, 0x7f, 0x43, 0x75, 0x5f, 0xf6,
+	0xdc, 0x79, 0x38, 0x4e, 0x54, 0x36, 0x89, 0xe7, 0x3c, 0x7f, 0xe7, 0xdc, 0x73, 0xef, 0x39, 0x07,
+	0xe6, 0x1b, 0x7a, 0x53, 0x7f, 0x70, 0x58, 0x6e, 0x99, 0x96, 0x5f, 0xbe, 0xdf, 0xc6, 0xee, 0x61,
+	0xc9, 0x71, 0x6d, 0xdf, 0x46, 0xa3, 0x8c, 0x51, 0x22, 0x0c, 0x75, 0xa6, 0x61, 0x37, 0x6c, 0x4a,
+	0x2f, 0x93, 0x5f, 0x4c, 0x44, 0x5d, 0x6c, 0xd8, 0x76, 0xa3, 0x89, 0xcb, 0xba, 0x63, 0x96, 0x75,
+	0xcb, 0xb2, 0x7d, 0xdd, 0x37, 0x6d, 0xcb, 0xe3, 0xdc, 0xd5, 0xba, 0xed, 0xb5, 0x6c, 0xaf, 0x5c,
+	0xd3, 0x3d, 0xcc, 0x2c, 0x97, 0xf7, 0xd7, 0x6b, 0xd8, 0xd7, 0xd7, 0xcb, 0x8e, 0xde, 0x30, 0x2d,
+	0x2a, 0xcc, 0x65, 0xf3, 0x41, 0x59, 0x21, 0x55, 0xb7, 0x4d, 0xc1, 0xcf, 0x06, 0x51, 0x3a, 0xba,
+	0xab, 0xb7, 0x84, 0x97, 0xb9, 0x20, 0x87, 0xfc, 0xe1, 0xf4, 0x02, 0xc7, 0x46, 0xbf, 0x6a, 0xed,
+	0xdd, 0xb2, 0x6f, 0xb6, 0xb0, 0xe7, 0xeb, 0x2d, 0x47, 0xb8, 0x0c, 0x0b, 0x18, 0x6d, 0x37, 0x00,
+	0x49, 0x9b, 0x01, 0xf4, 0x3e, 0x01, 0xbd, 0x43, 0xbd, 0x55, 0xf0, 0xfd, 0x36, 0xf6, 0x7c, 0xed,
+	0x26, 0x4c, 0x4b, 0x54, 0xcf, 0xb1, 0x2d, 0x0f, 0xa3, 0x75, 0x18, 0x62, 0xa8, 0xb2, 0x4a, 0x51,
+	0x59, 0x1e, 0xdd, 0x98, 0x2e, 0x05, 0xb2, 0x57, 0x62, 0xc2, 0x9b, 0xe9, 0xe7, 0x2f, 0x0b, 0x03,
+	0x15, 0x2e, 0xd8, 0xb1, 0xff, 0x9e, 0x69, 0xf9, 0xd8, 0x0d, 0xdb, 0x17, 0xd4, 0xae, 0xfd, 0x16,
+	0xa5, 0xc4, 0xda, 0x67, 0xc2, 0xc2, 0x3e, 0x13, 0xd4, 0xe6, 0x61, 0x96, 0x5a, 0xba, 0x65, 0xed,
+	0x36, 0x69, 0x5c, 0xc2, 0xc5, 0x2e, 0xcc, 0x85, 0x19, 0xdc, 0xcb, 0xbb, 0x30, 0x62, 0x0a, 0x22,
+	0x75, 0x34, 0xb6, 0x59, 0x22, 0x36, 0x7f, 0x7f, 0x59, 0x58, 0x6a, 0x98, 0xfe, 0x5e, 0xbb, 0x56,
+	0xaa, 0xdb, 0xad, 0x32, 0x3f, 0x2b, 0xf6, 0xef, 0x8a, 0x67, 0xdc, 0x2b, 0xfb, 0x87, 0x0e, 0xf6,
+	0x4a, 0x5b, 0xb8, 0x5e, 0xe9, 0x1a, 0xd0, 0xf2, 0xb0, 0x48, 0xfd, 0xbc, 0x65, 0x59, 0x6d, 0xbd,
+	0xb9, 0xe3, 0xda, 0xfb, 0xa6, 0x47, 0xca, 0x43, 0xe0, 0xf8, 0x04, 0x72, 0x09, 0x7c, 0x0e, 0xe7,
+	0x23, 0x98, 0xd2, 0x29, 0xaf, 0xea, 0x74, 0x98, 0xa7, 0x84, 0x35, 0xa9, 0x87, 0x9c, 0x68, 0xd3,
+	0x30, 0xc5, 0x0e, 0x72, 0x4f, 0xf7, 0xb0, 0x80, 0xf4, 0x97, 0x02, 0x28, 0x48, 0xe5, 0x40, 0x66,
+	0x20, 0xe3, 0x10, 0x02, 0x75, 0x9e, 0xae, 0xb0, 0x0f, 0x74, 0x1e, 0xc6, 0x3c, 0x5f, 0x77, 0xfd,
+	0xea, 0x1e, 0x36, 0x1b, 0x7b, 0x7e, 0x76, 0xb0, 0xa8, 0x2c, 0xa7, 0x2a, 0xa3, 0x94, 0x76, 0x93,
+	0x92, 0x50, 0x0e, 0x00, 0x5b, 0x86, 0x10, 0x48, 0x51, 0x81, 0x11, 0x6c, 0x19, 0x9c, 0x7d, 0x03,
+	0x80, 0x59, 0x20, 0xb5, 0x99, 0x4d, 0xd3, 0x93, 0x55, 0x4b, 0xac, 0x2e, 0x4b, 0xa2, 0x2e, 0x4b,
+	0x77, 0x44, 0xe1, 0x6e, 0x9e, 0x21, 0x51, 0x3f, 0x79, 0x55, 0x50, 0x2a, 0x23, 0x54, 0x8f, 0x70,
+	0xd0, 0x35, 0x38, 0x43, 0x7c, 0x50, 0x13, 0x99, 0x63, 0x4d, 0xa4, 0xa9, 0xfa, 0x30, 0xb6, 0x0c,
+	0x42, 0xd3, 0x16, 0x41, 0xa5, 0xf1, 0x6e, 0x36, 0xed, 0xfa, 0xbd, 0x4e, 0x76, 0x44, 0x3a, 0x7e,
+	0x52, 0x60, 0x21, 0x96, 0xcd, 0xf3, 0x72, 0x13, 0x26, 0x6a, 0x84, 0xd3, 0x3d, 0x1f, 0x5e, 0x9e,
+	0xe7, 0x4a, 0xec, 0x14, 0x4a, 0xe4, 0x3e, 0x97, 0xf8, 0x7d, 0x2e, 0xdd, 0xb0, 0x4d, 0x8b, 0x17,
+	0xe9, 0x78, 0x4d, 0xb2, 0x88, 0x6e, 0xc1, 0x98, 0x61, 0x7a, 0xbe, 0x6b, 0xd6, 0xda, 0xb4, 0xf8,
+	0x06, 0xa9, 0x99, 0x82, 0x54, 0xe5, 0x5b, 0x01, 0x81, 0x0a, 0xae, 0xdb, 0xae, 0xc1, 0x8d, 0x49,
+	0xaa, 0x5a, 0x2e, 0x88, 0xd9, 0xab, 0xe0, 0x96, 0x6e, 0x5a, 0xa6, 0xd5, 0x10, 0x31, 0x3d, 0x55,
+	0x60, 0x31, 0x9e, 0xcf, 0x83, 0x5a, 0x81, 0x49, 0x0a, 0xce, 0xab, 0xba, 0x82, 0x47, 0xa3, 0x4a,
+	0x55, 0x26, 0x6a, 0xb2, 0x0a, 0xda, 0x86, 0x71, 0x92, 0xf6, 0x80, 0xe0, 0x20, 0x0f, 0x3f, 0x7c,
+	0x00, 0x5b, 0xfc, 0x6d, 0xd9, 0x4c, 0x7f, 0x41, 0xf2, 0x7f, 0x96, 0xa8, 0x75, 0xec, 0x68, 0xff,
+	0xe3, 0x90, 0xb6, 0xf0, 0x3e, 0x6e, 0xda, 0x0e, 0xb9, 0xf7, 0x07, 0xba, 0x6b, 0x88, 0x9b, 0x82,
+	0xb2, 0x30, 0xac, 0x1b, 0x86, 0x8b, 0x3d, 0x56, 0xfe, 0x23, 0x15, 0xf1, 0xa9, 0x1d, 0x29, 0x90,
+	0x4b, 0x50, 0xe5, 0xe1, 0xec, 0xc0, 0x94, 0x21, 0x78, 0x55, 0x97, 0x31, 0xf9, 0x29, 0xe5, 0xe4,
+	0xf4, 0x86, 0x2c, 0xf0, 0xe4, 0x4e, 0x1a, 0x21, 0x3a, 0xb2, 0x61, 0xec, 0xc0, 0xf4, 0xf7, 0x0c,
+	0x57, 0x3f, 0xd0, 0x6b, 0x4d, 0x9c, 0x1d, 0x2c, 0xa6, 0x7a, 0x1f, 0xf9, 0x55, 0x62, 0xe8, 0xc7,
+	0x57, 0x85, 0xe5, 0x3e, 0x2e, 0x2b, 0x51, 0xf0, 0x2a, 0x92, 0x03, 0xcd, 0x84, 0x02, 0x7b, 0x28,
+	0x9a, 0xcd, 0xa4, 0x0c, 0x6d, 0x03, 0x74, 0x7b, 0x0a, 0x0f, 0x6f, 0x49, 0x42, 0xc4, 0x5a, 0x9b,
+	0xc0, 0xb5, 0xa3, 0x37, 0xc4, 0xa5, 0xaf, 0x04, 0x34, 0xb5, 0x9f, 0x15, 0x28, 0x26, 0xfb, 0xea,
+	0x9d, 0xd2, 0xd4, 0xe9, 0x53, 0xfa, 0x8e, 0x04, 0x9f, 0x15, 0xd1, 0xa5, 0x63, 0xe1, 0x33, 0x38,
+	0x12, 0xfe, 0x48, 0x25, 0xdd, 0xc5, 0x9e, 0xdf, 0xad, 0xfe, 0x1e, 0x95, 0xf4, 0xc3, 0x20, 0xe4,
+	0x12, 0x54, 0x79, 0xd8, 0xd7, 0x61, 0x78, 0x9f, 0x91, 0x7a, 0xd7, 0x0f, 0xd7, 0xe3, 0xc1, 0x0a,
+	0x1d, 0x54, 0x87, 0x21, 0xf2, 0x13, 0x1b, 0x6f, 0xa2, 0x60, 0xb8, 0x69, 0xe2, 0x84, 0xdc, 0x51,
+	0x6c, 0x64, 0x53, 0x6f, 0xc0, 0x09, 0x33, 0xad, 0x15, 0x21, 0xcf, 0x32, 0x15, 0x78, 0x76, 0xee,
+	0xd8, 0xbe, 0xde, 0xec, 0xb4, 0xb6, 0x6f, 0x14, 0x28, 0x24, 0x8a, 0xf0, 0x74, 0x5e, 0x83, 0x8c,
+	0x4f, 0x28, 0x59, 0xe5, 0x24, 0x6f, 0x1d, 0xd3, 0x41, 0xd7, 0x61, 0x88, 0x36, 0x21, 0x8f, 0x27,
+	0xb3, 0x4f, 0x6d, 0xae, 0xa4, 0xfd, 0x37, 0x26, 0x02, 0x26, 0x28, 0x0a, 0x25, 0xb6, 0xe5, 0x69,
+	0x87, 0x50, 0x48, 0xd4, 0xe3, 0x61, 0xdd, 0x85, 0xe9, 0xe0, 0x73, 0x5c, 0x75, 0x29, 0xfb, 0x64,
+	0x41, 0x22, 0x23, 0xc2, 0xe9, 0xf4, 0xeb, 0xb7, 0x1d, 0xbb, 0xbe, 0x27, 0xf2, 0xfc, 0xbd, 0xe8,
+	0xd7, 0x9c, 0xca, 0x31, 0x94, 0x20, 0x83, 0x09, 0x81, 0x7b, 0x45, 0x92, 0x57, 0x2a, 0x2a, 0xb2,
+	0x49, 0xc5, 0x42, 0x6d, 0x7a, 0x30, 0xdc, 0xa6, 0xff, 0x1f, 0xe8, 0xb0, 0xa9, 0xbe, 0x9a, 0xb4,
+	0x22, 0x77, 0xd9, 0x05, 0x38, 0xc7, 0x50, 0xd6, 0x6d, 0xef, 0xd0, 0xf3, 0x71, 0x6b, 0xc7, 0xb6,
+	0x9b, 0x22, 0x86, 0x47, 0xa0, 0xc6, 0x31, 0x79, 0x28, 0x55, 0x48, 0x3b, 0xb6, 0xdd, 0xcc, 0x2a,
+	0xff, 0x7c, 0x39, 0x53, 0xc3, 0xdd, 0x39, 0x48, 0x6f, 0x77, 0xe7, 0xa0, 0xc7, 0x9d, 0x39, 0x88,
+	0x51, 0x39, 0x98, 0x0d, 0xc8, 0x38, 0x84, 0xc0, 0xf3, 0x3a, 0x17, 0x19, 0x42, 0xa9, 0xb8, 0xc8,
+	0x2d, 0x15, 0x45, 0xd7, 0x60, 0x8c, 0xfe, 0xa8, 0x3a, 0x76, 0xd3, 0xac, 0x1f, 0xd2, 0xec, 0x8e,
+	0x6f, 0x64, 0x43, 0xf3, 0x71, 0xdb, 0xc3, 0x3b, 0x94, 0x5f, 0x19, 0x75, 0xba, 0x1f, 0xda, 0x2c,
+	0x9f, 0x86, 0xb7, 0x31, 0xde, 0x6c, 0xbb, 0x9d, 0xb9, 0xe4, 0x3b, 0x05, 0x66, 0x64, 0x3a, 0x07,
+	0xf8, 0x1f, 0x38, 0xb3, 0x8b, 0x71, 0xb5, 0xd6, 0x76, 0x45, 0x13, 0x98, 0x91, 0x1c, 0x71, 0x79,
+	0xf1, 0x34, 0xed, 0xb2, 0x4f, 0x74, 0x07, 0xc6, 0x85, 0x5a, 0x95, 0xf6, 0x69, 0x8a, 0x72, 0xe4,
+	0xc4, 0x53, 0xe6, 0x18, 0x37, 0x58, 0x21, 0x36, 0xb4, 0x2c, 0x9f, 0xb3, 0x77, 0x5c, 0xfb, 0x63,
+	0x5c, 0x0f, 0x4e, 0xe0, 0x55, 0x98, 0x8f, 0x70, 0x78, 0x04, 0x5b, 0x30, 0xea, 0x74, 0xa8, 0xa2,
+	0xab, 0x2c, 0xca, 0xd9, 0x22, 0x57, 0xb1, 0xab, 0xca, 0x83, 0x09, 0xaa, 0x69, 0xcf, 0xd2, 0x30,
+	0x11, 0x12, 0x4b, 0x18, 0x62, 0xa5, 0x91, 0xff, 0x74, 0x51, 0x77, 0x0d, 0xc4, 0x4f, 0xec, 0xa9,
+	0x53, 0x59, 0x8d, 0x4c, 0xec, 0x71, 0xd3, 0x66, 0xfa, 0x74, 0xd3, 0xe6, 0x07, 0x30, 0x49, 0x2f,
+	0x34, 0x79, 0x4a, 0xab, 0x5e, 0xdb, 0x71, 0x9a, 0x87, 0xd9, 0xcc, 0x89, 0x51, 0xde, 0xb2, 0xfc,
+	0xca, 0x38, 0xb9, 0xe2, 0xc4, 0xcc, 0x6d, 0x6a, 0x05, 0x6d, 0xc3, 0x10, 0xa9, 0x22, 0x6c, 0x64,
+	0x87, 0x4e, 0x65, 0x8f, 0x6b, 0xa3, 0xdb, 0x70, 0xd6, 0xc2, 0x7e, 0xb5, 0x7b, 0x34, 0xc3, 0xa7,
+	0x2b, 0x48, 0x0b, 0xfb, 0x9d, 0x35, 0x6f, 0xe3, 0x97, 0x29, 0xc8, 0xd0, 0xba, 0x43, 0x7b, 0x30,
+	0xc4, 0x76, 0x52, 0x24, 0xbf, 0xc8, 0xd1, 0x85, 0x57, 0x2d, 0x26, 0x0b, 0xb0, 0x92, 0xd5, 0x16,
+	0x3e, 0xfd, 0xf5, 0xcf, 0x67, 0x83, 0xb3, 0x68, 0xba, 0x1c, 0x5d, 0xd2, 0x89, 0x27, 0xb6, 0x9d,
+	0xc6, 0x79, 0x92, 0x56, 0x5f, 0xb5, 0x98, 0x2c, 0xd0, 0xd3, 0x53, 0x8b, 0xd9, 0xf7, 0x61, 0xa4,
+	0x13, 0x2a, 0xd2, 0xa2, 0xb6, 0xc2, 0x7b, 0xb0, 0x7a, 0xa1, 0xa7, 0x0c, 0x77, 0x99, 0xa7, 0x2e,
+	0xb3, 0x68, 0x4e, 0x72, 0xd9, 0xad, 0xf8, 0xa7, 0x0a, 0x4c, 0x86, 0x17, 0x58, 0xb4, 0x12, 0xb5,
+	0x9c, 0xb0, 0x04, 0xab, 0xab, 0xfd, 0x88, 0x72, 0x2c, 0x4b, 0x14, 0x4b, 0x11, 0xe5, 0x25, 0x2c,
+	0x91, 0x0b, 0x87, 0x0c, 0xc8, 0xd0, 0xcb, 0x8f, 0xf2, 0x31, 0x67, 0x17, 0x58, 0x77, 0xd5, 0x42,
+	0x22, 0x9f, 0x7b, 0x54, 0xa9, 0xc7, 0x19, 0x84, 0xe4, 0xa3, 0xa5, 0xc6, 0x3f, 0x53, 0x60, 0x5c,
+	0xde, 0x0b, 0xd1, 0xa5, 0xa8, 0xbd, 0xd8, 0xc5, 0x52, 0x5d, 0x3e, 0x5e, 0x90, 0x23, 0xb8, 0x48,
+	0x11, 0xe4, 0xd1, 0xa2, 0x84, 0x20, 0xf4, 0x0e, 0xa0, 0xcf, 0x15, 0x98, 0x08, 0xed, 0x73, 0x28,
+	0xc9, 0x47, 0x64, 0x25, 0x54, 0x57, 0xfa, 0x90, 0xe4, 0x70, 0xfe, 0x45, 0xe1, 0x14, 0x50, 0x2e,
+	0x0a, 0x27, 0xb0, 0x2f, 0xa2, 0x07, 0x00, 0x81, 0x97, 0x37, 0xa6, 0xd0, 0x22, 0x3d, 0x41, 0xbd,
+	0xd8, 0x5b, 0x88, 0xfb, 0x2f, 0x50, 0xff, 0xe7, 0xd0, 0xbc, 0x7c, 0x20, 0x5d, 0x5f, 0x5f, 0x2b,
+	0x30, 0x19, 0x5e, 0x3b, 0xe2, 0xea, 0x31, 0x61, 0x91, 0x52, 0x57, 0xfb, 0x11, 0xe5, 0x60, 0xae,
+	0x52, 0x30, 0xab, 0x68, 0x59, 0x02, 0x13, 0x59, 0x8d, 0xca, 0x0f, 0xf9, 0x8e, 0xf1, 0x08, 0x7d,
+	0xa5, 0xc0, 0x74, 0xcc, 0x66, 0x85, 0xd6, 0x62, 0x6e, 0x41, 0xe2, 0xb2, 0xa7, 0x5e, 0xe9, 0x53,
+	0xba, 0xe7, 0xb5, 0x89, 0xc0, 0x94, 0x53, 0xc7, 0x97, 0x98, 0x9e, 0xa9, 0x93, 0x77, 0x2b, 0x75,
+	0xb5, 0x1f, 0xd1, 0x3e, 0x53, 0xc7, 0x97, 0xa6, 0x40, 0xea, 0xbe, 0x54, 0x00, 0x45, 0xb7, 0x09,
+	0x74, 0x39, 0xc6, 0x69, 0xd2, 0x5a, 0xa2, 0xae, 0xf5, 0x27, 0xcc, 0x31, 0x2e, 0x53, 0x8c, 0x1a,
+	0x2a, 0xca, 0x18, 0x83, 0xc3, 0xbd, 0xcf, 0x40, 0x7c, 0x1b, 0xc2, 0xc6, 0x46, 0xf6, 0xe3, 0xb0,
+	0x49, 0x0b, 0x87, 0xba, 0xd6, 0x9f, 0x30, 0xc7, 0xb6, 0x4e, 0xb1, 0x5d, 0x46, 0x2b, 0xc9, 0xd8,
+	0xd8, 0xe2, 0xe1, 0x95, 0x1f, 0xd2, 0xe7, 0xea, 0x11, 0x79, 0x15, 0xe9, 0xe8, 0x1f, 0xf7, 0x2a,
+	0x06, 0x97, 0x0a, 0xb5, 0x90, 0xc8, 0xef, 0xf9, 0x2a, 0xb2, 0x55, 0xe2, 0xb1, 0x02, 0x67, 0xa5,
+	0x49, 0x1e, 0x2d, 0xc5, 0x98, 0x8b, 0xd9, 0x03, 0xd4, 0x4b, 0xc7, 0xca, 0x71, 0xf7, 0x17, 0xa8,
+	0xfb, 0x1c, 0x5a, 0x90, 0xdd, 0x0b, 0xd9, 0x2a, 0x19, 0xeb, 0x69, 0x0f, 0xa0, 0xf3, 0x77, 0x5c,
+	0x0f, 0x08, 0x8c, 0xfa, 0x6a, 0x21, 0x91, 0xdf, 0xbb, 0x07, 0x50, 0xe3, 0x2d, 0x18, 0xe6, 0x23,
+	0x35, 0x8a, 0xe9, 0xde, 0xf2, 0xd4, 0xae, 0x9e, 0xef, 0x21, 0xc1, 0x7d, 0xe5, 0xa8, 0xaf, 0x79,
+	0x34, 0x2b, 0xf9, 0x12, 0xb3, 0xf9, 0xe6, 0xf6, 0xf3, 0xa3, 0xbc, 0xf2, 0xe2, 0x28, 0xaf, 0xfc,
+	0x71, 0x94, 0x57, 0x9e, 0xbc, 0xce, 0x0f, 0xbc, 0x78, 0x9d, 0x1f, 0xf8, 0xed, 0x75, 0x7e, 0xe0,
+	0xc3, 0xb5, 0xc0, 0x40, 0xc4, 0x54, 0x2d, 0xec, 0x1f, 0xd8, 0xee, 0x3d, 0x61, 0xe8, 0x01, 0x33,
+	0x45, 0x47, 0xa3, 0xda, 0x10, 0x5d, 0xdb, 0xfe, 0xfd, 0xf7, 0x00, 0x9c, 0xd9, 0x48, 0x63, 0xfc,
+	0x18, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	Minter(ctx context.Context, in *QueryMinterRequest, opts ...grpc.CallOption) (*QueryMinterResponse, error)
+	// Inflation returns the current inflation rate.
+	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
+	// AnnualProvisions returns the current annual provisions.
+	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// Phase returns the current phase with its bounds.
+	Phase(ctx context.Context, in *QueryPhaseRequest, opts ...grpc.CallOption) (*QueryPhaseResponse, error)
+	// BlockProvision returns the provision of the next block at the current
+	// annual provisions, broken down by destination.
+	BlockProvision(ctx context.Context, in *QueryBlockProvisionRequest, opts ...grpc.CallOption) (*QueryBlockProvisionResponse, error)
+	// BlocksRemaining returns the blocks remaining until the next phase.
+	BlocksRemaining(ctx context.Context, in *QueryBlocksRemainingRequest, opts ...grpc.CallOption) (*QueryBlocksRemainingResponse, error)
+	// Projection returns the projected emissions of every remaining phase.
+	Projection(ctx context.Context, in *QueryProjectionRequest, opts ...grpc.CallOption) (*QueryProjectionResponse, error)
+	// DeveloperRewards returns the escrowed rewards of a developer rewards receiver.
+	DeveloperRewards(ctx context.Context, in *QueryDeveloperRewardsRequest, opts ...grpc.CallOption) (*QueryDeveloperRewardsResponse, error)
+	// AllDeveloperRewards returns the escrowed rewards of all developer rewards receivers.
+	AllDeveloperRewards(ctx context.Context, in *QueryAllDeveloperRewardsRequest, opts ...grpc.CallOption) (*QueryAllDeveloperRewardsResponse, error)
+	// DeveloperVesting returns the vested and locked rewards of a developer
+	// rewards receiver.
+	DeveloperVesting(ctx context.Context, in *QueryDeveloperVestingRequest, opts ...grpc.CallOption) (*QueryDeveloperVestingResponse, error)
+	// DistributionTotals returns the cumulative minted coins sent to each
+	// destination, overall and per phase.
+	DistributionTotals(ctx context.Context, in *QueryDistributionTotalsRequest, opts ...grpc.CallOption) (*QueryDistributionTotalsResponse, error)
+	// DistributionRecord returns the cumulative minted coins sent to each
+	// destination during a phase.
+	DistributionRecord(ctx context.Context, in *QueryDistributionRecordRequest, opts ...grpc.CallOption) (*QueryDistributionRecordResponse, error)
+	// Epoch returns the minting epoch in progress.
+	Epoch(ctx context.Context, in *QueryEpochRequest, opts ...grpc.CallOption) (*QueryEpochResponse, error)
+	// EcosystemPool returns the balance of the ecosystem incentives pool.
+	EcosystemPool(ctx context.Context, in *QueryEcosystemPoolRequest, opts ...grpc.CallOption) (*QueryEcosystemPoolResponse, error)
+	// Pause returns the pause state of minting.
+	Pause(ctx context.Context, in *QueryPauseRequest, opts ...grpc.CallOption) (*QueryPauseResponse, error)
+	// FeeBurn returns the cumulative fees burned.
+	FeeBurn(ctx context.Context, in *QueryFeeBurnRequest, opts ...grpc.CallOption) (*QueryFeeBurnResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.mint.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Minter(ctx context.Context, in *QueryMinterRequest, opts ...grpc.CallOption) (*QueryMinterResponse, error) {
+	out := new(QueryMinterResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.mint.Query/Minter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error) {
+	out := new(QueryInflationResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.mint.Query/Inflation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error) {
+	out := new(QueryAnnualProvisionsResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.mint.Query/AnnualProvisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Phase(ctx context.Context, in *QueryPhaseRequest, opts ...grpc.CallOption) (*QueryPhaseResponse, error) {
+	out := new(QueryPhaseResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.mint.Query/Phase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockProvision(ctx context.Context, in *QueryBlockProvisionRequest, opts ...grpc.CallOption) (*QueryBlockProvisionResponse, error) {
+	out := new(QueryBlockProvisionResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.mint.Query/BlockProvision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlocksRemaining(ctx context.Context, in *QueryBlocksRemainingRequest, opts ...grpc.CallOption) (*QueryBlocksRemainingResponse, error) {
+	out := new(QueryBlocksRemainingResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.mint.Query/BlocksRemaining", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Projection(ctx context.Context, in *QueryProjectionRequest, opts ...grpc.CallOption) (*QueryProjectionResponse, error) {
+	out := new(QueryProjectionResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.mint.Query/Projection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeveloperRewards(ctx context.Context, in *QueryDeveloperRewardsRequest, opts ...grpc.CallOption) (*QueryDeveloperRewardsResponse, error) {
+	out := new(QueryDeveloperRewardsResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.mint.Query/DeveloperRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllDeveloperRewards(ctx context.Context, in *QueryAllDeveloperRewardsRequest, opts ...grpc.CallOption) (*QueryAllDeveloperRewardsResponse, error) {
+	out := new(QueryAllDeveloperRewardsResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.mint.Query/AllDeveloperRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeveloperVesting(ctx context.Context, in *QueryDeveloperVestingRequest, opts ...grpc.CallOption) (*QueryDeveloperVestingResponse, error) {
+	out := new(QueryDeveloperVestingResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.mint.Query/DeveloperVesting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DistributionTotals(ctx context.Context, in *QueryDistributionTotalsRequest, opts ...grpc.CallOption) (*QueryDistributionTotalsResponse, error) {
+	out := new(QueryDistributionTotalsResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.mint.Query/DistributionTotals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DistributionRecord(ctx context.Context, in *QueryDistributionRecordRequest, opts ...grpc.CallOption) (*QueryDistributionRecordResponse, error) {
+	out := new(QueryDistributionRecordResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.mint.Query/DistributionRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Epoch(ctx context.Context, in *QueryEpochRequest, opts ...grpc.CallOption) (*QueryEpochResponse, error) {
+	out := new(QueryEpochResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.mint.Query/Epoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EcosystemPool(ctx context.Context, in *QueryEcosystemPoolRequest, opts ...grpc.CallOption) (*QueryEcosystemPoolResponse, error) {
+	out := new(QueryEcosystemPoolResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.mint.Query/EcosystemPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Pause(ctx context.Context, in *QueryPauseRequest, opts ...grpc.CallOption) (*QueryPauseResponse, error) {
+	out := new(QueryPauseResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.mint.Query/Pause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeBurn(ctx context.Context, in *QueryFeeBurnRequest, opts ...grpc.CallOption) (*QueryFeeBurnResponse, error) {
+	out := new(QueryFeeBurnResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.mint.Query/FeeBurn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	Minter(context.Context, *QueryMinterRequest) (*QueryMinterResponse, error)
+	// Inflation returns the current inflation rate.
+	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
+	// AnnualProvisions returns the current annual provisions.
+	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// Phase returns the current phase with its bounds.
+	Phase(context.Context, *QueryPhaseRequest) (*QueryPhaseResponse, error)
+	// BlockProvision returns the provision of the next block at the current
+	// annual provisions, broken down by destination.
+	BlockProvision(context.Context, *QueryBlockProvisionRequest) (*QueryBlockProvisionResponse, error)
+	// BlocksRemaining returns the blocks remaining until the next phase.
+	BlocksRemaining(context.Context, *QueryBlocksRemainingRequest) (*QueryBlocksRemainingResponse, error)
+	// Projection returns the projected emissions of every remaining phase.
+	Projection(context.Context, *QueryProjectionRequest) (*QueryProjectionResponse, error)
+	// DeveloperRewards returns the escrowed rewards of a developer rewards receiver.
+	DeveloperRewards(context.Context, *QueryDeveloperRewardsRequest) (*QueryDeveloperRewardsResponse, error)
+	// AllDeveloperRewards returns the escrowed rewards of all developer rewards receivers.
+	AllDeveloperRewards(context.Context, *QueryAllDeveloperRewardsRequest) (*QueryAllDeveloperRewardsResponse, error)
+	// DeveloperVesting returns the vested and locked rewards of a developer
+	// rewards receiver.
+	DeveloperVesting(context.Context, *QueryDeveloperVestingRequest) (*QueryDeveloperVestingResponse, error)
+	// DistributionTotals returns the cumulative minted coins sent to each
+	// destination, overall and per phase.
+	DistributionTotals(context.Context, *QueryDistributionTotalsRequest) (*QueryDistributionTotalsResponse, error)
+	// DistributionRecord returns the cumulative minted coins sent to each
+	// destination during a phase.
+	DistributionRecord(context.Context, *QueryDistributionRecordRequest) (*QueryDistributionRecordResponse, error)
+	// Epoch returns the minting epoch in progress.
+	Epoch(context.Context, *QueryEpochRequest) (*QueryEpochResponse, error)
+	// EcosystemPool returns the balance of the ecosystem incentives pool.
+	EcosystemPool(context.Context, *QueryEcosystemPoolRequest) (*QueryEcosystemPoolResponse, error)
+	// Pause returns the pause state of minting.
+	Pause(context.Context, *QueryPauseRequest) (*QueryPauseResponse, error)
+	// FeeBurn returns the cumulative fees burned.
+	FeeBurn(context.Context, *QueryFeeBurnRequest) (*QueryFeeBurnResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Minter(ctx context.Context, req *QueryMinterRequest) (*QueryMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Minter not implemented")
+}
+func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflationRequest) (*QueryInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Inflation not implemented")
+}
+func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
+}
+func (*UnimplementedQueryServer) Phase(ctx context.Context, req *QueryPhaseRequest) (*QueryPhaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Phase not implemented")
+}
+func (*UnimplementedQueryServer) BlockProvision(ctx context.Context, req *QueryBlockProvisionRequest) (*QueryBlockProvisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockProvision not implemented")
+}
+func (*UnimplementedQueryServer) BlocksRemaining(ctx context.Context, req *QueryBlocksRemainingRequest) (*QueryBlocksRemainingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlocksRemaining not implemented")
+}
+func (*UnimplementedQueryServer) Projection(ctx context.Context, req *QueryProjectionRequest) (*QueryProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Projection not implemented")
+}
+func (*UnimplementedQueryServer) DeveloperRewards(ctx context.Context, req *QueryDeveloperRewardsRequest) (*QueryDeveloperRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeveloperRewards not implemented")
+}
+func (*UnimplementedQueryServer) AllDeveloperRewards(ctx context.Context, req *QueryAllDeveloperRewardsRequest) (*QueryAllDeveloperRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllDeveloperRewards not implemented")
+}
+func (*UnimplementedQueryServer) DeveloperVesting(ctx context.Context, req *QueryDeveloperVestingRequest) (*QueryDeveloperVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeveloperVesting not implemented")
+}
+func (*UnimplementedQueryServer) DistributionTotals(ctx context.Context, req *QueryDistributionTotalsRequest) (*QueryDistributionTotalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributionTotals not implemented")
+}
+func (*UnimplementedQueryServer) DistributionRecord(ctx context.Context, req *QueryDistributionRecordRequest) (*QueryDistributionRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributionRecord not implemented")
+}
+func (*UnimplementedQueryServer) Epoch(ctx context.Context, req *QueryEpochRequest) (*QueryEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Epoch not implemented")
+}
+func (*UnimplementedQueryServer) EcosystemPool(ctx context.Context, req *QueryEcosystemPoolRequest) (*QueryEcosystemPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EcosystemPool not implemented")
+}
+func (*UnimplementedQueryServer) Pause(ctx context.Context, req *QueryPauseRequest) (*QueryPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (*UnimplementedQueryServer) FeeBurn(ctx context.Context, req *QueryFeeBurnRequest) (*QueryFeeBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeBurn not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.mint.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Minter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Minter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.mint.Query/Minter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Minter(ctx, req.(*QueryMinterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Inflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Inflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.mint.Query/Inflation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Inflation(ctx, req.(*QueryInflationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAnnualProvisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AnnualProvisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.mint.Query/AnnualProvisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AnnualProvisions(ctx, req.(*QueryAnnualProvisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Phase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPhaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Phase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.mint.Query/Phase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Phase(ctx, req.(*QueryPhaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockProvision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockProvisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockProvision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.mint.Query/BlockProvision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockProvision(ctx, req.(*QueryBlockProvisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlocksRemaining_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlocksRemainingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlocksRemaining(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.mint.Query/BlocksRemaining",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlocksRemaining(ctx, req.(*QueryBlocksRemainingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Projection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Projection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.mint.Query/Projection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Projection(ctx, req.(*QueryProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeveloperRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeveloperRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeveloperRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.mint.Query/DeveloperRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeveloperRewards(ctx, req.(*QueryDeveloperRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllDeveloperRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDeveloperRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllDeveloperRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.mint.Query/AllDeveloperRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllDeveloperRewards(ctx, req.(*QueryAllDeveloperRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeveloperVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeveloperVestingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeveloperVesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.mint.Query/DeveloperVesting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeveloperVesting(ctx, req.(*QueryDeveloperVestingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DistributionTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDistributionTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DistributionTotals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.mint.Query/DistributionTotals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DistributionTotals(ctx, req.(*QueryDistributionTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DistributionRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDistributionRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DistributionRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.mint.Query/DistributionRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DistributionRecord(ctx, req.(*QueryDistributionRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Epoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Epoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.mint.Query/Epoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Epoch(ctx, req.(*QueryEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EcosystemPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEcosystemPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EcosystemPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.mint.Query/EcosystemPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EcosystemPool(ctx, req.(*QueryEcosystemPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.mint.Query/Pause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pause(ctx, req.(*QueryPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeBurn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeBurnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeBurn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.mint.Query/FeeBurn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeBurn(ctx, req.(*QueryFeeBurnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "galaxy.mint.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Minter",
+			Handler:    _Query_Minter_Handler,
+		},
+		{
+			MethodName: "Inflation",
+			Handler:    _Query_Inflation_Handler,
+		},
+		{
+			MethodName: "AnnualProvisions",
+			Handler:    _Query_AnnualProvisions_Handler,
+		},
+		{
+			MethodName: "Phase",
+			Handler:    _Query_Phase_Handler,
+		},
+		{
+			MethodName: "BlockProvision",
+			Handler:    _Query_BlockProvision_Handler,
+		},
+		{
+			MethodName: "BlocksRemaining",
+			Handler:    _Query_BlocksRemaining_Handler,
+		},
+		{
+			MethodName: "Projection",
+			Handler:    _Query_Projection_Handler,
+		},
+		{
+			MethodName: "DeveloperRewards",
+			Handler:    _Query_DeveloperRewards_Handler,
+		},
+		{
+			MethodName: "AllDeveloperRewards",
+			Handler:    _Query_AllDeveloperRewards_Handler,
+		},
+		{
+			MethodName: "DeveloperVesting",
+			Handler:    _Query_DeveloperVesting_Handler,
+		},
+		{
+			MethodName: "DistributionTotals",
+			Handler:    _Query_DistributionTotals_Handler,
+		},
+		{
+			MethodName: "DistributionRecord",
+			Handler:    _Query_DistributionRecord_Handler,
+		},
+		{
+			MethodName: "Epoch",
+			Handler:    _Query_Epoch_Handler,
+		},
+		{
+			MethodName: "EcosystemPool",
+			Handler:    _Query_EcosystemPool_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _Query_Pause_Handler,
+		},
+		{
+			MethodName: "FeeBurn",
+			Handler:    _Query_FeeBurn_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "galaxy/mint/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Minter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnualProvisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnualProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnualProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnualProvisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnualProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPhaseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPhaseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPhaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPhaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPhaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPhaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintQuery(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Phase != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockProvisionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockProvisionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockProvisionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockProvisionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockProvisionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockProvisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Distribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BlockProvision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlocksRemainingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlocksRemainingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlocksRemainingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlocksRemainingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlocksRemainingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlocksRemainingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeRemaining != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TimeRemaining, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TimeRemaining):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintQuery(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlocksRemaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksRemaining))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeveloperRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeveloperRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeveloperRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeveloperRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeveloperRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeveloperRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Withdrawable) > 0 {
+		for iNdEx := len(m.Withdrawable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.DeveloperRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDeveloperRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDeveloperRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDeveloperRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDeveloperRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDeveloperRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDeveloperRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DeveloperRewards) > 0 {
+		for iNdEx := len(m.DeveloperRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeveloperRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeveloperVestingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeveloperVestingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeveloperVestingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeveloperVestingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeveloperVestingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeveloperVestingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Locked) > 0 {
+		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Vested) > 0 {
+		for iNdEx := len(m.Vested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Vesting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionTotalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionTotalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionTotalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionTotalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionTotalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionTotalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Phases) > 0 {
+		for iNdEx := len(m.Phases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Phases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Phase != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DistributionRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != nil {
+		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintQuery(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Epoch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEcosystemPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEcosystemPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEcosystemPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEcosystemPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEcosystemPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEcosystemPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		for iNdEx := len(m.Pool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PausePolicy != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PausePolicy))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Pause.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeBurnRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeBurnRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeBurnRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeBurnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeBurnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeBurnRatio.Size()
+		i -= size
+		if _, err := m.FeeBurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.FeeBurn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for iNdEx := len(m.Projections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PhaseProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PhaseProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PhaseProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NetInflation.Size()
+		i -= size
+		if _, err := m.NetInflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Burned.Size()
+		i -= size
+		if _, err := m.Burned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.EndTotalSupply.Size()
+		i -= size
+		if _, err := m.EndTotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.BlockProvision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Phase != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMinterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMinterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Minter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInflationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAnnualProvisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAnnualProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPhaseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPhaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Phase != 0 {
+		n += 1 + sovQuery(uint64(m.Phase))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockProvisionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlockProvisionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BlockProvision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Distribution.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlocksRemainingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlocksRemainingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlocksRemaining != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksRemaining))
+	}
+	if m.TimeRemaining != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TimeRemaining)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeveloperRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeveloperRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DeveloperRewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Withdrawable) > 0 {
+		for _, e := range m.Withdrawable {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllDeveloperRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDeveloperRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DeveloperRewards) > 0 {
+		for _, e := range m.DeveloperRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeveloperVestingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeveloperVestingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Vesting.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Vested) > 0 {
+		for _, e := range m.Vested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Locked) > 0 {
+		for _, e := range m.Locked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDistributionTotalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDistributionTotalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Phases) > 0 {
+		for _, e := range m.Phases {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDistributionRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Phase != 0 {
+		n += 1 + sovQuery(uint64(m.Phase))
+	}
+	return n
+}
+
+func (m *QueryDistributionRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DistributionRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEpochRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Epoch.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEcosystemPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEcosystemPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		for _, e := range m.Pool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPauseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pause.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PausePolicy != 0 {
+		n += 1 + sovQuery(uint64(m.PausePolicy))
+	}
+	return n
+}
+
+func (m *QueryFeeBurnRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeBurnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeBurn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FeeBurnRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for _, e := range m.Projections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PhaseProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Phase != 0 {
+		n += 1 + sovQuery(uint64(m.Phase))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BlockProvision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EndTotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Burned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NetInflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPhaseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPhaseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPhaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPhaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPhaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPhaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockProvisionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockProvisionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockProvisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockProvisionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockProvisionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockProvisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProvision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Distribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlocksRemainingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlocksRemainingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlocksRemainingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlocksRemainingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlocksRemainingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlocksRemainingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksRemaining", wireType)
+			}
+			m.BlocksRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksRemaining |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeRemaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimeRemaining == nil {
+				m.TimeRemaining = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.TimeRemaining, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeveloperRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeveloperRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeveloperRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeveloperRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeveloperRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeveloperRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeveloperRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawable = append(m.Withdrawable, types1.Coin{})
+			if err := m.Withdrawable[len(m.Withdrawable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDeveloperRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDeveloperRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDeveloperRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDeveloperRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDeveloperRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDeveloperRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeveloperRewards = append(m.DeveloperRewards, DeveloperRewards{})
+			if err := m.DeveloperRewards[len(m.DeveloperRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeveloperVestingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeveloperVestingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeveloperVestingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeveloperVestingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeveloperVestingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeveloperVestingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vested = append(m.Vested, types1.Coin{})
+			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locked = append(m.Locked, types1.Coin{})
+			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionTotalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionTotalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionTotalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionTotalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionTotalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionTotalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phases = append(m.Phases, DistributionRecord{})
+			if err := m.Phases[len(m.Phases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributionRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEcosystemPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEcosystemPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEcosystemPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEcosystemPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEcosystemPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEcosystemPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = append(m.Pool, types1.Coin{})
+			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPauseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPauseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPauseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPauseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPauseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausePolicy", wireType)
+			}
+			m.PausePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PausePolicy |= PausePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeBurnRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeBurnRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeBurnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeBurnResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeBurnResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeBurn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryMinterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Minter.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProjectionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -533,10 +6860,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProjectionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -560,7 +6887,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProjectionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -583,15 +6910,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProjectionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Projections", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -618,7 +6945,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Projections = append(m.Projections, PhaseProjection{})
+			if err := m.Projections[len(m.Projections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -643,7 +6971,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMinterRequest) Unmarshal(dAtA []byte) error {
+func (m *PhaseProjection) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -666,65 +6994,102 @@ func (m *QueryMinterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMinterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PhaseProjection: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMinterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PhaseProjection: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryMinterResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMinterResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProvision", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -751,7 +7116,109 @@ func (m *QueryMinterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Minter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BlockProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetInflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,3524 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: galaxy/mint/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address allowed to update the module parameters.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the full set of mint parameters to apply.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e2ab1b3a62482ab, []int{0}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e2ab1b3a62482ab, []int{1}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgWithdrawDeveloperRewards is the Msg/WithdrawDeveloperRewards request type.
+type MsgWithdrawDeveloperRewards struct {
+	// receiver is the developer rewards receiver withdrawing its rewards.
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+}
+
+func (m *MsgWithdrawDeveloperRewards) Reset()         { *m = MsgWithdrawDeveloperRewards{} }
+func (m *MsgWithdrawDeveloperRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawDeveloperRewards) ProtoMessage()    {}
+func (*MsgWithdrawDeveloperRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e2ab1b3a62482ab, []int{2}
+}
+func (m *MsgWithdrawDeveloperRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawDeveloperRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawDeveloperRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawDeveloperRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawDeveloperRewards.Merge(m, src)
+}
+func (m *MsgWithdrawDeveloperRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawDeveloperRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawDeveloperRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawDeveloperRewards proto.InternalMessageInfo
+
+func (m *MsgWithdrawDeveloperRewards) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+// MsgWithdrawDeveloperRewardsResponse defines the response structure for
+// executing a MsgWithdrawDeveloperRewards message.
+type MsgWithdrawDeveloperRewardsResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawDeveloperRewardsResponse) Reset()         { *m = MsgWithdrawDeveloperRewardsResponse{} }
+func (m *MsgWithdrawDeveloperRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawDeveloperRewardsResponse) ProtoMessage()    {}
+func (*MsgWithdrawDeveloperRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e2ab1b3a62482ab, []int{3}
+}
+func (m *MsgWithdrawDeveloperRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawDeveloperRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawDeveloperRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawDeveloperRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawDeveloperRewardsResponse.Merge(m, src)
+}
+func (m *MsgWithdrawDeveloperRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawDeveloperRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawDeveloperRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawDeveloperRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawDeveloperRewardsResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgClawbackDeveloperRewards is the Msg/ClawbackDeveloperRewards request type.
+type MsgClawbackDeveloperRewards struct {
+	// authority is the address allowed to claw back locked rewards.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// receiver is the developer rewards receiver whose locked rewards are clawed
+	// back.
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+}
+
+func (m *MsgClawbackDeveloperRewards) Reset()         { *m = MsgClawbackDeveloperRewards{} }
+func (m *MsgClawbackDeveloperRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClawbackDeveloperRewards) ProtoMessage()    {}
+func (*MsgClawbackDeveloperRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e2ab1b3a62482ab, []int{4}
+}
+func (m *MsgClawbackDeveloperRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawbackDeveloperRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawbackDeveloperRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawbackDeveloperRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawbackDeveloperRewards.Merge(m, src)
+}
+func (m *MsgClawbackDeveloperRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawbackDeveloperRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawbackDeveloperRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawbackDeveloperRewards proto.InternalMessageInfo
+
+func (m *MsgClawbackDeveloperRewards) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgClawbackDeveloperRewards) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+// MsgClawbackDeveloperRewardsResponse defines the response structure for
+// executing a MsgClawbackDeveloperRewards message.
+type MsgClawbackDeveloperRewardsResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgClawbackDeveloperRewardsResponse) Reset()         { *m = MsgClawbackDeveloperRewardsResponse{} }
+func (m *MsgClawbackDeveloperRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClawbackDeveloperRewardsResponse) ProtoMessage()    {}
+func (*MsgClawbackDeveloperRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e2ab1b3a62482ab, []int{5}
+}
+func (m *MsgClawbackDeveloperRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawbackDeveloperRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawbackDeveloperRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawbackDeveloperRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawbackDeveloperRewardsResponse.Merge(m, src)
+}
+func (m *MsgClawbackDeveloperRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawbackDeveloperRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawbackDeveloperRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawbackDeveloperRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgClawbackDeveloperRewardsResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgAddDeveloperReceiver is the Msg/AddDeveloperReceiver request type.
+type MsgAddDeveloperReceiver struct {
+	// authority is the address allowed to update the module parameters.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// receiver is the receiver to add with its weight and vesting.
+	Receiver DevloperWeightedAddress `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver"`
+}
+
+func (m *MsgAddDeveloperReceiver) Reset()         { *m = MsgAddDeveloperReceiver{} }
+func (m *MsgAddDeveloperReceiver) String() string { return proto.CompactTextString(m) }
+func (*MsgAddDeveloperReceiver) ProtoMessage()    {}
+func (*MsgAddDeveloperReceiver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e2ab1b3a62482ab, []int{6}
+}
+func (m *MsgAddDeveloperReceiver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddDeveloperReceiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddDeveloperReceiver.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddDeveloperReceiver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddDeveloperReceiver.Merge(m, src)
+}
+func (m *MsgAddDeveloperReceiver) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddDeveloperReceiver) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddDeveloperReceiver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddDeveloperReceiver proto.InternalMessageInfo
+
+func (m *MsgAddDeveloperReceiver) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgAddDeveloperReceiver) GetReceiver() DevloperWeightedAddress {
+	if m != nil {
+		return m.Receiver
+	}
+	return DevloperWeightedAddress{}
+}
+
+// MsgAddDeveloperReceiverResponse defines the response structure for
+// executing a MsgAddDeveloperReceiver message.
+type MsgAddDeveloperReceiverResponse struct {
+}
+
+func (m *MsgAddDeveloperReceiverResponse) Reset()         { *m = MsgAddDeveloperReceiverResponse{} }
+func (m *MsgAddDeveloperReceiverResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddDeveloperReceiverResponse) ProtoMessage()    {}
+func (*MsgAddDeveloperReceiverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e2ab1b3a62482ab, []int{7}
+}
+func (m *MsgAddDeveloperReceiverResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddDeveloperReceiverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddDeveloperReceiverResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddDeveloperReceiverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddDeveloperReceiverResponse.Merge(m, src)
+}
+func (m *MsgAddDeveloperReceiverResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddDeveloperReceiverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddDeveloperReceiverResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddDeveloperReceiverResponse proto.InternalMessageInfo
+
+// MsgRemoveDeveloperReceiver is the Msg/RemoveDeveloperReceiver request type.
+type MsgRemoveDeveloperReceiver struct {
+	// authority is the address allowed to update the module parameters.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// address is the receiver to remove.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// redistribution defines where the weight of the receiver goes.
+	Redistribution WeightRedistribution `protobuf:"varint,3,opt,name=redistribution,proto3,enum=galaxy.mint.WeightRedistribution" json:"redistribution,omitempty"`
+}
+
+func (m *MsgRemoveDeveloperReceiver) Reset()         { *m = MsgRemoveDeveloperReceiver{} }
+func (m *MsgRemoveDeveloperReceiver) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveDeveloperReceiver) ProtoMessage()    {}
+func (*MsgRemoveDeveloperReceiver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e2ab1b3a62482ab, []int{8}
+}
+func (m *MsgRemoveDeveloperReceiver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveDeveloperReceiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveDeveloperReceiver.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveDeveloperReceiver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveDeveloperReceiver.Merge(m, src)
+}
+func (m *MsgRemoveDeveloperReceiver) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveDeveloperReceiver) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveDeveloperReceiver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveDeveloperReceiver proto.InternalMessageInfo
+
+func (m *MsgRemoveDeveloperReceiver) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveDeveloperReceiver) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgRemoveDeveloperReceiver) GetRedistribution() WeightRedistribution {
+	if m != nil {
+		return m.Redistribution
+	}
+	return RedistributeToReceivers
+}
+
+// MsgRemoveDeveloperReceiverResponse defines the response structure for
+// executing a MsgRemoveDeveloperReceiver message.
+type MsgRemoveDeveloperReceiverResponse struct {
+}
+
+func (m *MsgRemoveDeveloperReceiverResponse) Reset()         { *m = MsgRemoveDeveloperReceiverResponse{} }
+func (m *MsgRemoveDeveloperReceiverResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveDeveloperReceiverResponse) ProtoMessage()    {}
+func (*MsgRemoveDeveloperReceiverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e2ab1b3a62482ab, []int{9}
+}
+func (m *MsgRemoveDeveloperReceiverResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveDeveloperReceiverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveDeveloperReceiverResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveDeveloperReceiverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveDeveloperReceiverResponse.Merge(m, src)
+}
+func (m *MsgRemoveDeveloperReceiverResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveDeveloperReceiverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveDeveloperReceiverResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveDeveloperReceiverResponse proto.InternalMessageInfo
+
+// MsgReplaceDeveloperReceiver is the Msg/ReplaceDeveloperReceiver request
+// type.
+type MsgReplaceDeveloperReceiver struct {
+	// authority is the address allowed to update the module parameters.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// address is the receiver to replace.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// new_address is the receiver taking over the weight and vesting.
+	NewAddress string `protobuf:"bytes,3,opt,name=new_address,json=newAddress,proto3" json:"new_address,omitempty"`
+}
+
+func (m *MsgReplaceDeveloperReceiver) Reset()         { *m = MsgReplaceDeveloperReceiver{} }
+func (m *MsgReplaceDeveloperReceiver) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceDeveloperReceiver) ProtoMessage()    {}
+func (*MsgReplaceDeveloperReceiver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e2ab1b3a62482ab, []int{10}
+}
+func (m *MsgReplaceDeveloperReceiver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReplaceDeveloperReceiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplaceDeveloperReceiver.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReplaceDeveloperReceiver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceDeveloperReceiver.Merge(m, src)
+}
+func (m *MsgReplaceDeveloperReceiver) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReplaceDeveloperReceiver) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceDeveloperReceiver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReplaceDeveloperReceiver proto.InternalMessageInfo
+
+func (m *MsgReplaceDeveloperReceiver) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgReplaceDeveloperReceiver) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgReplaceDeveloperReceiver) GetNewAddress() string {
+	if m != nil {
+		return m.NewAddress
+	}
+	return ""
+}
+
+// MsgReplaceDeveloperReceiverResponse defines the response structure for
+// executing a MsgReplaceDeveloperReceiver message.
+type MsgReplaceDeveloperReceiverResponse struct {
+}
+
+func (m *MsgReplaceDeveloperReceiverResponse) Reset()         { *m = MsgReplaceDeveloperReceiverResponse{} }
+func (m *MsgReplaceDeveloperReceiverResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceDeveloperReceiverResponse) ProtoMessage()    {}
+func (*MsgReplaceDeveloperReceiverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e2ab1b3a62482ab, []int{11}
+}
+func (m *MsgReplaceDeveloperReceiverResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReplaceDeveloperReceiverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplaceDeveloperReceiverResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReplaceDeveloperReceiverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceDeveloperReceiverResponse.Merge(m, src)
+}
+func (m *MsgReplaceDeveloperReceiverResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReplaceDeveloperReceiverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceDeveloperReceiverResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReplaceDeveloperReceiverResponse proto.InternalMessageInfo
+
+// MsgReweightDeveloperReceiver is the Msg/ReweightDeveloperReceiver request
+// type.
+type MsgReweightDeveloperReceiver struct {
+	// authority is the address allowed to update the module parameters.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// address is the receiver to reweight.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// weight is the new weight of the receiver.
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *MsgReweightDeveloperReceiver) Reset()         { *m = MsgReweightDeveloperReceiver{} }
+func (m *MsgReweightDeveloperReceiver) String() string { return proto.CompactTextString(m) }
+func (*MsgReweightDeveloperReceiver) ProtoMessage()    {}
+func (*MsgReweightDeveloperReceiver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e2ab1b3a62482ab, []int{12}
+}
+func (m *MsgReweightDeveloperReceiver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReweightDeveloperReceiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReweightDeveloperReceiver.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReweightDeveloperReceiver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReweightDeveloperReceiver.Merge(m, src)
+}
+func (m *MsgReweightDeveloperReceiver) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReweightDeveloperReceiver) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReweightDeveloperReceiver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReweightDeveloperReceiver proto.InternalMessageInfo
+
+func (m *MsgReweightDeveloperReceiver) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgReweightDeveloperReceiver) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// MsgReweightDeveloperReceiverResponse defines the response structure for
+// executing a MsgReweightDeveloperReceiver message.
+type MsgReweightDeveloperReceiverResponse struct {
+}
+
+func (m *MsgReweightDeveloperReceiverResponse) Reset()         { *m = MsgReweightDeveloperReceiverResponse{} }
+func (m *MsgReweightDeveloperReceiverResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReweightDeveloperReceiverResponse) ProtoMessage()    {}
+func (*MsgReweightDeveloperReceiverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e2ab1b3a62482ab, []int{13}
+}
+func (m *MsgReweightDeveloperReceiverResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReweightDeveloperReceiverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReweightDeveloperReceiverResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReweightDeveloperReceiverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReweightDeveloperReceiverResponse.Merge(m, src)
+}
+func (m *MsgReweightDeveloperReceiverResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReweightDeveloperReceiverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReweightDeveloperReceiverResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReweightDeveloperReceiverResponse proto.InternalMessageInfo
+
+// MsgSetPaused is the Msg/SetPaused request type.
+type MsgSetPaused struct {
+	// authority is the module authority, or the guardian to pause.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// paused is true to pause minting and false to resume it.
+	Paused bool `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *MsgSetPaused) Reset()         { *m = MsgSetPaused{} }
+func (m *MsgSetPaused) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPaused) ProtoMessage()    {}
+func (*MsgSetPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e2ab1b3a62482ab, []int{14}
+}
+func (m *MsgSetPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPaused.Merge(m, src)
+}
+func (m *MsgSetPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPaused proto.InternalMessageInfo
+
+func (m *MsgSetPaused) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetPaused) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+// MsgSetPausedResponse defines the response structure for executing a
+// MsgSetPaused message.
+type MsgSetPausedResponse struct {
+}
+
+func (m *MsgSetPausedResponse) Reset()         { *m = MsgSetPausedResponse{} }
+func (m *MsgSetPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPausedResponse) ProtoMessage()    {}
+func (*MsgSetPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e2ab1b3a62482ab, []int{15}
+}
+func (m *MsgSetPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPausedResponse.Merge(m, src)
+}
+func (m *MsgSetPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPausedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPausedResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "galaxy.mint.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "galaxy.mint.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgWithdrawDeveloperRewards)(nil), "galaxy.mint.MsgWithdrawDeveloperRewards")
+	proto.RegisterType((*MsgWithdrawDeveloperRewardsResponse)(nil), "galaxy.mint.MsgWithdrawDeveloperRewardsResponse")
+	proto.RegisterType((*MsgClawbackDeveloperRewards)(nil), "galaxy.mint.MsgClawbackDeveloperRewards")
+	proto.RegisterType((*MsgClawbackDeveloperRewardsResponse)(nil), "galaxy.mint.MsgClawbackDeveloperRewardsResponse")
+	proto.RegisterType((*MsgAddDeveloperReceiver)(nil), "galaxy.mint.MsgAddDeveloperReceiver")
+	proto.RegisterType((*MsgAddDeveloperReceiverResponse)(nil), "galaxy.mint.MsgAddDeveloperReceiverResponse")
+	proto.RegisterType((*MsgRemoveDeveloperReceiver)(nil), "galaxy.mint.MsgRemoveDeveloperReceiver")
+	proto.RegisterType((*MsgRemoveDeveloperReceiverResponse)(nil), "galaxy.mint.MsgRemoveDeveloperReceiverResponse")
+	proto.RegisterType((*MsgReplaceDeveloperReceiver)(nil), "galaxy.mint.MsgReplaceDeveloperReceiver")
+	proto.RegisterType((*MsgReplaceDeveloperReceiverResponse)(nil), "galaxy.mint.MsgReplaceDeveloperReceiverResponse")
+	proto.RegisterType((*MsgReweightDeveloperReceiver)(nil), "galaxy.mint.MsgReweightDeveloperReceiver")
+	proto.RegisterType((*MsgReweightDeveloperReceiverResponse)(nil), "galaxy.mint.MsgReweightDeveloperReceiverResponse")
+	proto.RegisterType((*MsgSetPaused)(nil), "galaxy.mint.MsgSetPaused")
+	proto.RegisterType((*MsgSetPausedResponse)(nil), "galaxy.mint.MsgSetPausedResponse")
+}
+
+func init() { proto.RegisterFile("galaxy/mint/tx.proto", fileDescriptor_4e2ab1b3a62482ab) }
+
+var fileDescriptor_4e2ab1b3a62482ab = []byte{
+	// 750 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4d, 0x6f, 0xda, 0x4a,
+	0x14, 0xc5, 0xe1, 0x89, 0x97, 0x5c, 0xa2, 0x3c, 0x89, 0x87, 0x12, 0x70, 0x23, 0x20, 0x0e, 0x4d,
+	0xa9, 0x94, 0xda, 0x40, 0x57, 0x5d, 0x86, 0xa0, 0x48, 0x59, 0x20, 0x45, 0xae, 0xaa, 0x48, 0xdd,
+	0x54, 0x63, 0x7b, 0x64, 0xdc, 0x80, 0xc7, 0xf2, 0x0c, 0x76, 0x58, 0x75, 0xdf, 0x55, 0x7f, 0x41,
+	0xbb, 0xef, 0x2f, 0xc9, 0x32, 0xcb, 0xaa, 0x8b, 0xb4, 0x4a, 0x36, 0xfd, 0x19, 0x95, 0x3f, 0x70,
+	0x8c, 0xc1, 0xc4, 0x95, 0xa2, 0x6e, 0xc0, 0x33, 0xf7, 0xcc, 0xb9, 0xe7, 0x5c, 0xdf, 0xb9, 0x32,
+	0x94, 0x75, 0x34, 0x42, 0x97, 0x53, 0x69, 0x6c, 0x98, 0x4c, 0x62, 0x97, 0xa2, 0x65, 0x13, 0x46,
+	0x4a, 0xc5, 0x60, 0x57, 0xf4, 0x76, 0xf9, 0xb2, 0x4e, 0x74, 0xe2, 0xef, 0x4b, 0xde, 0x53, 0x00,
+	0xe1, 0x2b, 0xf1, 0x83, 0x16, 0xb2, 0xd1, 0x98, 0x86, 0x91, 0xed, 0x78, 0xc4, 0xfb, 0x09, 0xf7,
+	0x6b, 0x2a, 0xa1, 0x63, 0x42, 0x25, 0x05, 0x51, 0x2c, 0x39, 0x1d, 0x05, 0x33, 0xd4, 0x91, 0x54,
+	0x62, 0x98, 0x41, 0x5c, 0x50, 0xe0, 0xbf, 0x01, 0xd5, 0xdf, 0x58, 0x1a, 0x62, 0xf8, 0xcc, 0x27,
+	0x2c, 0xed, 0xc2, 0x06, 0x9a, 0xb0, 0x21, 0xb1, 0x0d, 0x36, 0xad, 0x70, 0x0d, 0xae, 0xb5, 0x21,
+	0xdf, 0x6f, 0x94, 0x3a, 0x50, 0x08, 0x12, 0x57, 0xd6, 0x1a, 0x5c, 0xab, 0xd8, 0xfd, 0x5f, 0x8c,
+	0xc9, 0x16, 0x03, 0x8a, 0xde, 0x3f, 0x57, 0x37, 0xf5, 0x9c, 0x1c, 0x02, 0x85, 0x2a, 0xec, 0x24,
+	0x72, 0xc8, 0x98, 0x5a, 0xc4, 0xa4, 0x58, 0x78, 0x05, 0x4f, 0x06, 0x54, 0x3f, 0x37, 0xd8, 0x50,
+	0xb3, 0x91, 0xdb, 0xc7, 0x0e, 0x1e, 0x11, 0x0b, 0xdb, 0x32, 0x76, 0x91, 0xad, 0xd1, 0x12, 0x0f,
+	0xeb, 0x36, 0x56, 0xb1, 0xe1, 0x60, 0x3b, 0x54, 0x12, 0xad, 0x85, 0x8f, 0x1c, 0xec, 0xaf, 0x38,
+	0x3b, 0x4b, 0x51, 0x52, 0xa1, 0x80, 0xc6, 0x64, 0x62, 0xb2, 0x0a, 0xd7, 0xc8, 0xb7, 0x8a, 0xdd,
+	0xaa, 0x18, 0x94, 0x44, 0xf4, 0x4a, 0x22, 0x86, 0x25, 0x11, 0x8f, 0x89, 0x61, 0xf6, 0xda, 0x9e,
+	0xec, 0xaf, 0x3f, 0xea, 0x2d, 0xdd, 0x60, 0xc3, 0x89, 0x22, 0xaa, 0x64, 0x2c, 0x85, 0xf5, 0x0b,
+	0xfe, 0x5e, 0x50, 0xed, 0x42, 0x62, 0x53, 0x0b, 0x53, 0xff, 0x00, 0x95, 0x43, 0x6a, 0xe1, 0xdc,
+	0xf7, 0x71, 0x3c, 0x42, 0xae, 0x82, 0xd4, 0x8b, 0x05, 0x1f, 0xab, 0x4b, 0x1a, 0x77, 0xb9, 0xb6,
+	0xdc, 0x65, 0x1a, 0xf3, 0xdf, 0x75, 0xf9, 0xc1, 0x7f, 0x91, 0x47, 0x9a, 0x16, 0x93, 0x11, 0xe8,
+	0x7c, 0xc0, 0xe1, 0x49, 0xc2, 0x61, 0xb1, 0xdb, 0x9c, 0x6b, 0x9b, 0x3e, 0x76, 0x7c, 0xba, 0x73,
+	0x6c, 0xe8, 0x43, 0x86, 0xb5, 0x23, 0x4d, 0xb3, 0x31, 0x9d, 0xf5, 0xd1, 0x7d, 0x35, 0xf6, 0xa0,
+	0x9e, 0x22, 0x20, 0xea, 0xa8, 0x2f, 0x1c, 0xf0, 0x03, 0xaa, 0xcb, 0x78, 0x4c, 0x1c, 0xfc, 0xa7,
+	0x3a, 0x2b, 0xf0, 0x2f, 0x0a, 0x52, 0x87, 0x2f, 0x62, 0xb6, 0x2c, 0x9d, 0xc2, 0x96, 0x8d, 0x35,
+	0x83, 0x32, 0xdb, 0x50, 0x26, 0xcc, 0x20, 0x66, 0x25, 0xdf, 0xe0, 0x5a, 0x5b, 0xdd, 0xbd, 0x39,
+	0x1f, 0x81, 0x7e, 0x79, 0x0e, 0x28, 0x27, 0x0e, 0x0a, 0x4d, 0x10, 0xd2, 0x05, 0x46, 0x3e, 0x1c,
+	0xbf, 0xa3, 0x64, 0x6c, 0x8d, 0x90, 0xfa, 0x88, 0x3e, 0xea, 0x50, 0x34, 0xb1, 0xfb, 0x6e, 0x16,
+	0xcd, 0xfb, 0x51, 0x30, 0xb1, 0x1b, 0x96, 0x5c, 0x78, 0x0a, 0xfb, 0x2b, 0xf2, 0x46, 0xf2, 0x3e,
+	0x73, 0xb0, 0xeb, 0xe3, 0x5c, 0xdf, 0xf2, 0xe3, 0x09, 0x3c, 0x81, 0x42, 0x40, 0x19, 0x68, 0xeb,
+	0x89, 0x5e, 0x0b, 0x7c, 0xbf, 0xa9, 0x1f, 0x64, 0xe8, 0xd6, 0x3e, 0x56, 0xe5, 0xf0, 0xb4, 0x70,
+	0x00, 0xcd, 0x55, 0xfa, 0x22, 0x23, 0x7d, 0xd8, 0x1c, 0x50, 0xfd, 0x35, 0x66, 0x67, 0x68, 0x42,
+	0xb1, 0xf6, 0x80, 0xee, 0x6d, 0x6f, 0xfa, 0x79, 0x38, 0x5f, 0xf6, 0xba, 0x1c, 0xae, 0x84, 0x6d,
+	0x28, 0xc7, 0x59, 0x66, 0xec, 0xdd, 0x5f, 0x05, 0xc8, 0x0f, 0xa8, 0x5e, 0x92, 0x61, 0x73, 0x7e,
+	0xc6, 0xce, 0xb5, 0x4d, 0x62, 0x3a, 0xf2, 0xcd, 0x55, 0xd1, 0xe8, 0xca, 0x3b, 0x50, 0x49, 0x1d,
+	0x9c, 0xad, 0x24, 0x43, 0x1a, 0x92, 0x6f, 0x67, 0x45, 0xc6, 0xf3, 0xa6, 0x0e, 0xba, 0x85, 0xbc,
+	0x69, 0x48, 0xbe, 0x9d, 0x15, 0x19, 0xe5, 0x7d, 0x0f, 0xe5, 0xa5, 0xa3, 0x67, 0xa1, 0x5a, 0xcb,
+	0x50, 0xfc, 0x61, 0x16, 0x54, 0x94, 0x8b, 0xc2, 0x4e, 0xda, 0x04, 0x79, 0x96, 0x24, 0x4a, 0x01,
+	0xf2, 0x52, 0x46, 0x60, 0xbc, 0xb0, 0xa9, 0xf7, 0xbd, 0xb5, 0x48, 0xb6, 0x1c, 0xc9, 0xb7, 0xb3,
+	0x22, 0xa3, 0xbc, 0x53, 0xa8, 0xa6, 0xdf, 0xe3, 0xe7, 0x8b, 0x74, 0x29, 0x50, 0xbe, 0x93, 0x19,
+	0x1a, 0xa5, 0x3e, 0x85, 0x8d, 0xfb, 0xab, 0x57, 0x4d, 0x9e, 0x8f, 0x42, 0xfc, 0x5e, 0x6a, 0x68,
+	0x46, 0xd5, 0x3b, 0xb9, 0xba, 0xad, 0x71, 0xd7, 0xb7, 0x35, 0xee, 0xe7, 0x6d, 0x8d, 0xfb, 0x74,
+	0x57, 0xcb, 0x5d, 0xdf, 0xd5, 0x72, 0xdf, 0xee, 0x6a, 0xb9, 0xb7, 0x87, 0xb1, 0xd1, 0x11, 0xd0,
+	0x98, 0x98, 0xb9, 0xc4, 0xbe, 0x08, 0x57, 0xd2, 0x65, 0xf8, 0x25, 0xe6, 0x0d, 0x11, 0xa5, 0xe0,
+	0x7f, 0x18, 0xbd, 0xfc, 0x3d, 0x00, 0x40, 0x91, 0xc4, 0x02, 0xa5, 0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams updates the mint module parameters. It must be signed by the
+	// module authority (the gov module account by default).
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// WithdrawDeveloperRewards withdraws the escrowed developer rewards of the
+	// receiver.
+	WithdrawDeveloperRewards(ctx context.Context, in *MsgWithdrawDeveloperRewards, opts ...grpc.CallOption) (*MsgWithdrawDeveloperRewardsResponse, error)
+	// ClawbackDeveloperRewards sends the locked developer rewards of a receiver
+	// to the community pool. It must be signed by the module authority.
+	ClawbackDeveloperRewards(ctx context.Context, in *MsgClawbackDeveloperRewards, opts ...grpc.CallOption) (*MsgClawbackDeveloperRewardsResponse, error)
+	// AddDeveloperReceiver adds a developer rewards receiver, scaling down the
+	// weights of the other receivers proportionally. It must be signed by the
+	// module authority.
+	AddDeveloperReceiver(ctx context.Context, in *MsgAddDeveloperReceiver, opts ...grpc.CallOption) (*MsgAddDeveloperReceiverResponse, error)
+	// RemoveDeveloperReceiver removes a developer rewards receiver. It must be
+	// signed by the module authority.
+	RemoveDeveloperReceiver(ctx context.Context, in *MsgRemoveDeveloperReceiver, opts ...grpc.CallOption) (*MsgRemoveDeveloperReceiverResponse, error)
+	// ReplaceDeveloperReceiver replaces the address of a developer rewards
+	// receiver, keeping its weight and vesting. It must be signed by the module
+	// authority.
+	ReplaceDeveloperReceiver(ctx context.Context, in *MsgReplaceDeveloperReceiver, opts ...grpc.CallOption) (*MsgReplaceDeveloperReceiverResponse, error)
+	// ReweightDeveloperReceiver sets the weight of a developer rewards receiver,
+	// scaling the weights of the other receivers proportionally. It must be
+	// signed by the module authority.
+	ReweightDeveloperReceiver(ctx context.Context, in *MsgReweightDeveloperReceiver, opts ...grpc.CallOption) (*MsgReweightDeveloperReceiverResponse, error)
+	// SetPaused pauses or resumes minting. It must be signed by the module
+	// authority, or by the guardian to pause.
+	SetPaused(ctx context.Context, in *MsgSetPaused, opts ...grpc.CallOption) (*MsgSetPausedResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.mint.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawDeveloperRewards(ctx context.Context, in *MsgWithdrawDeveloperRewards, opts ...grpc.CallOption) (*MsgWithdrawDeveloperRewardsResponse, error) {
+	out := new(MsgWithdrawDeveloperRewardsResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.mint.Msg/WithdrawDeveloperRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClawbackDeveloperRewards(ctx context.Context, in *MsgClawbackDeveloperRewards, opts ...grpc.CallOption) (*MsgClawbackDeveloperRewardsResponse, error) {
+	out := new(MsgClawbackDeveloperRewardsResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.mint.Msg/ClawbackDeveloperRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddDeveloperReceiver(ctx context.Context, in *MsgAddDeveloperReceiver, opts ...grpc.CallOption) (*MsgAddDeveloperReceiverResponse, error) {
+	out := new(MsgAddDeveloperReceiverResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.mint.Msg/AddDeveloperReceiver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveDeveloperReceiver(ctx context.Context, in *MsgRemoveDeveloperReceiver, opts ...grpc.CallOption) (*MsgRemoveDeveloperReceiverResponse, error) {
+	out := new(MsgRemoveDeveloperReceiverResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.mint.Msg/RemoveDeveloperReceiver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReplaceDeveloperReceiver(ctx context.Context, in *MsgReplaceDeveloperReceiver, opts ...grpc.CallOption) (*MsgReplaceDeveloperReceiverResponse, error) {
+	out := new(MsgReplaceDeveloperReceiverResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.mint.Msg/ReplaceDeveloperReceiver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReweightDeveloperReceiver(ctx context.Context, in *MsgReweightDeveloperReceiver, opts ...grpc.CallOption) (*MsgReweightDeveloperReceiverResponse, error) {
+	out := new(MsgReweightDeveloperReceiverResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.mint.Msg/ReweightDeveloperReceiver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPaused(ctx context.Context, in *MsgSetPaused, opts ...grpc.CallOption) (*MsgSetPausedResponse, error) {
+	out := new(MsgSetPausedResponse)
+	err := c.cc.Invoke(ctx, "/galaxy.mint.Msg/SetPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams updates the mint module parameters. It must be signed by the
+	// module authority (the gov module account by default).
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// WithdrawDeveloperRewards withdraws the escrowed developer rewards of the
+	// receiver.
+	WithdrawDeveloperRewards(context.Context, *MsgWithdrawDeveloperRewards) (*MsgWithdrawDeveloperRewardsResponse, error)
+	// ClawbackDeveloperRewards sends the locked developer rewards of a receiver
+	// to the community pool. It must be signed by the module authority.
+	ClawbackDeveloperRewards(context.Context, *MsgClawbackDeveloperRewards) (*MsgClawbackDeveloperRewardsResponse, error)
+	// AddDeveloperReceiver adds a developer rewards receiver, scaling down the
+	// weights of the other receivers proportionally. It must be signed by the
+	// module authority.
+	AddDeveloperReceiver(context.Context, *MsgAddDeveloperReceiver) (*MsgAddDeveloperReceiverResponse, error)
+	// RemoveDeveloperReceiver removes a developer rewards receiver. It must be
+	// signed by the module authority.
+	RemoveDeveloperReceiver(context.Context, *MsgRemoveDeveloperReceiver) (*MsgRemoveDeveloperReceiverResponse, error)
+	// ReplaceDeveloperReceiver replaces the address of a developer rewards
+	// receiver, keeping its weight and vesting. It must be signed by the module
+	// authority.
+	ReplaceDeveloperReceiver(context.Context, *MsgReplaceDeveloperReceiver) (*MsgReplaceDeveloperReceiverResponse, error)
+	// ReweightDeveloperReceiver sets the weight of a developer rewards receiver,
+	// scaling the weights of the other receivers proportionally. It must be
+	// signed by the module authority.
+	ReweightDeveloperReceiver(context.Context, *MsgReweightDeveloperReceiver) (*MsgReweightDeveloperReceiverResponse, error)
+	// SetPaused pauses or resumes minting. It must be signed by the module
+	// authority, or by the guardian to pause.
+	SetPaused(context.Context, *MsgSetPaused) (*MsgSetPausedResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawDeveloperRewards(ctx context.Context, req *MsgWithdrawDeveloperRewards) (*MsgWithdrawDeveloperRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawDeveloperRewards not implemented")
+}
+func (*UnimplementedMsgServer) ClawbackDeveloperRewards(ctx context.Context, req *MsgClawbackDeveloperRewards) (*MsgClawbackDeveloperRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClawbackDeveloperRewards not implemented")
+}
+func (*UnimplementedMsgServer) AddDeveloperReceiver(ctx context.Context, req *MsgAddDeveloperReceiver) (*MsgAddDeveloperReceiverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDeveloperReceiver not implemented")
+}
+func (*UnimplementedMsgServer) RemoveDeveloperReceiver(ctx context.Context, req *MsgRemoveDeveloperReceiver) (*MsgRemoveDeveloperReceiverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDeveloperReceiver not implemented")
+}
+func (*UnimplementedMsgServer) ReplaceDeveloperReceiver(ctx context.Context, req *MsgReplaceDeveloperReceiver) (*MsgReplaceDeveloperReceiverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceDeveloperReceiver not implemented")
+}
+func (*UnimplementedMsgServer) ReweightDeveloperReceiver(ctx context.Context, req *MsgReweightDeveloperReceiver) (*MsgReweightDeveloperReceiverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReweightDeveloperReceiver not implemented")
+}
+func (*UnimplementedMsgServer) SetPaused(ctx context.Context, req *MsgSetPaused) (*MsgSetPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPaused not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.mint.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawDeveloperRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawDeveloperRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawDeveloperRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.mint.Msg/WithdrawDeveloperRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawDeveloperRewards(ctx, req.(*MsgWithdrawDeveloperRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClawbackDeveloperRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawbackDeveloperRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClawbackDeveloperRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.mint.Msg/ClawbackDeveloperRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClawbackDeveloperRewards(ctx, req.(*MsgClawbackDeveloperRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddDeveloperReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddDeveloperReceiver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddDeveloperReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.mint.Msg/AddDeveloperReceiver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddDeveloperReceiver(ctx, req.(*MsgAddDeveloperReceiver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveDeveloperReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveDeveloperReceiver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveDeveloperReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.mint.Msg/RemoveDeveloperReceiver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveDeveloperReceiver(ctx, req.(*MsgRemoveDeveloperReceiver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReplaceDeveloperReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReplaceDeveloperReceiver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReplaceDeveloperReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.mint.Msg/ReplaceDeveloperReceiver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReplaceDeveloperReceiver(ctx, req.(*MsgReplaceDeveloperReceiver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReweightDeveloperReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReweightDeveloperReceiver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReweightDeveloperReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.mint.Msg/ReweightDeveloperReceiver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReweightDeveloperReceiver(ctx, req.(*MsgReweightDeveloperReceiver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galaxy.mint.Msg/SetPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPaused(ctx, req.(*MsgSetPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "galaxy.mint.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "WithdrawDeveloperRewards",
+			Handler:    _Msg_WithdrawDeveloperRewards_Handler,
+		},
+		{
+			MethodName: "ClawbackDeveloperRewards",
+			Handler:    _Msg_ClawbackDeveloperRewards_Handler,
+		},
+		{
+			MethodName: "AddDeveloperReceiver",
+			Handler:    _Msg_AddDeveloperReceiver_Handler,
+		},
+		{
+			MethodName: "RemoveDeveloperReceiver",
+			Handler:    _Msg_RemoveDeveloperReceiver_Handler,
+		},
+		{
+			MethodName: "ReplaceDeveloperReceiver",
+			Handler:    _Msg_ReplaceDeveloperReceiver_Handler,
+		},
+		{
+			MethodName: "ReweightDeveloperReceiver",
+			Handler:    _Msg_ReweightDeveloperReceiver_Handler,
+		},
+		{
+			MethodName: "SetPaused",
+			Handler:    _Msg_SetPaused_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "galaxy/mint/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawDeveloperRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawDeveloperRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawDeveloperRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawDeveloperRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawDeveloperRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawDeveloperRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackDeveloperRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackDeveloperRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackDeveloperRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackDeveloperRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackDeveloperRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackDeveloperRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddDeveloperReceiver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddDeveloperReceiver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddDeveloperReceiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Receiver.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddDeveloperReceiverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddDeveloperReceiverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddDeveloperReceiverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveDeveloperReceiver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveDeveloperReceiver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveDeveloperReceiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Redistribution != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Redistribution))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveDeveloperReceiverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveDeveloperReceiverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveDeveloperReceiverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReplaceDeveloperReceiver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplaceDeveloperReceiver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplaceDeveloperReceiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAddress) > 0 {
+		i -= len(m.NewAddress)
+		copy(dAtA[i:], m.NewAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReplaceDeveloperReceiverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplaceDeveloperReceiverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplaceDeveloperReceiverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReweightDeveloperReceiver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReweightDeveloperReceiver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReweightDeveloperReceiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReweightDeveloperReceiverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReweightDeveloperReceiverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReweightDeveloperReceiverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawDeveloperRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawDeveloperRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClawbackDeveloperRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClawbackDeveloperRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddDeveloperReceiver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Receiver.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddDeveloperReceiverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveDeveloperReceiver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Redistribution != 0 {
+		n += 1 + sovTx(uint64(m.Redistribution))
+	}
+	return n
+}
+
+func (m *MsgRemoveDeveloperReceiverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReplaceDeveloperReceiver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReplaceDeveloperReceiverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReweightDeveloperReceiver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgReweightDeveloperReceiverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawDeveloperRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawDeveloperRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawDeveloperRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawDeveloperRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawDeveloperRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawDeveloperRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawbackDeveloperRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawbackDeveloperRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawbackDeveloperRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawbackDeveloperRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawbackDeveloperRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawbackDeveloperRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddDeveloperReceiver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddDeveloperReceiver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddDeveloperReceiver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Receiver.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddDeveloperReceiverResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddDeveloperReceiverResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddDeveloperReceiverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveDeveloperReceiver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveDeveloperReceiver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveDeveloperReceiver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redistribution", wireType)
+			}
+			m.Redistribution = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Redistribution |= WeightRedistribution(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveDeveloperReceiverResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveDeveloperReceiverResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveDeveloperReceiverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReplaceDeveloperReceiver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReplaceDeveloperReceiver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReplaceDeveloperReceiver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReplaceDeveloperReceiverResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReplaceDeveloperReceiverResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReplaceDeveloperReceiverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReweightDeveloperReceiver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReweightDeveloperReceiver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReweightDeveloperReceiver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReweightDeveloperReceiverResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReweightDeveloperReceiverResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReweightDeveloperReceiverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)